@@ -1,18 +1,27 @@
 package app
 
 import (
+	"context"
+	"crypto/rand"
 	"database/sql"
 	"html/template"
+	"mordezzanV4/internal/contextkeys"
 	"mordezzanV4/internal/controllers"
+	"mordezzanV4/internal/dice"
 	"mordezzanV4/internal/logger"
 	"mordezzanV4/internal/middleware"
+	"mordezzanV4/internal/models"
 	"mordezzanV4/internal/repositories"
 	"mordezzanV4/internal/services"
 	"net/http"
+	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
+	apperrors "mordezzanV4/internal/errors"
+
 	"github.com/alexedwards/scs/sqlite3store"
 	"github.com/alexedwards/scs/v2"
 	"github.com/go-chi/chi"
@@ -21,55 +30,111 @@ import (
 )
 
 type App struct {
-	DB                      *sql.DB
-	UserRepository          repositories.UserRepository
-	CharacterRepository     repositories.CharacterRepository
-	SpellRepository         repositories.SpellRepository
-	ArmorRepository         repositories.ArmorRepository
-	WeaponRepository        repositories.WeaponRepository
-	EquipmentRepository     repositories.EquipmentRepository
-	ShieldRepository        repositories.ShieldRepository
-	PotionRepository        repositories.PotionRepository
-	MagicItemRepository     repositories.MagicItemRepository
-	RingRepository          repositories.RingRepository
-	AmmoRepository          repositories.AmmoRepository
-	SpellScrollRepository   repositories.SpellScrollRepository
-	ContainerRepository     repositories.ContainerRepository
-	TreasureRepository      repositories.TreasureRepository
-	InventoryRepository     repositories.InventoryRepository
-	ClassRepository         repositories.ClassRepository
-	SpellCastingRepository  repositories.SpellCastingRepository
-	WeaponMasteryRepository repositories.WeaponMasteryRepository
-	ThiefSkillsRepository   repositories.ThiefSkillsRepository
-
-	ClassService       *services.ClassService
-	EncumbranceService *services.EncumbranceService
-	SpellService       *services.SpellService
-	ACService          *services.ACService
-	WeaponStatsService *services.WeaponStatsService
-	ThiefSkillsService *services.ThiefSkillsService
-
-	UserController          *controllers.UserController
-	CharacterController     *controllers.CharacterController
-	SpellController         *controllers.SpellController
-	ArmorController         *controllers.ArmorController
-	WeaponController        *controllers.WeaponController
-	EquipmentController     *controllers.EquipmentController
-	ShieldController        *controllers.ShieldController
-	PotionController        *controllers.PotionController
-	MagicItemController     *controllers.MagicItemController
-	RingController          *controllers.RingController
-	AmmoController          *controllers.AmmoController
-	SpellScrollController   *controllers.SpellScrollController
-	ContainerController     *controllers.ContainerController
-	AuthController          *controllers.AuthController
-	TreasureController      *controllers.TreasureController
-	InventoryController     *controllers.InventoryController
-	SpellCastingController  *controllers.SpellCastingController
-	ACController            *controllers.ACController
-	WeaponMasteryController *controllers.WeaponMasteryController
-	WeaponStatsController   *controllers.WeaponStatsController
-	ThiefSkillsController   *controllers.ThiefSkillsController
+	DB                            *sql.DB
+	UserRepository                repositories.UserRepository
+	CharacterRepository           repositories.CharacterRepository
+	SpellRepository               repositories.SpellRepository
+	ArmorRepository               repositories.ArmorRepository
+	WeaponRepository              repositories.WeaponRepository
+	EquipmentRepository           repositories.EquipmentRepository
+	ShieldRepository              repositories.ShieldRepository
+	PotionRepository              repositories.PotionRepository
+	MagicItemRepository           repositories.MagicItemRepository
+	RingRepository                repositories.RingRepository
+	AmmoRepository                repositories.AmmoRepository
+	SpellScrollRepository         repositories.SpellScrollRepository
+	ContainerRepository           repositories.ContainerRepository
+	TreasureRepository            repositories.TreasureRepository
+	InventoryRepository           repositories.InventoryRepository
+	ClassRepository               repositories.ClassRepository
+	SpellCastingRepository        repositories.SpellCastingRepository
+	WeaponMasteryRepository       repositories.WeaponMasteryRepository
+	ThiefSkillsRepository         repositories.ThiefSkillsRepository
+	CharacterGrantRepository      repositories.CharacterGrantRepository
+	AbilityRollRepository         repositories.AbilityRollRepository
+	LevelUpRepository             repositories.LevelUpRepository
+	XPAwardRepository             repositories.XPAwardRepository
+	KindredRepository             repositories.KindredRepository
+	ContentPackRepository         repositories.ContentPackRepository
+	SpellbookRepository           repositories.SpellbookRepository
+	SpellLearningRepository       repositories.SpellLearningRepository
+	ItemUseRepository             repositories.ItemUseRepository
+	ActiveEffectRepository        repositories.ActiveEffectRepository
+	ConditionRepository           repositories.ConditionRepository
+	MonsterRepository             repositories.MonsterRepository
+	EncounterRepository           repositories.EncounterRepository
+	TurnUndeadRepository          repositories.TurnUndeadRepository
+	ThiefSkillCheckRepository     repositories.ThiefSkillCheckRepository
+	AttributeAdjustmentRepository repositories.AttributeAdjustmentRepository
+
+	ClassService               *services.ClassService
+	EncumbranceService         *services.EncumbranceService
+	SpellService               *services.SpellService
+	ACService                  *services.ACService
+	WeaponStatsService         *services.WeaponStatsService
+	ThiefSkillsService         *services.ThiefSkillsService
+	CharacterAccessService     *services.CharacterAccessService
+	DiceService                *services.DiceService
+	AbilityRollService         *services.AbilityRollService
+	LevelUpService             *services.LevelUpService
+	XPService                  *services.XPService
+	ContentPackService         *services.ContentPackService
+	SpellbookService           *services.SpellbookService
+	SpellLearningService       *services.SpellLearningService
+	ItemUseService             *services.ItemUseService
+	ActiveEffectService        *services.ActiveEffectService
+	ConditionService           *services.ConditionService
+	SavingThrowService         *services.SavingThrowService
+	AttackService              *services.AttackService
+	EncounterService           *services.EncounterService
+	TurnUndeadService          *services.TurnUndeadService
+	ThiefSkillCheckService     *services.ThiefSkillCheckService
+	AttributeCheckService      *services.AttributeCheckService
+	AttributeAdjustmentService *services.AttributeAdjustmentService
+	VitalityService            *services.VitalityService
+
+	UserController                *controllers.UserController
+	CharacterController           *controllers.CharacterController
+	SpellController               *controllers.SpellController
+	ArmorController               *controllers.ArmorController
+	WeaponController              *controllers.WeaponController
+	EquipmentController           *controllers.EquipmentController
+	ShieldController              *controllers.ShieldController
+	PotionController              *controllers.PotionController
+	MagicItemController           *controllers.MagicItemController
+	RingController                *controllers.RingController
+	AmmoController                *controllers.AmmoController
+	SpellScrollController         *controllers.SpellScrollController
+	ContainerController           *controllers.ContainerController
+	AuthController                *controllers.AuthController
+	TreasureController            *controllers.TreasureController
+	InventoryController           *controllers.InventoryController
+	SpellCastingController        *controllers.SpellCastingController
+	ACController                  *controllers.ACController
+	WeaponMasteryController       *controllers.WeaponMasteryController
+	WeaponStatsController         *controllers.WeaponStatsController
+	ThiefSkillsController         *controllers.ThiefSkillsController
+	CharacterGrantController      *controllers.CharacterGrantController
+	DiceController                *controllers.DiceController
+	AbilityRollController         *controllers.AbilityRollController
+	LevelUpController             *controllers.LevelUpController
+	XPController                  *controllers.XPController
+	KindredController             *controllers.KindredController
+	ContentPackController         *controllers.ContentPackController
+	SpellbookController           *controllers.SpellbookController
+	SpellLearningController       *controllers.SpellLearningController
+	ItemUseController             *controllers.ItemUseController
+	ActiveEffectController        *controllers.ActiveEffectController
+	ConditionController           *controllers.ConditionController
+	SavingThrowController         *controllers.SavingThrowController
+	AttackController              *controllers.AttackController
+	MonsterController             *controllers.MonsterController
+	EncounterController           *controllers.EncounterController
+	TurnUndeadController          *controllers.TurnUndeadController
+	ThiefSkillCheckController     *controllers.ThiefSkillCheckController
+	AttributeCheckController      *controllers.AttributeCheckController
+	AttributeAdjustmentController *controllers.AttributeAdjustmentController
+	VitalityController            *controllers.VitalityController
 
 	Templates      *template.Template
 	SessionManager *scs.SessionManager
@@ -143,6 +208,22 @@ func NewApp(dbPath string) (*App, error) {
 	spellCastingRepo := repositories.NewSQLCSpellCastingRepository(db)
 	weaponMasteryRepo := repositories.NewSQLCWeaponMasteryRepository(db)
 	thiefSkillsRepo := repositories.NewSQLCThiefSkillsRepository(db)
+	characterGrantRepo := repositories.NewSQLCCharacterGrantRepository(db)
+	abilityRollRepo := repositories.NewSQLCAbilityRollRepository(db)
+	levelUpRepo := repositories.NewSQLCLevelUpRepository(db)
+	xpAwardRepo := repositories.NewSQLCXPAwardRepository(db)
+	kindredRepo := repositories.NewSQLCKindredRepository(db)
+	contentPackRepo := repositories.NewSQLCContentPackRepository(db)
+	spellbookRepo := repositories.NewSQLCSpellbookRepository(db)
+	spellLearningRepo := repositories.NewSQLCSpellLearningRepository(db)
+	itemUseRepo := repositories.NewSQLCItemUseRepository(db)
+	activeEffectRepo := repositories.NewSQLCActiveEffectRepository(db)
+	conditionRepo := repositories.NewSQLCConditionRepository(db)
+	monsterRepo := repositories.NewSQLCMonsterRepository(db)
+	encounterRepo := repositories.NewSQLCEncounterRepository(db)
+	turnUndeadRepo := repositories.NewSQLCTurnUndeadRepository(db)
+	thiefSkillCheckRepo := repositories.NewSQLCThiefSkillCheckRepository(db)
+	attributeAdjustmentRepo := repositories.NewSQLCAttributeAdjustmentRepository(db)
 
 	// Initialize services
 	classService := services.NewClassService(
@@ -162,6 +243,7 @@ func NewApp(dbPath string) (*App, error) {
 		ringRepo,
 		ammoRepo,
 		spellScrollRepo,
+		spellbookRepo,
 		containerRepo,
 		equipmentRepo,
 		treasureRepo,
@@ -170,10 +252,12 @@ func NewApp(dbPath string) (*App, error) {
 	spellService := services.NewSpellService(
 		spellRepo,
 		spellCastingRepo,
+		spellbookRepo,
 		characterRepo,
 		classRepo,
 		classService,
 		encumbranceService,
+		turnUndeadRepo,
 	)
 	acService := services.NewACService(
 		inventoryRepo,
@@ -196,13 +280,96 @@ func NewApp(dbPath string) (*App, error) {
 	)
 
 	classService.SetEncumbranceService(encumbranceService)
+	classService.SetKindredRepository(kindredRepo)
 
 	thiefSkillsService := services.NewThiefSkillsService(thiefSkillsRepo)
 
+	characterAccessService := services.NewCharacterAccessService(
+		characterRepo,
+		characterGrantRepo,
+		inventoryRepo,
+		userRepo,
+	)
+
+	roller := dice.NewRandomRoller()
+	diceService := services.NewDiceService(roller, weaponStatsService)
+	abilityRollService := services.NewAbilityRollService(abilityRollRepo, roller, abilityRollSecret())
+	xpService := services.NewXPService(xpAwardRepo, characterRepo, classRepo)
+	contentPackService := services.NewContentPackService(contentPackRepo)
+	spellbookService := services.NewSpellbookService(spellbookRepo, spellCastingRepo, classService)
+	spellLearningService := services.NewSpellLearningService(
+		spellLearningRepo,
+		characterRepo,
+		spellRepo,
+		spellScrollRepo,
+		spellbookRepo,
+		spellCastingRepo,
+		classService,
+		characterAccessService,
+		roller,
+	)
+	itemUseService := services.NewItemUseService(
+		itemUseRepo,
+		characterRepo,
+		spellRepo,
+		spellScrollRepo,
+		magicItemRepo,
+		potionRepo,
+		activeEffectRepo,
+		classService,
+		thiefSkillsService,
+		roller,
+	)
+	activeEffectService := services.NewActiveEffectService(activeEffectRepo, conditionRepo, characterRepo)
+	conditionService := services.NewConditionService(conditionRepo, characterRepo)
+	savingThrowService := services.NewSavingThrowService(
+		characterRepo,
+		classService,
+		inventoryRepo,
+		ringRepo,
+		equipmentRepo,
+		roller,
+	)
+	vitalityService := services.NewVitalityService(characterRepo, attributeAdjustmentRepo, roller)
+	attackService := services.NewAttackService(characterRepo, classService, weaponStatsService, roller)
+	encounterService := services.NewEncounterService(
+		encounterRepo,
+		monsterRepo,
+		characterRepo,
+		classService,
+		characterAccessService,
+		activeEffectService,
+		vitalityService,
+		roller,
+	)
+	turnUndeadService := services.NewTurnUndeadService(turnUndeadRepo, characterRepo, classService, roller)
+	attributeCheckService := services.NewAttributeCheckService(characterRepo, classService, roller)
+	attributeAdjustmentService := services.NewAttributeAdjustmentService(attributeAdjustmentRepo, characterRepo, classService)
+	thiefSkillCheckService := services.NewThiefSkillCheckService(
+		thiefSkillCheckRepo,
+		characterRepo,
+		thiefSkillsService,
+		inventoryRepo,
+		armorRepo,
+		ringRepo,
+		equipmentRepo,
+		magicItemRepo,
+		encumbranceService,
+		roller,
+	)
+	levelUpService := services.NewLevelUpService(
+		characterRepo,
+		levelUpRepo,
+		classRepo,
+		classService,
+		spellService,
+		roller,
+	)
+
 	// Initialize controllers with session manager
 	authController := controllers.NewAuthController(userRepo, tmpl, sessionManager)
 	userController := controllers.NewUserController(userRepo, tmpl)
-	characterController := controllers.NewCharacterController(characterRepo, userRepo, classService, tmpl, sessionManager)
+	characterController := controllers.NewCharacterController(characterRepo, userRepo, classService, characterAccessService, abilityRollService, xpService, vitalityService, tmpl, sessionManager)
 	spellController := controllers.NewSpellController(spellRepo, tmpl)
 	armorController := controllers.NewArmorController(armorRepo, tmpl)
 	weaponController := controllers.NewWeaponController(weaponRepo, tmpl)
@@ -214,7 +381,7 @@ func NewApp(dbPath string) (*App, error) {
 	ammoController := controllers.NewAmmoController(ammoRepo, tmpl)
 	spellScrollController := controllers.NewSpellScrollController(spellScrollRepo, spellRepo, tmpl)
 	containerController := controllers.NewContainerController(containerRepo, tmpl)
-	treasureController := controllers.NewTreasureController(treasureRepo, characterRepo, tmpl)
+	treasureController := controllers.NewTreasureController(treasureRepo, characterAccessService, tmpl)
 	inventoryController := controllers.NewInventoryController(
 		inventoryRepo,
 		characterRepo,
@@ -226,10 +393,13 @@ func NewApp(dbPath string) (*App, error) {
 		ringRepo,
 		ammoRepo,
 		spellScrollRepo,
+		spellbookRepo,
 		containerRepo,
 		equipmentRepo,
 		treasureRepo,
 		encumbranceService,
+		characterAccessService,
+		roller,
 		tmpl,
 	)
 	thiefSkillsController := controllers.NewThiefSkillsController(
@@ -242,58 +412,135 @@ func NewApp(dbPath string) (*App, error) {
 	spellCastingController := controllers.NewSpellCastingController(spellService)
 	acController := controllers.NewACController(acService)
 	weaponStatsController := controllers.NewWeaponStatsController(weaponStatsService)
+	characterGrantController := controllers.NewCharacterGrantController(characterAccessService, userRepo)
+	diceController := controllers.NewDiceController(diceService)
+	abilityRollController := controllers.NewAbilityRollController(abilityRollService)
+	levelUpController := controllers.NewLevelUpController(levelUpService)
+	xpController := controllers.NewXPController(xpService)
+	kindredController := controllers.NewKindredController(kindredRepo, classRepo)
+	contentPackController := controllers.NewContentPackController(contentPackService)
+	spellbookController := controllers.NewSpellbookController(spellbookService)
+	spellLearningController := controllers.NewSpellLearningController(spellLearningService)
+	itemUseController := controllers.NewItemUseController(itemUseService)
+	activeEffectController := controllers.NewActiveEffectController(activeEffectService)
+	conditionController := controllers.NewConditionController(conditionRepo, conditionService)
+	savingThrowController := controllers.NewSavingThrowController(savingThrowService)
+	attackController := controllers.NewAttackController(attackService)
+	monsterController := controllers.NewMonsterController(monsterRepo)
+	encounterController := controllers.NewEncounterController(encounterService)
+	turnUndeadController := controllers.NewTurnUndeadController(turnUndeadService)
+	thiefSkillCheckController := controllers.NewThiefSkillCheckController(thiefSkillCheckService)
+	attributeCheckController := controllers.NewAttributeCheckController(attributeCheckService)
+	attributeAdjustmentController := controllers.NewAttributeAdjustmentController(attributeAdjustmentService)
+	vitalityController := controllers.NewVitalityController(vitalityService, characterAccessService)
 	logger.Info("Application initialized successfully")
 
 	return &App{
-		DB:                      db,
-		UserRepository:          userRepo,
-		CharacterRepository:     characterRepo,
-		SpellRepository:         spellRepo,
-		ArmorRepository:         armorRepo,
-		WeaponRepository:        weaponRepo,
-		EquipmentRepository:     equipmentRepo,
-		ShieldRepository:        shieldRepo,
-		PotionRepository:        potionRepo,
-		MagicItemRepository:     magicItemRepo,
-		RingRepository:          ringRepo,
-		AmmoRepository:          ammoRepo,
-		SpellScrollRepository:   spellScrollRepo,
-		ContainerRepository:     containerRepo,
-		TreasureRepository:      treasureRepo,
-		InventoryRepository:     inventoryRepo,
-		ClassRepository:         classRepo,
-		SpellCastingRepository:  spellCastingRepo,
-		WeaponMasteryRepository: weaponMasteryRepo,
-		ThiefSkillsRepository:   thiefSkillsRepo,
-
-		ClassService:       classService,
-		EncumbranceService: encumbranceService,
-		SpellService:       spellService,
-		ACService:          acService,
-		WeaponStatsService: weaponStatsService,
-		ThiefSkillsService: thiefSkillsService,
-
-		UserController:          userController,
-		CharacterController:     characterController,
-		SpellController:         spellController,
-		ArmorController:         armorController,
-		WeaponController:        weaponController,
-		EquipmentController:     equipmentController,
-		ShieldController:        shieldController,
-		PotionController:        potionController,
-		MagicItemController:     magicItemController,
-		RingController:          ringController,
-		AmmoController:          ammoController,
-		SpellScrollController:   spellScrollController,
-		ContainerController:     containerController,
-		AuthController:          authController,
-		TreasureController:      treasureController,
-		InventoryController:     inventoryController,
-		SpellCastingController:  spellCastingController,
-		ACController:            acController,
-		WeaponMasteryController: weaponMasteryController,
-		WeaponStatsController:   weaponStatsController,
-		ThiefSkillsController:   thiefSkillsController,
+		DB:                            db,
+		UserRepository:                userRepo,
+		CharacterRepository:           characterRepo,
+		SpellRepository:               spellRepo,
+		ArmorRepository:               armorRepo,
+		WeaponRepository:              weaponRepo,
+		EquipmentRepository:           equipmentRepo,
+		ShieldRepository:              shieldRepo,
+		PotionRepository:              potionRepo,
+		MagicItemRepository:           magicItemRepo,
+		RingRepository:                ringRepo,
+		AmmoRepository:                ammoRepo,
+		SpellScrollRepository:         spellScrollRepo,
+		ContainerRepository:           containerRepo,
+		TreasureRepository:            treasureRepo,
+		InventoryRepository:           inventoryRepo,
+		ClassRepository:               classRepo,
+		SpellCastingRepository:        spellCastingRepo,
+		WeaponMasteryRepository:       weaponMasteryRepo,
+		ThiefSkillsRepository:         thiefSkillsRepo,
+		CharacterGrantRepository:      characterGrantRepo,
+		AbilityRollRepository:         abilityRollRepo,
+		LevelUpRepository:             levelUpRepo,
+		XPAwardRepository:             xpAwardRepo,
+		KindredRepository:             kindredRepo,
+		ContentPackRepository:         contentPackRepo,
+		SpellbookRepository:           spellbookRepo,
+		SpellLearningRepository:       spellLearningRepo,
+		ItemUseRepository:             itemUseRepo,
+		ActiveEffectRepository:        activeEffectRepo,
+		ConditionRepository:           conditionRepo,
+		MonsterRepository:             monsterRepo,
+		EncounterRepository:           encounterRepo,
+		TurnUndeadRepository:          turnUndeadRepo,
+		ThiefSkillCheckRepository:     thiefSkillCheckRepo,
+		AttributeAdjustmentRepository: attributeAdjustmentRepo,
+
+		ClassService:               classService,
+		EncumbranceService:         encumbranceService,
+		SpellService:               spellService,
+		ACService:                  acService,
+		WeaponStatsService:         weaponStatsService,
+		ThiefSkillsService:         thiefSkillsService,
+		CharacterAccessService:     characterAccessService,
+		DiceService:                diceService,
+		AbilityRollService:         abilityRollService,
+		LevelUpService:             levelUpService,
+		XPService:                  xpService,
+		ContentPackService:         contentPackService,
+		SpellbookService:           spellbookService,
+		SpellLearningService:       spellLearningService,
+		ItemUseService:             itemUseService,
+		ActiveEffectService:        activeEffectService,
+		ConditionService:           conditionService,
+		SavingThrowService:         savingThrowService,
+		AttackService:              attackService,
+		EncounterService:           encounterService,
+		TurnUndeadService:          turnUndeadService,
+		ThiefSkillCheckService:     thiefSkillCheckService,
+		AttributeCheckService:      attributeCheckService,
+		AttributeAdjustmentService: attributeAdjustmentService,
+		VitalityService:            vitalityService,
+
+		UserController:                userController,
+		CharacterController:           characterController,
+		SpellController:               spellController,
+		ArmorController:               armorController,
+		WeaponController:              weaponController,
+		EquipmentController:           equipmentController,
+		ShieldController:              shieldController,
+		PotionController:              potionController,
+		MagicItemController:           magicItemController,
+		RingController:                ringController,
+		AmmoController:                ammoController,
+		SpellScrollController:         spellScrollController,
+		ContainerController:           containerController,
+		AuthController:                authController,
+		TreasureController:            treasureController,
+		InventoryController:           inventoryController,
+		SpellCastingController:        spellCastingController,
+		ACController:                  acController,
+		WeaponMasteryController:       weaponMasteryController,
+		WeaponStatsController:         weaponStatsController,
+		ThiefSkillsController:         thiefSkillsController,
+		CharacterGrantController:      characterGrantController,
+		DiceController:                diceController,
+		AbilityRollController:         abilityRollController,
+		LevelUpController:             levelUpController,
+		XPController:                  xpController,
+		KindredController:             kindredController,
+		ContentPackController:         contentPackController,
+		SpellbookController:           spellbookController,
+		SpellLearningController:       spellLearningController,
+		ItemUseController:             itemUseController,
+		ActiveEffectController:        activeEffectController,
+		ConditionController:           conditionController,
+		SavingThrowController:         savingThrowController,
+		AttackController:              attackController,
+		MonsterController:             monsterController,
+		EncounterController:           encounterController,
+		TurnUndeadController:          turnUndeadController,
+		ThiefSkillCheckController:     thiefSkillCheckController,
+		AttributeCheckController:      attributeCheckController,
+		AttributeAdjustmentController: attributeAdjustmentController,
+		VitalityController:            vitalityController,
 
 		Templates:      tmpl,
 		SessionManager: sessionManager,
@@ -362,41 +609,91 @@ func (a *App) SetupRoutes() http.Handler {
 	// Protected web routes
 	authRouter.Get("/settings", a.UserController.RenderSettingsPage)
 	authRouter.Get("/characters/create", a.CharacterController.RenderCreateForm)
-	authRouter.Get("/characters/view/{id}", a.CharacterController.RenderCharacterDetail)
-	authRouter.Get("/characters/{id}/edit", a.CharacterController.RenderEditForm)
+	authRouter.With(a.requireCharacterAccess).Get("/characters/view/{id}", a.CharacterController.RenderCharacterDetail)
+	authRouter.With(a.requireCharacterAccess).Get("/characters/{id}/edit", a.CharacterController.RenderEditForm)
 
 	// API routes requiring authentication
 	authRouter.Route("/api", func(r chi.Router) {
 		// User routes
 		r.Route("/users", func(r chi.Router) {
-			r.Get("/", a.UserController.ListUsers)
-			r.Post("/", a.UserController.CreateUser)
-			r.Get("/{id}", a.UserController.GetUser)
-			r.Put("/{id}", a.UserController.UpdateUser)
-			r.Delete("/{id}", a.UserController.DeleteUser)
 			r.Get("/{id}/characters", a.CharacterController.GetCharactersByUser)
+
+			// User administration is restricted to admins
+			r.Group(func(r chi.Router) {
+				r.Use(a.requireRole(models.RoleAdmin))
+
+				r.Get("/", a.UserController.ListUsers)
+				r.Post("/", a.UserController.CreateUser)
+				r.Get("/{id}", a.UserController.GetUser)
+				r.Put("/{id}", a.UserController.UpdateUser)
+				r.Delete("/{id}", a.UserController.DeleteUser)
+				r.Put("/{id}/role", a.UserController.UpdateUserRole)
+			})
 		})
 
 		// Settings route
 		r.Put("/user/settings", a.UserController.UpdateUserSettings)
 
+		// Dice route
+		r.Post("/roll", a.DiceController.RollDice)
+
+		// Ability score generation routes
+		r.Route("/ability-rolls", func(r chi.Router) {
+			r.Get("/", a.AbilityRollController.ListAbilityRolls)
+			r.Post("/", a.AbilityRollController.GenerateAbilityRoll)
+			r.Get("/{id}", a.AbilityRollController.GetAbilityRoll)
+		})
+
+		// Encounter routes
+		r.Route("/encounters", func(r chi.Router) {
+			r.Get("/", a.EncounterController.ListEncounters)
+			r.Post("/", a.EncounterController.CreateEncounter)
+			r.Get("/{id}", a.EncounterController.GetEncounter)
+			r.Delete("/{id}", a.EncounterController.DeleteEncounter)
+			r.Post("/{id}/participants", a.EncounterController.AddParticipant)
+			r.Delete("/{id}/participants/{participantId}", a.EncounterController.RemoveParticipant)
+			r.Post("/{id}/participants/{participantId}/hp", a.EncounterController.ModifyParticipantHP)
+			r.Post("/{id}/initiative", a.EncounterController.RollInitiative)
+			r.Post("/{id}/next", a.EncounterController.NextTurn)
+		})
+
 		// Character routes
 		r.Route("/characters", func(r chi.Router) {
 			r.Get("/", a.CharacterController.ListCharacters)
 			r.Post("/", a.CharacterController.CreateCharacter)
 
 			r.Route("/{id}", func(r chi.Router) {
+				r.Use(a.requireCharacterAccess)
+
 				r.Get("/", a.CharacterController.GetCharacter)
 				r.Put("/", a.CharacterController.UpdateCharacter)
 				r.Delete("/", a.CharacterController.DeleteCharacter)
 				r.Patch("/hp", a.CharacterController.UpdateCharacterHP)
 				r.Post("/modify-hp", a.CharacterController.ModifyCharacterHP)
 				r.Patch("/xp", a.CharacterController.UpdateCharacterXP)
+				r.Get("/level-up", a.LevelUpController.GetLevelUpStatus)
+				r.Post("/level-up", a.LevelUpController.LevelUp)
+				r.Get("/level-ups", a.LevelUpController.GetLevelUps)
+
+				// Experience ledger routes
+				r.Route("/xp-awards", func(r chi.Router) {
+					r.Get("/", a.XPController.GetXPLedger)
+					r.Post("/", a.XPController.AwardXP)
+					r.Post("/{awardId}/reverse", a.XPController.ReverseXPAward)
+				})
 				r.Get("/class-data", a.CharacterController.GetCharacterClassData)
 				r.Get("/equipment-status", a.InventoryController.GetEquipmentStatus)
 				r.Get("/combat-equipment", a.InventoryController.GetCombatEquipment)
 				r.Get("/ac", a.ACController.GetCharacterAC)
 				r.Get("/weapon-stats", a.WeaponStatsController.GetCharacterWeaponStats)
+				r.Post("/roll", a.DiceController.RollForCharacter)
+				r.Post("/saves", a.SavingThrowController.RollSavingThrow)
+				r.Get("/attribute-checks", a.AttributeCheckController.GetAttributeChances)
+				r.Post("/attribute-checks", a.AttributeCheckController.RollAttributeCheck)
+				r.Post("/attack", a.AttackController.Attack)
+				r.Get("/turn-undead", a.TurnUndeadController.GetTurnUndeadStatus)
+				r.Post("/turn-undead", a.TurnUndeadController.TurnUndead)
+				r.Get("/ability-roll", a.AbilityRollController.GetCharacterAbilityRoll)
 
 				r.Route("/weapon-masteries", func(r chi.Router) {
 					r.Get("/", a.WeaponMasteryController.GetWeaponMasteriesByCharacter)
@@ -413,10 +710,58 @@ func (a *App) SetupRoutes() http.Handler {
 					r.Put("/capacity", a.InventoryController.UpdateInventoryCapacity)
 				})
 
+				// Inventory item use routes
+				r.Route("/inventory", func(r chi.Router) {
+					r.Post("/items/{itemId}/use", a.ItemUseController.UseItem)
+					r.Post("/items/{itemId}/recharge", a.ItemUseController.RechargeItem)
+					r.Get("/uses", a.ItemUseController.GetItemUses)
+				})
+
+				// Active effect routes
+				r.Route("/effects", func(r chi.Router) {
+					r.Get("/", a.ActiveEffectController.GetActiveEffects)
+					r.Post("/advance", a.ActiveEffectController.AdvanceTime)
+					r.Delete("/{effectId}", a.ActiveEffectController.RemoveActiveEffect)
+				})
+
+				// Condition routes
+				r.Route("/conditions", func(r chi.Router) {
+					r.Get("/", a.ConditionController.GetCharacterConditions)
+					r.Post("/", a.ConditionController.ApplyCondition)
+					r.Delete("/{conditionId}", a.ConditionController.RemoveCharacterCondition)
+				})
+
+				// Attribute damage, drain and restoration routes
+				r.Route("/attributes", func(r chi.Router) {
+					r.Get("/", a.AttributeAdjustmentController.GetCharacterAttributes)
+					r.Post("/adjustments", a.AttributeAdjustmentController.AddAttributeAdjustment)
+					r.Delete("/adjustments/{adjustmentId}", a.AttributeAdjustmentController.RemoveAttributeAdjustment)
+					r.Post("/restore", a.AttributeAdjustmentController.RestoreAttributes)
+				})
+
+				// Dying, death, resurrection and retirement routes
+				r.Route("/vitality", func(r chi.Router) {
+					r.Get("/", a.VitalityController.GetVitality)
+					r.Post("/stabilize", a.VitalityController.Stabilize)
+					r.Post("/resurrect", a.VitalityController.Resurrect)
+					r.Post("/retire", a.VitalityController.Retire)
+				})
+
+				// Sharing routes
+				r.Route("/grants", func(r chi.Router) {
+					r.Get("/", a.CharacterGrantController.GetCharacterGrants)
+					r.Post("/", a.CharacterGrantController.SaveCharacterGrant)
+					r.Delete("/{userId}", a.CharacterGrantController.RevokeCharacterGrant)
+				})
+
 				// Thief skills routes
 				r.Route("/thief-skills", func(r chi.Router) {
 					r.Get("/", a.ThiefSkillsController.GetThiefSkillsForCharacter)
 				})
+				r.Route("/thief-skill-checks", func(r chi.Router) {
+					r.Get("/", a.ThiefSkillCheckController.GetThiefSkillChecks)
+					r.Post("/", a.ThiefSkillCheckController.CheckThiefSkill)
+				})
 
 				// Spell routes
 				r.Route("/spells", func(r chi.Router) {
@@ -427,14 +772,30 @@ func (a *App) SetupRoutes() http.Handler {
 					r.Delete("/prepared/{spellId}", a.SpellCastingController.UnprepareSpell)
 					r.Delete("/prepared", a.SpellCastingController.ClearPreparedSpells)
 					r.Post("/prepared/all", a.SpellCastingController.PrepareAllSpells)
+					r.Post("/cast", a.SpellCastingController.CastSpell)
+					r.Get("/casts", a.SpellCastingController.GetSpellCasts)
+					r.Post("/rest", a.SpellCastingController.Rest)
+					r.Post("/learn", a.SpellLearningController.LearnSpell)
+					r.Get("/learning-attempts", a.SpellLearningController.GetLearningAttempts)
 					r.Get("/learnable", a.SpellCastingController.GetSpellsLearnableOnLevelUp)
 					r.Post("/initial", a.SpellCastingController.AddInitialSpellsForNewCharacter)
 				})
+
+				// Spellbook routes
+				r.Route("/spellbooks", func(r chi.Router) {
+					r.Get("/", a.SpellbookController.ListSpellbooks)
+					r.Post("/", a.SpellbookController.CreateSpellbook)
+					r.Get("/{bookId}", a.SpellbookController.GetSpellbook)
+					r.Post("/{bookId}/spells", a.SpellbookController.ScribeSpell)
+					r.Delete("/{bookId}/spells/{spellId}", a.SpellbookController.EraseSpell)
+				})
 			})
 		})
 
-		// Game data routes
+		// Game data routes: anyone can read the catalogs, only admins can change them
 		r.Route("/spells", func(r chi.Router) {
+			r.Use(a.requireAdminForWrites)
+
 			r.Get("/", a.SpellController.ListSpells)
 			r.Post("/", a.SpellController.CreateSpell)
 			r.Get("/{id}", a.SpellController.GetSpell)
@@ -443,6 +804,8 @@ func (a *App) SetupRoutes() http.Handler {
 		})
 
 		r.Route("/armors", func(r chi.Router) {
+			r.Use(a.requireAdminForWrites)
+
 			r.Get("/", a.ArmorController.ListArmors)
 			r.Post("/", a.ArmorController.CreateArmor)
 			r.Get("/{id}", a.ArmorController.GetArmor)
@@ -451,6 +814,8 @@ func (a *App) SetupRoutes() http.Handler {
 		})
 
 		r.Route("/weapons", func(r chi.Router) {
+			r.Use(a.requireAdminForWrites)
+
 			r.Get("/", a.WeaponController.ListWeapons)
 			r.Post("/", a.WeaponController.CreateWeapon)
 			r.Get("/{id}", a.WeaponController.GetWeapon)
@@ -459,6 +824,8 @@ func (a *App) SetupRoutes() http.Handler {
 		})
 
 		r.Route("/equipment", func(r chi.Router) {
+			r.Use(a.requireAdminForWrites)
+
 			r.Get("/", a.EquipmentController.ListEquipment)
 			r.Post("/", a.EquipmentController.CreateEquipment)
 			r.Get("/{id}", a.EquipmentController.GetEquipment)
@@ -466,7 +833,49 @@ func (a *App) SetupRoutes() http.Handler {
 			r.Delete("/{id}", a.EquipmentController.DeleteEquipment)
 		})
 
+		r.Route("/kindreds", func(r chi.Router) {
+			r.Use(a.requireAdminForWrites)
+
+			r.Get("/", a.KindredController.ListKindreds)
+			r.Post("/", a.KindredController.CreateKindred)
+			r.Get("/{id}", a.KindredController.GetKindred)
+			r.Put("/{id}", a.KindredController.UpdateKindred)
+			r.Delete("/{id}", a.KindredController.DeleteKindred)
+		})
+
+		r.Route("/conditions", func(r chi.Router) {
+			r.Use(a.requireAdminForWrites)
+
+			r.Get("/", a.ConditionController.ListConditions)
+			r.Post("/", a.ConditionController.CreateCondition)
+			r.Get("/{id}", a.ConditionController.GetCondition)
+			r.Put("/{id}", a.ConditionController.UpdateCondition)
+			r.Delete("/{id}", a.ConditionController.DeleteCondition)
+		})
+
+		r.Route("/monsters", func(r chi.Router) {
+			r.Use(a.requireAdminForWrites)
+
+			r.Get("/", a.MonsterController.ListMonsters)
+			r.Post("/", a.MonsterController.CreateMonster)
+			r.Get("/{id}", a.MonsterController.GetMonster)
+			r.Put("/{id}", a.MonsterController.UpdateMonster)
+			r.Delete("/{id}", a.MonsterController.DeleteMonster)
+		})
+
+		r.Route("/content-packs", func(r chi.Router) {
+			r.Use(a.requireAdminForWrites)
+
+			r.Get("/", a.ContentPackController.ListContentPacks)
+			r.Post("/", a.ContentPackController.InstallContentPack)
+			r.Post("/validate", a.ContentPackController.ValidateContentPack)
+			r.Get("/{id}", a.ContentPackController.GetContentPack)
+			r.Delete("/{id}", a.ContentPackController.UninstallContentPack)
+		})
+
 		r.Route("/shields", func(r chi.Router) {
+			r.Use(a.requireAdminForWrites)
+
 			r.Get("/", a.ShieldController.ListShields)
 			r.Post("/", a.ShieldController.CreateShield)
 			r.Get("/{id}", a.ShieldController.GetShield)
@@ -475,6 +884,8 @@ func (a *App) SetupRoutes() http.Handler {
 		})
 
 		r.Route("/potions", func(r chi.Router) {
+			r.Use(a.requireAdminForWrites)
+
 			r.Get("/", a.PotionController.ListPotions)
 			r.Post("/", a.PotionController.CreatePotion)
 			r.Get("/{id}", a.PotionController.GetPotion)
@@ -483,6 +894,8 @@ func (a *App) SetupRoutes() http.Handler {
 		})
 
 		r.Route("/magic-items", func(r chi.Router) {
+			r.Use(a.requireAdminForWrites)
+
 			r.Get("/", a.MagicItemController.ListMagicItems)
 			r.Post("/", a.MagicItemController.CreateMagicItem)
 			r.Get("/{id}", a.MagicItemController.GetMagicItem)
@@ -491,6 +904,8 @@ func (a *App) SetupRoutes() http.Handler {
 		})
 
 		r.Route("/rings", func(r chi.Router) {
+			r.Use(a.requireAdminForWrites)
+
 			r.Get("/", a.RingController.ListRings)
 			r.Post("/", a.RingController.CreateRing)
 			r.Get("/{id}", a.RingController.GetRing)
@@ -499,6 +914,8 @@ func (a *App) SetupRoutes() http.Handler {
 		})
 
 		r.Route("/ammo", func(r chi.Router) {
+			r.Use(a.requireAdminForWrites)
+
 			r.Get("/", a.AmmoController.ListAmmo)
 			r.Post("/", a.AmmoController.CreateAmmo)
 			r.Get("/{id}", a.AmmoController.GetAmmo)
@@ -507,6 +924,8 @@ func (a *App) SetupRoutes() http.Handler {
 		})
 
 		r.Route("/spell-scrolls", func(r chi.Router) {
+			r.Use(a.requireAdminForWrites)
+
 			r.Get("/", a.SpellScrollController.ListSpellScrolls)
 			r.Post("/", a.SpellScrollController.CreateSpellScroll)
 			r.Get("/{id}", a.SpellScrollController.GetSpellScroll)
@@ -515,6 +934,8 @@ func (a *App) SetupRoutes() http.Handler {
 		})
 
 		r.Route("/containers", func(r chi.Router) {
+			r.Use(a.requireAdminForWrites)
+
 			r.Get("/", a.ContainerController.ListContainers)
 			r.Post("/", a.ContainerController.CreateContainer)
 			r.Get("/{id}", a.ContainerController.GetContainer)
@@ -562,7 +983,16 @@ func (a *App) requireAuthentication(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Check if user is authenticated
 		userID := a.SessionManager.GetInt64(r.Context(), "userID")
-		if userID == 0 {
+		var user *models.User
+		if userID != 0 {
+			var err error
+			user, err = a.UserRepository.GetUser(r.Context(), userID)
+			if err != nil {
+				logger.Warning("Session refers to unknown user %d: %v", userID, err)
+				user = nil
+			}
+		}
+		if user == nil {
 			// Check if it's an API request or browser request
 			if strings.HasPrefix(r.URL.Path, "/api/") {
 				http.Error(w, "Unauthorized", http.StatusUnauthorized)
@@ -576,11 +1006,99 @@ func (a *App) requireAuthentication(next http.Handler) http.Handler {
 		ctx := r.Context()
 		a.SessionManager.Put(ctx, "isAuthenticated", true)
 
+		// Make the user ID and role available to controllers and services
+		ctx = context.WithValue(ctx, contextkeys.UserIDKey, userID)
+		ctx = context.WithValue(ctx, contextkeys.UserRoleKey, user.Role)
+
 		// User is authenticated, continue
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Role middleware; must run after requireAuthentication
+func (a *App) requireRole(roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			role, _ := r.Context().Value(contextkeys.UserRoleKey).(string)
+			for _, allowed := range roles {
+				if role == allowed {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+			apperrors.HandleError(w, apperrors.NewForbidden("You do not have permission to perform this action"))
+		})
+	}
+}
+
+// Catalog middleware: reads are open to everyone, changes need an admin
+func (a *App) requireAdminForWrites(next http.Handler) http.Handler {
+	adminOnly := a.requireRole(models.RoleAdmin)(next)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+		adminOnly.ServeHTTP(w, r)
+	})
+}
+
+// Character access middleware for routes scoped by a character {id}.
+// Safe methods need read access; everything else needs write access, and
+// dead or retired characters reject writes outside readOnlyExempt.
+func (a *App) requireCharacterAccess(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+			return
+		}
+
+		userID := a.SessionManager.GetInt64(r.Context(), "userID")
+		write := r.Method != http.MethodGet && r.Method != http.MethodHead
+
+		if err := a.CharacterAccessService.AuthorizeCharacter(r.Context(), userID, characterID, write); err != nil {
+			apperrors.HandleError(w, err)
+			return
+		}
+
+		if write && !readOnlyExempt(r) {
+			character, err := a.CharacterRepository.GetCharacter(r.Context(), characterID)
+			if err != nil {
+				apperrors.HandleError(w, err)
+				return
+			}
+			if character.IsReadOnly() {
+				apperrors.HandleError(w, apperrors.NewConflict(character.ReadOnlyReason()))
+				return
+			}
+		}
+
 		next.ServeHTTP(w, r)
 	})
 }
 
+// readOnlyExempt reports whether a write is still allowed on a read-only
+// character: vitality actions revive or retire it, grants let someone else
+// do so, and the record itself may still be deleted.
+func readOnlyExempt(r *http.Request) bool {
+	path := r.URL.Path
+	if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePath != "" {
+		path = rctx.RoutePath
+	}
+	path = "/" + strings.Trim(path, "/")
+
+	switch {
+	case path == "/vitality" || strings.HasPrefix(path, "/vitality/"):
+		return true
+	case path == "/grants" || strings.HasPrefix(path, "/grants/"):
+		return true
+	case path == "/" && r.Method == http.MethodDelete:
+		return true
+	}
+	return false
+}
+
 // Add context data for templates
 func (a *App) addContextData(r *http.Request) map[string]interface{} {
 	data := make(map[string]interface{})
@@ -605,3 +1123,19 @@ func (a *App) Shutdown() {
 		a.DB.Close()
 	}
 }
+
+// abilityRollSecret returns the key used to sign ability rolls. Without
+// ABILITY_ROLL_SECRET a random key is used, so rolls made before a restart
+// will no longer verify.
+func abilityRollSecret() []byte {
+	if secret := os.Getenv("ABILITY_ROLL_SECRET"); secret != "" {
+		return []byte(secret)
+	}
+
+	logger.Warning("ABILITY_ROLL_SECRET is not set; using a temporary signing key")
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		logger.Fatal("Failed to generate ability roll signing key: %v", err)
+	}
+	return secret
+}
@@ -0,0 +1,137 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/services"
+)
+
+// AbilityRollController handles HTTP requests for server-generated ability scores
+type AbilityRollController struct {
+	abilityRollService *services.AbilityRollService
+}
+
+// NewAbilityRollController creates a new ability roll controller
+func NewAbilityRollController(abilityRollService *services.AbilityRollService) *AbilityRollController {
+	return &AbilityRollController{
+		abilityRollService: abilityRollService,
+	}
+}
+
+// GenerateAbilityRoll handles generating a new set of ability scores for the current user
+func (c *AbilityRollController) GenerateAbilityRoll(w http.ResponseWriter, r *http.Request) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	var input models.CreateAbilityRollInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			apperrors.HandleValidationErrors(w, map[string]string{
+				validationErr.Field: validationErr.Message,
+			})
+			return
+		}
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	roll, err := c.abilityRollService.GenerateAbilityRoll(r.Context(), userID, &input)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(roll); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// ListAbilityRolls handles listing the current user's ability rolls
+func (c *AbilityRollController) ListAbilityRolls(w http.ResponseWriter, r *http.Request) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	rolls, err := c.abilityRollService.GetAbilityRollsByUser(r.Context(), userID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(rolls); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// GetAbilityRoll handles retrieving a single ability roll; game masters may inspect any roll
+func (c *AbilityRollController) GetAbilityRoll(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid ability roll ID format"))
+		return
+	}
+
+	userID, err := currentUserID(r)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	roll, err := c.abilityRollService.GetAbilityRoll(r.Context(), id)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	role := currentUserRole(r)
+	if roll.UserID != userID && role != models.RoleGameMaster && role != models.RoleAdmin {
+		apperrors.HandleError(w, apperrors.NewForbidden("You do not have access to this ability roll"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(roll); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// GetCharacterAbilityRoll handles retrieving the roll a character's attributes came from
+func (c *AbilityRollController) GetCharacterAbilityRoll(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	roll, err := c.abilityRollService.GetAbilityRollForCharacter(r.Context(), characterID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(roll); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
@@ -0,0 +1,105 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/services"
+)
+
+// ActiveEffectController handles HTTP requests for the timed effects on a character
+type ActiveEffectController struct {
+	activeEffectService *services.ActiveEffectService
+}
+
+// NewActiveEffectController creates a new active effect controller
+func NewActiveEffectController(activeEffectService *services.ActiveEffectService) *ActiveEffectController {
+	return &ActiveEffectController{
+		activeEffectService: activeEffectService,
+	}
+}
+
+// GetActiveEffects lists the effects currently on the character
+func (c *ActiveEffectController) GetActiveEffects(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	effects, err := c.activeEffectService.GetActiveEffects(r.Context(), characterID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(effects); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// AdvanceTime moves game time forward and reports the effects that ended
+func (c *ActiveEffectController) AdvanceTime(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	var input models.AdvanceTimeInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			apperrors.HandleValidationErrors(w, map[string]string{
+				validationErr.Field: validationErr.Message,
+			})
+			return
+		}
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	result, err := c.activeEffectService.AdvanceTime(r.Context(), characterID, &input)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// RemoveActiveEffect ends an effect on the character early
+func (c *ActiveEffectController) RemoveActiveEffect(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+	effectID, err := strconv.ParseInt(chi.URLParam(r, "effectId"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid effect ID format"))
+		return
+	}
+
+	if err := c.activeEffectService.RemoveActiveEffect(r.Context(), characterID, effectID); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
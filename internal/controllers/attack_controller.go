@@ -0,0 +1,65 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/services"
+)
+
+// AttackController handles HTTP requests for a character's weapon attacks
+type AttackController struct {
+	attackService *services.AttackService
+}
+
+// NewAttackController creates a new attack controller
+func NewAttackController(attackService *services.AttackService) *AttackController {
+	return &AttackController{
+		attackService: attackService,
+	}
+}
+
+// Attack resolves an attack by the character against a target AC and
+// returns the roll, each modifier applied and any damage dealt
+func (c *AttackController) Attack(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	var input models.AttackInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			apperrors.HandleValidationErrors(w, map[string]string{
+				validationErr.Field: validationErr.Message,
+			})
+			return
+		}
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	result, err := c.attackService.Attack(r.Context(), characterID, &input)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
@@ -0,0 +1,145 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/services"
+)
+
+// AttributeAdjustmentController handles HTTP requests for attribute damage,
+// drain, bonuses and restoration
+type AttributeAdjustmentController struct {
+	adjustmentService *services.AttributeAdjustmentService
+}
+
+// NewAttributeAdjustmentController creates a new attribute adjustment controller
+func NewAttributeAdjustmentController(adjustmentService *services.AttributeAdjustmentService) *AttributeAdjustmentController {
+	return &AttributeAdjustmentController{
+		adjustmentService: adjustmentService,
+	}
+}
+
+// GetCharacterAttributes returns the base, adjusted and current values of
+// the character's attributes and level
+func (c *AttributeAdjustmentController) GetCharacterAttributes(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	attributes, err := c.adjustmentService.GetAttributes(r.Context(), characterID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(attributes); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// AddAttributeAdjustment damages, drains or improves one of the character's
+// attributes or their level
+func (c *AttributeAdjustmentController) AddAttributeAdjustment(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	var input models.AddAttributeAdjustmentInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		handleAttributeAdjustmentError(w, err)
+		return
+	}
+
+	attributes, err := c.adjustmentService.AddAdjustment(r.Context(), characterID, &input)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(attributes); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// RemoveAttributeAdjustment takes a single adjustment off the character;
+// permanent losses cannot be removed
+func (c *AttributeAdjustmentController) RemoveAttributeAdjustment(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+	adjustmentID, err := strconv.ParseInt(chi.URLParam(r, "adjustmentId"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid adjustment ID format"))
+		return
+	}
+
+	if err := c.adjustmentService.RemoveAdjustment(r.Context(), characterID, adjustmentID); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RestoreAttributes removes the character's damage or drain
+func (c *AttributeAdjustmentController) RestoreAttributes(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	var input models.RestoreAttributesInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		handleAttributeAdjustmentError(w, err)
+		return
+	}
+
+	result, err := c.adjustmentService.Restore(r.Context(), characterID, &input)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+func handleAttributeAdjustmentError(w http.ResponseWriter, err error) {
+	var validationErr *models.ValidationError
+	if errors.As(err, &validationErr) {
+		apperrors.HandleValidationErrors(w, map[string]string{
+			validationErr.Field: validationErr.Message,
+		})
+		return
+	}
+	apperrors.HandleError(w, err)
+}
@@ -0,0 +1,86 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/services"
+)
+
+// AttributeCheckController handles HTTP requests for attribute tests, feats
+// and checks
+type AttributeCheckController struct {
+	checkService *services.AttributeCheckService
+}
+
+// NewAttributeCheckController creates a new attribute check controller
+func NewAttributeCheckController(checkService *services.AttributeCheckService) *AttributeCheckController {
+	return &AttributeCheckController{
+		checkService: checkService,
+	}
+}
+
+// GetAttributeChances lists the character's chances with each attribute
+func (c *AttributeCheckController) GetAttributeChances(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	chances, err := c.checkService.GetChances(r.Context(), characterID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(chances); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// RollAttributeCheck rolls a test, feat or check for one of the character's
+// attributes
+func (c *AttributeCheckController) RollAttributeCheck(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	var input models.AttributeCheckInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			apperrors.HandleValidationErrors(w, map[string]string{
+				validationErr.Field: validationErr.Message,
+			})
+			return
+		}
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	result, err := c.checkService.RollCheck(r.Context(), characterID, &input)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
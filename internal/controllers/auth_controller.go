@@ -261,6 +261,13 @@ func (c *AuthController) Register(w http.ResponseWriter, r *http.Request) {
 	// Log the creation
 	logger.Info("New user registered: %s (ID: %d)", input.Username, userID)
 
+	// The first account on a fresh install becomes the administrator
+	if adminCount, err := c.userRepo.CountUsersByRole(r.Context(), models.RoleAdmin); err == nil && adminCount == 0 {
+		if err := c.userRepo.UpdateUserRole(r.Context(), userID, models.RoleAdmin); err != nil {
+			logger.Error("Failed to promote first user to admin: %v", err)
+		}
+	}
+
 	// Automatically log in the new user
 	c.sessionManager.Put(r.Context(), "userID", userID)
 	c.sessionManager.Put(r.Context(), "username", input.Username)
@@ -18,11 +18,15 @@ import (
 )
 
 type CharacterController struct {
-	userRepo       repositories.UserRepository
-	characterRepo  repositories.CharacterRepository
-	classService   *services.ClassService
-	Templates      *template.Template
-	sessionManager *scs.SessionManager
+	userRepo        repositories.UserRepository
+	characterRepo   repositories.CharacterRepository
+	classService    *services.ClassService
+	accessService   *services.CharacterAccessService
+	rollService     *services.AbilityRollService
+	xpService       *services.XPService
+	vitalityService *services.VitalityService
+	Templates       *template.Template
+	sessionManager  *scs.SessionManager
 }
 
 type UpdateHPInput struct {
@@ -31,13 +35,17 @@ type UpdateHPInput struct {
 	TemporaryHitPoints int `json:"temporary_hit_points"`
 }
 
-func NewCharacterController(repo repositories.CharacterRepository, userRepo repositories.UserRepository, classService *services.ClassService, tmpl *template.Template, sessionManager *scs.SessionManager) *CharacterController {
+func NewCharacterController(repo repositories.CharacterRepository, userRepo repositories.UserRepository, classService *services.ClassService, accessService *services.CharacterAccessService, rollService *services.AbilityRollService, xpService *services.XPService, vitalityService *services.VitalityService, tmpl *template.Template, sessionManager *scs.SessionManager) *CharacterController {
 	return &CharacterController{
-		characterRepo:  repo,
-		userRepo:       userRepo,
-		classService:   classService,
-		Templates:      tmpl,
-		sessionManager: sessionManager,
+		characterRepo:   repo,
+		userRepo:        userRepo,
+		classService:    classService,
+		accessService:   accessService,
+		rollService:     rollService,
+		xpService:       xpService,
+		vitalityService: vitalityService,
+		Templates:       tmpl,
+		sessionManager:  sessionManager,
 	}
 }
 
@@ -166,6 +174,17 @@ func (c *CharacterController) GetCharactersByUser(w http.ResponseWriter, r *http
 		return
 	}
 
+	// Users can only list their own characters unless they are an admin
+	userID, err := currentUserID(r)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+	if userID != id && currentUserRole(r) != models.RoleAdmin {
+		apperrors.HandleError(w, apperrors.NewForbidden("You can only list your own characters"))
+		return
+	}
+
 	// Verify the user exists
 	user, err := c.userRepo.GetUser(r.Context(), id)
 	if err != nil {
@@ -188,12 +207,25 @@ func (c *CharacterController) GetCharactersByUser(w http.ResponseWriter, r *http
 }
 
 func (c *CharacterController) ListCharacters(w http.ResponseWriter, r *http.Request) {
-	characters, err := c.characterRepo.ListCharacters(r.Context())
+	userID, err := currentUserID(r)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	allCharacters, err := c.characterRepo.ListCharacters(r.Context())
 	if err != nil {
 		apperrors.HandleError(w, apperrors.NewInternalError(err))
 		return
 	}
 
+	// Only return characters the caller owns or has been granted
+	characters, err := c.accessService.FilterAccessibleCharacters(r.Context(), userID, allCharacters)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(characters)
 }
@@ -205,6 +237,19 @@ func (c *CharacterController) CreateCharacter(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// Characters are always created for the logged-in user
+	userID, err := currentUserID(r)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+	if input.UserID == 0 {
+		input.UserID = userID
+	} else if input.UserID != userID {
+		apperrors.HandleError(w, apperrors.NewForbidden("You cannot create characters for another user"))
+		return
+	}
+
 	// Validate the input
 	if err := input.Validate(); err != nil {
 		var validationErr *models.ValidationError
@@ -219,8 +264,14 @@ func (c *CharacterController) CreateCharacter(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// The kindred must exist and allow the chosen class
+	if err := c.classService.ValidateKindred(r.Context(), input.Kindred, input.Class); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
 	// Check if the user exists
-	_, err := c.userRepo.GetUser(r.Context(), input.UserID)
+	_, err = c.userRepo.GetUser(r.Context(), input.UserID)
 	if err != nil {
 		if errors.Is(err, apperrors.ErrNotFound) {
 			apperrors.HandleError(w, apperrors.NewNotFound("user", input.UserID))
@@ -230,13 +281,39 @@ func (c *CharacterController) CreateCharacter(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// Attributes generated by the server must match the signed roll they came from
+	if input.AbilityRollID != 0 {
+		if err := c.rollService.ClaimAbilityRoll(r.Context(), &input); err != nil {
+			apperrors.HandleError(w, err)
+			return
+		}
+	}
+
 	// Create the character
 	id, err := c.characterRepo.CreateCharacter(r.Context(), &input)
 	if err != nil {
+		if input.AbilityRollID != 0 {
+			if releaseErr := c.rollService.ReleaseAbilityRoll(r.Context(), input.AbilityRollID); releaseErr != nil {
+				logger.Error("Failed to release ability roll %d: %v", input.AbilityRollID, releaseErr)
+			}
+		}
 		apperrors.HandleError(w, apperrors.NewInternalError(err))
 		return
 	}
 
+	if input.AbilityRollID != 0 {
+		if err := c.rollService.AttachCharacter(r.Context(), input.AbilityRollID, id); err != nil {
+			logger.Error("Failed to link ability roll %d to character %d: %v", input.AbilityRollID, id, err)
+		}
+	}
+
+	// Starting experience opens the character's ledger
+	if input.ExperiencePoints > 0 {
+		if err := c.xpService.SetExperience(r.Context(), id, userID, input.ExperiencePoints, "Opening balance"); err != nil {
+			logger.Error("Failed to record opening experience for character %d: %v", id, err)
+		}
+	}
+
 	// Get the created character
 	character, err := c.characterRepo.GetCharacter(r.Context(), id)
 	if err != nil {
@@ -274,20 +351,28 @@ func (c *CharacterController) UpdateCharacter(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// For class-specific level adjustments based on experience points
-	if input.ExperiencePoints != existingCharacter.ExperiencePoints {
-		// Get all class level data
-		classLevelData, err := c.classService.GetAllClassLevelData(r.Context(), input.Class)
-		if err == nil {
-			// Find the appropriate level for the XP
-			for i := len(classLevelData) - 1; i >= 0; i-- {
-				if input.ExperiencePoints >= classLevelData[i].ExperiencePoints {
-					input.Level = classLevelData[i].Level
-					break
-				}
-			}
-		}
+	// Kindred is optional in updates; omitting it keeps the current one
+	if input.Kindred == "" {
+		input.Kindred = existingCharacter.Kindred
+	}
+	if err := c.classService.ValidateKindred(r.Context(), input.Kindred, input.Class); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	// Levels are gained through the level-up endpoint so hit points are
+	// rolled, and experience is kept by the ledger below
+	if input.Level != existingCharacter.Level {
+		apperrors.HandleError(w, apperrors.NewValidationError("level", "Level changes must go through level-up"))
+		return
+	}
+	// Damage and healing change the character's vitality, so they go through modify-hp
+	if input.CurrentHitPoints != existingCharacter.CurrentHitPoints {
+		apperrors.HandleError(w, apperrors.NewValidationError("current_hit_points", "Current hit point changes must go through modify-hp"))
+		return
 	}
+	experiencePoints := input.ExperiencePoints
+	input.ExperiencePoints = existingCharacter.ExperiencePoints
 
 	// Update the character
 	err = c.characterRepo.UpdateCharacter(r.Context(), id, &input)
@@ -296,6 +381,19 @@ func (c *CharacterController) UpdateCharacter(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// Record edited experience in the ledger, which updates the character
+	if experiencePoints != existingCharacter.ExperiencePoints {
+		userID, err := currentUserID(r)
+		if err != nil {
+			apperrors.HandleError(w, err)
+			return
+		}
+		if err := c.xpService.SetExperience(r.Context(), id, userID, experiencePoints, "Manual adjustment"); err != nil {
+			apperrors.HandleError(w, err)
+			return
+		}
+	}
+
 	// Get the updated character
 	character, err := c.characterRepo.GetCharacter(r.Context(), id)
 	if err != nil {
@@ -315,14 +413,14 @@ func (c *CharacterController) DeleteCharacter(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// Check if the character exists
-	_, err = c.characterRepo.GetCharacter(r.Context(), id)
+	// Only the owner may delete a character, even if others have been granted access
+	userID, err := currentUserID(r)
 	if err != nil {
-		if errors.Is(err, apperrors.ErrNotFound) {
-			apperrors.HandleError(w, apperrors.NewNotFound("character", id))
-			return
-		}
-		apperrors.HandleError(w, apperrors.NewInternalError(err))
+		apperrors.HandleError(w, err)
+		return
+	}
+	if err := c.accessService.AuthorizeCharacterOwner(r.Context(), userID, id); err != nil {
+		apperrors.HandleError(w, err)
 		return
 	}
 
@@ -457,11 +555,20 @@ func (c *CharacterController) RenderEditForm(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	// Check if the character belongs to the current user
-	if character.UserID != userID {
+	// Check if the current user may edit the character
+	allowed, err := c.accessService.CanAccessCharacter(r.Context(), userID, character, true)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+		return
+	}
+	if !allowed {
 		http.Error(w, "Unauthorized access to this character", http.StatusForbidden)
 		return
 	}
+	if character.IsReadOnly() {
+		http.Error(w, character.ReadOnlyReason(), http.StatusConflict)
+		return
+	}
 
 	data := map[string]interface{}{
 		"Character": character,
@@ -496,11 +603,6 @@ func (c *CharacterController) UpdateCharacterHP(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// Current HP cannot be less than -10 (death)
-	if input.CurrentHitPoints < -10 {
-		input.CurrentHitPoints = -10
-	}
-
 	// Temp HP cannot be negative
 	if input.TemporaryHitPoints < 0 {
 		input.TemporaryHitPoints = 0
@@ -517,10 +619,17 @@ func (c *CharacterController) UpdateCharacterHP(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// Damage and healing change the character's vitality, so they go through modify-hp
+	if input.CurrentHitPoints != existingChar.CurrentHitPoints {
+		apperrors.HandleError(w, apperrors.NewValidationError("current_hit_points", "Current hit point changes must go through modify-hp"))
+		return
+	}
+
 	// Create update input with just the HP fields changed
 	updateInput := models.UpdateCharacterInput{
 		Name:               existingChar.Name,
 		Class:              existingChar.Class,
+		Kindred:            existingChar.Kindred,
 		Level:              existingChar.Level,
 		ExperiencePoints:   existingChar.ExperiencePoints,
 		Strength:           existingChar.Strength,
@@ -530,7 +639,7 @@ func (c *CharacterController) UpdateCharacterHP(w http.ResponseWriter, r *http.R
 		Intelligence:       existingChar.Intelligence,
 		Charisma:           existingChar.Charisma,
 		MaxHitPoints:       input.MaxHitPoints,
-		CurrentHitPoints:   input.CurrentHitPoints,
+		CurrentHitPoints:   existingChar.CurrentHitPoints,
 		TemporaryHitPoints: input.TemporaryHitPoints,
 	}
 
@@ -570,86 +679,11 @@ func (c *CharacterController) ModifyCharacterHP(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// Get existing character
-	existingChar, err := c.characterRepo.GetCharacter(r.Context(), id)
-	if err != nil {
-		if errors.Is(err, apperrors.ErrNotFound) {
-			apperrors.HandleError(w, apperrors.NewNotFound("character", id))
-			return
-		}
-		apperrors.HandleError(w, apperrors.NewInternalError(err))
-		return
-	}
-
-	newCurrentHP := existingChar.CurrentHitPoints
-	newTempHP := existingChar.TemporaryHitPoints
-
-	if input.Delta < 0 {
-		// Taking damage
-		damageAmount := -input.Delta
-
-		// Apply to temp HP first
-		if newTempHP > 0 {
-			if damageAmount <= newTempHP {
-				newTempHP -= damageAmount
-				damageAmount = 0
-			} else {
-				damageAmount -= newTempHP
-				newTempHP = 0
-			}
-		}
-
-		// Apply remaining damage to current HP
-		if damageAmount > 0 {
-			if newCurrentHP-damageAmount < -10 {
-				newCurrentHP = -10
-			} else {
-				newCurrentHP = newCurrentHP - damageAmount
-			}
-		}
-	} else if input.Delta > 0 {
-		// Healing or temp HP
-		if input.Temp {
-			// Adding temporary hit points
-			newTempHP += input.Delta
-		} else {
-			// Regular healing
-			if newCurrentHP+input.Delta > existingChar.MaxHitPoints {
-				newCurrentHP = existingChar.MaxHitPoints
-			} else {
-				newCurrentHP = newCurrentHP + input.Delta
-			}
-		}
-	}
-
-	// Create update input with the new HP value
-	updateInput := models.UpdateCharacterInput{
-		Name:               existingChar.Name,
-		Class:              existingChar.Class,
-		Level:              existingChar.Level,
-		ExperiencePoints:   existingChar.ExperiencePoints,
-		Strength:           existingChar.Strength,
-		Dexterity:          existingChar.Dexterity,
-		Constitution:       existingChar.Constitution,
-		Wisdom:             existingChar.Wisdom,
-		Intelligence:       existingChar.Intelligence,
-		Charisma:           existingChar.Charisma,
-		MaxHitPoints:       existingChar.MaxHitPoints,
-		CurrentHitPoints:   newCurrentHP,
-		TemporaryHitPoints: newTempHP,
-	}
-
-	// Update the character
-	err = c.characterRepo.UpdateCharacter(r.Context(), id, &updateInput)
+	// Damage and healing move the character between healthy, unconscious,
+	// dying and dead; the dead must be resurrected before they can be healed
+	character, err := c.vitalityService.ModifyHitPoints(r.Context(), id, input.Delta, input.Temp)
 	if err != nil {
-		apperrors.HandleError(w, apperrors.NewInternalError(err))
-		return
-	}
-
-	// Get the updated character
-	character, err := c.characterRepo.GetCharacter(r.Context(), id)
-	if err != nil {
-		apperrors.HandleError(w, apperrors.NewInternalError(err))
+		apperrors.HandleError(w, err)
 		return
 	}
 
@@ -680,51 +714,17 @@ func (c *CharacterController) UpdateCharacterXP(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// Get existing character to preserve other fields
-	existingChar, err := c.characterRepo.GetCharacter(r.Context(), id)
+	userID, err := currentUserID(r)
 	if err != nil {
-		if errors.Is(err, apperrors.ErrNotFound) {
-			apperrors.HandleError(w, apperrors.NewNotFound("character", id))
-			return
-		}
-		apperrors.HandleError(w, apperrors.NewInternalError(err))
+		apperrors.HandleError(w, err)
 		return
 	}
 
-	// Get all class level data
-	newLevel := existingChar.Level
-	classLevelData, err := c.classService.GetAllClassLevelData(r.Context(), existingChar.Class)
-	if err == nil {
-		// Find the appropriate level for the XP
-		for i := len(classLevelData) - 1; i >= 0; i-- {
-			if input.ExperiencePoints >= classLevelData[i].ExperiencePoints {
-				newLevel = classLevelData[i].Level
-				break
-			}
-		}
-	}
-
-	// Create update input with updated XP and potentially level
-	updateInput := models.UpdateCharacterInput{
-		Name:               existingChar.Name,
-		Class:              existingChar.Class,
-		Level:              newLevel,
-		ExperiencePoints:   input.ExperiencePoints,
-		Strength:           existingChar.Strength,
-		Dexterity:          existingChar.Dexterity,
-		Constitution:       existingChar.Constitution,
-		Wisdom:             existingChar.Wisdom,
-		Intelligence:       existingChar.Intelligence,
-		Charisma:           existingChar.Charisma,
-		MaxHitPoints:       existingChar.MaxHitPoints,
-		CurrentHitPoints:   existingChar.CurrentHitPoints,
-		TemporaryHitPoints: existingChar.TemporaryHitPoints,
-	}
-
-	// Update the character
-	err = c.characterRepo.UpdateCharacter(r.Context(), id, &updateInput)
-	if err != nil {
-		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	// Experience is derived from the ledger, so a direct edit is recorded as an
+	// adjustment. Level is left alone; advancing is done through the level-up
+	// endpoint so hit points are rolled and new class features recorded.
+	if err := c.xpService.SetExperience(r.Context(), id, userID, input.ExperiencePoints, "Manual adjustment"); err != nil {
+		apperrors.HandleError(w, err)
 		return
 	}
 
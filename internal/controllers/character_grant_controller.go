@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+	"mordezzanV4/internal/services"
+)
+
+// CharacterGrantController handles sharing characters with other users
+type CharacterGrantController struct {
+	accessService *services.CharacterAccessService
+	userRepo      repositories.UserRepository
+}
+
+// NewCharacterGrantController creates a new character grant controller
+func NewCharacterGrantController(accessService *services.CharacterAccessService, userRepo repositories.UserRepository) *CharacterGrantController {
+	return &CharacterGrantController{
+		accessService: accessService,
+		userRepo:      userRepo,
+	}
+}
+
+// GetCharacterGrants lists the users a character has been shared with
+func (c *CharacterGrantController) GetCharacterGrants(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	grants, err := c.accessService.GetGrants(r.Context(), characterID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(grants); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// SaveCharacterGrant shares a character with another user or changes their grant type
+func (c *CharacterGrantController) SaveCharacterGrant(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	if err := c.authorizeOwner(r, characterID); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	var input models.CreateCharacterGrantInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+	input.CharacterID = characterID
+
+	if err := input.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			validationErrors := map[string]string{
+				validationErr.Field: validationErr.Message,
+			}
+			apperrors.HandleValidationErrors(w, validationErrors)
+			return
+		}
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	if _, err := c.userRepo.GetUser(r.Context(), input.UserID); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	if err := c.accessService.SaveGrant(r.Context(), &input); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	grants, err := c.accessService.GetGrants(r.Context(), characterID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(grants); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// RevokeCharacterGrant removes another user's access to a character
+func (c *CharacterGrantController) RevokeCharacterGrant(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	userID, err := strconv.ParseInt(chi.URLParam(r, "userId"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid user ID format"))
+		return
+	}
+
+	if err := c.authorizeOwner(r, characterID); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	if err := c.accessService.RevokeGrant(r.Context(), characterID, userID); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *CharacterGrantController) authorizeOwner(r *http.Request, characterID int64) error {
+	userID, err := currentUserID(r)
+	if err != nil {
+		return err
+	}
+	return c.accessService.AuthorizeCharacterOwner(r.Context(), userID, characterID)
+}
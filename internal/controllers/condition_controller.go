@@ -0,0 +1,255 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+	"mordezzanV4/internal/services"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+)
+
+// ConditionController handles the conditions catalog and the conditions on
+// each character
+type ConditionController struct {
+	conditionRepo    repositories.ConditionRepository
+	conditionService *services.ConditionService
+}
+
+func NewConditionController(conditionRepo repositories.ConditionRepository, conditionService *services.ConditionService) *ConditionController {
+	return &ConditionController{
+		conditionRepo:    conditionRepo,
+		conditionService: conditionService,
+	}
+}
+
+func (c *ConditionController) GetCondition(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid condition ID format"))
+		return
+	}
+
+	condition, err := c.conditionRepo.GetCondition(r.Context(), id)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(condition); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+func (c *ConditionController) ListConditions(w http.ResponseWriter, r *http.Request) {
+	conditions, err := c.conditionRepo.ListConditions(r.Context())
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(conditions); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+func (c *ConditionController) CreateCondition(w http.ResponseWriter, r *http.Request) {
+	var input models.CreateConditionInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			validationErrors := map[string]string{
+				validationErr.Field: validationErr.Message,
+			}
+			apperrors.HandleValidationErrors(w, validationErrors)
+			return
+		}
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	existingCondition, err := c.conditionRepo.GetConditionByName(r.Context(), input.Name)
+	if err == nil && existingCondition != nil {
+		validationErrors := map[string]string{
+			"name": "Condition with this name already exists",
+		}
+		apperrors.HandleValidationErrors(w, validationErrors)
+		return
+	}
+
+	id, err := c.conditionRepo.CreateCondition(r.Context(), &input)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	condition, err := c.conditionRepo.GetCondition(r.Context(), id)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(condition); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+func (c *ConditionController) UpdateCondition(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid condition ID format"))
+		return
+	}
+
+	var input models.UpdateConditionInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			validationErrors := map[string]string{
+				validationErr.Field: validationErr.Message,
+			}
+			apperrors.HandleValidationErrors(w, validationErrors)
+			return
+		}
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	existingCondition, err := c.conditionRepo.GetConditionByName(r.Context(), input.Name)
+	if err == nil && existingCondition != nil && existingCondition.ID != id {
+		validationErrors := map[string]string{
+			"name": "Condition with this name already exists",
+		}
+		apperrors.HandleValidationErrors(w, validationErrors)
+		return
+	}
+
+	if err := c.conditionRepo.UpdateCondition(r.Context(), id, &input); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	updatedCondition, err := c.conditionRepo.GetCondition(r.Context(), id)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(updatedCondition); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+func (c *ConditionController) DeleteCondition(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid condition ID format"))
+		return
+	}
+
+	if err := c.conditionRepo.DeleteCondition(r.Context(), id); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetCharacterConditions lists the conditions currently on the character
+func (c *ConditionController) GetCharacterConditions(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	conditions, err := c.conditionService.GetCharacterConditions(r.Context(), characterID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(conditions); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// ApplyCondition puts a condition on the character
+func (c *ConditionController) ApplyCondition(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	var input models.ApplyConditionInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			apperrors.HandleValidationErrors(w, map[string]string{
+				validationErr.Field: validationErr.Message,
+			})
+			return
+		}
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	condition, err := c.conditionService.ApplyCondition(r.Context(), characterID, &input)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(condition); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// RemoveCharacterCondition ends a condition on the character
+func (c *ConditionController) RemoveCharacterCondition(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+	conditionID, err := strconv.ParseInt(chi.URLParam(r, "conditionId"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid condition ID format"))
+		return
+	}
+
+	if err := c.conditionService.RemoveCondition(r.Context(), characterID, conditionID); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,155 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/services"
+)
+
+// ContentPackController handles HTTP requests for homebrew content packs
+type ContentPackController struct {
+	packService *services.ContentPackService
+}
+
+// NewContentPackController creates a new content pack controller
+func NewContentPackController(packService *services.ContentPackService) *ContentPackController {
+	return &ContentPackController{
+		packService: packService,
+	}
+}
+
+// ListContentPacks handles listing installed content packs and their entries
+func (c *ContentPackController) ListContentPacks(w http.ResponseWriter, r *http.Request) {
+	packs, err := c.packService.ListContentPacks(r.Context())
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(packs); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// GetContentPack handles retrieving an installed content pack
+func (c *ContentPackController) GetContentPack(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid content pack ID format"))
+		return
+	}
+
+	pack, err := c.packService.GetContentPack(r.Context(), id)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(pack); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// ValidateContentPack handles checking a pack without installing it. The body
+// is either the pack as a JSON or YAML document or a zip archive of a pack
+// directory.
+func (c *ContentPackController) ValidateContentPack(w http.ResponseWriter, r *http.Request) {
+	pack, ok := c.readContentPack(w, r)
+	if !ok {
+		return
+	}
+
+	if err := c.packService.ValidateContentPack(r.Context(), pack); err != nil {
+		handleContentPackError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(models.ContentPackValidation{
+		Valid:     true,
+		Namespace: pack.Namespace,
+		Name:      pack.Name,
+		Version:   pack.Version,
+		Entries:   pack.Entries(),
+	}); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// InstallContentPack handles importing a content pack
+func (c *ContentPackController) InstallContentPack(w http.ResponseWriter, r *http.Request) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	pack, ok := c.readContentPack(w, r)
+	if !ok {
+		return
+	}
+
+	installed, err := c.packService.InstallContentPack(r.Context(), pack, userID)
+	if err != nil {
+		handleContentPackError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(installed); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// UninstallContentPack handles removing a content pack and everything it installed
+func (c *ContentPackController) UninstallContentPack(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid content pack ID format"))
+		return
+	}
+
+	if err := c.packService.UninstallContentPack(r.Context(), id); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *ContentPackController) readContentPack(w http.ResponseWriter, r *http.Request) (*models.ContentPack, bool) {
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, services.MaxContentPackSize))
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Content pack is too large or could not be read"))
+		return nil, false
+	}
+
+	pack, err := c.packService.ParseContentPack(data)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return nil, false
+	}
+	return pack, true
+}
+
+func handleContentPackError(w http.ResponseWriter, err error) {
+	var validationErr *models.ValidationError
+	if errors.As(err, &validationErr) {
+		apperrors.HandleValidationErrors(w, map[string]string{
+			validationErr.Field: validationErr.Message,
+		})
+		return
+	}
+	apperrors.HandleError(w, err)
+}
@@ -0,0 +1,101 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/services"
+)
+
+// DiceController handles HTTP requests for dice rolls
+type DiceController struct {
+	diceService *services.DiceService
+}
+
+// NewDiceController creates a new dice controller
+func NewDiceController(diceService *services.DiceService) *DiceController {
+	return &DiceController{
+		diceService: diceService,
+	}
+}
+
+// RollDice handles rolling an arbitrary dice expression
+func (c *DiceController) RollDice(w http.ResponseWriter, r *http.Request) {
+	var input models.RollDiceInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		handleRollValidationError(w, err)
+		return
+	}
+
+	roll, err := c.diceService.Roll(input.Expression, input.Seed)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(roll); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// RollForCharacter handles rolling an expression or a weapon's damage for a character
+func (c *DiceController) RollForCharacter(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	var input models.CharacterRollInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		handleRollValidationError(w, err)
+		return
+	}
+
+	var roll *services.DiceRoll
+	if input.InventoryItemID != 0 {
+		roll, err = c.diceService.RollWeaponDamage(r.Context(), characterID, input.InventoryItemID, input.TwoHanded, input.Seed)
+	} else {
+		roll, err = c.diceService.Roll(input.Expression, input.Seed)
+		if roll != nil {
+			roll.CharacterID = characterID
+		}
+	}
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(roll); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+func handleRollValidationError(w http.ResponseWriter, err error) {
+	var validationErr *models.ValidationError
+	if errors.As(err, &validationErr) {
+		apperrors.HandleValidationErrors(w, map[string]string{
+			validationErr.Field: validationErr.Message,
+		})
+		return
+	}
+	apperrors.HandleError(w, err)
+}
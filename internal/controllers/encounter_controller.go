@@ -0,0 +1,268 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/services"
+)
+
+// EncounterController handles HTTP requests for running combat encounters
+type EncounterController struct {
+	encounterService *services.EncounterService
+}
+
+// NewEncounterController creates a new encounter controller
+func NewEncounterController(encounterService *services.EncounterService) *EncounterController {
+	return &EncounterController{
+		encounterService: encounterService,
+	}
+}
+
+// ListEncounters lists the current user's encounters
+func (c *EncounterController) ListEncounters(w http.ResponseWriter, r *http.Request) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	encounters, err := c.encounterService.GetEncounters(r.Context(), userID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(encounters); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// CreateEncounter starts a new encounter for the current user
+func (c *EncounterController) CreateEncounter(w http.ResponseWriter, r *http.Request) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	var input models.CreateEncounterInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		handleEncounterError(w, err)
+		return
+	}
+
+	encounter, err := c.encounterService.CreateEncounter(r.Context(), userID, &input)
+	if err != nil {
+		handleEncounterError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(encounter); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// GetEncounter returns an encounter with its participants in initiative order
+func (c *EncounterController) GetEncounter(w http.ResponseWriter, r *http.Request) {
+	userID, encounterID, ok := parseEncounterParams(w, r)
+	if !ok {
+		return
+	}
+
+	encounter, err := c.encounterService.GetEncounter(r.Context(), userID, encounterID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(encounter); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// DeleteEncounter removes an encounter
+func (c *EncounterController) DeleteEncounter(w http.ResponseWriter, r *http.Request) {
+	userID, encounterID, ok := parseEncounterParams(w, r)
+	if !ok {
+		return
+	}
+
+	if err := c.encounterService.DeleteEncounter(r.Context(), userID, encounterID); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AddParticipant adds a character or monsters to an encounter
+func (c *EncounterController) AddParticipant(w http.ResponseWriter, r *http.Request) {
+	userID, encounterID, ok := parseEncounterParams(w, r)
+	if !ok {
+		return
+	}
+
+	var input models.AddParticipantInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		handleEncounterError(w, err)
+		return
+	}
+
+	encounter, err := c.encounterService.AddParticipants(r.Context(), userID, encounterID, &input)
+	if err != nil {
+		handleEncounterError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(encounter); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// RemoveParticipant takes a participant out of an encounter
+func (c *EncounterController) RemoveParticipant(w http.ResponseWriter, r *http.Request) {
+	userID, encounterID, ok := parseEncounterParams(w, r)
+	if !ok {
+		return
+	}
+	participantID, err := strconv.ParseInt(chi.URLParam(r, "participantId"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid participant ID format"))
+		return
+	}
+
+	if _, err := c.encounterService.RemoveParticipant(r.Context(), userID, encounterID, participantID); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RollInitiative checks surprise, rolls initiative and starts the first round
+func (c *EncounterController) RollInitiative(w http.ResponseWriter, r *http.Request) {
+	userID, encounterID, ok := parseEncounterParams(w, r)
+	if !ok {
+		return
+	}
+
+	var input models.RollInitiativeInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		handleEncounterError(w, err)
+		return
+	}
+
+	result, err := c.encounterService.RollInitiative(r.Context(), userID, encounterID, &input)
+	if err != nil {
+		handleEncounterError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// NextTurn passes the turn to the next participant able to act
+func (c *EncounterController) NextTurn(w http.ResponseWriter, r *http.Request) {
+	userID, encounterID, ok := parseEncounterParams(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := c.encounterService.NextTurn(r.Context(), userID, encounterID)
+	if err != nil {
+		handleEncounterError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// ModifyParticipantHP damages or heals a participant
+func (c *EncounterController) ModifyParticipantHP(w http.ResponseWriter, r *http.Request) {
+	userID, encounterID, ok := parseEncounterParams(w, r)
+	if !ok {
+		return
+	}
+	participantID, err := strconv.ParseInt(chi.URLParam(r, "participantId"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid participant ID format"))
+		return
+	}
+
+	var input models.ParticipantHPInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		handleEncounterError(w, err)
+		return
+	}
+
+	encounter, err := c.encounterService.ModifyParticipantHP(r.Context(), userID, encounterID, participantID, &input)
+	if err != nil {
+		handleEncounterError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(encounter); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+func parseEncounterParams(w http.ResponseWriter, r *http.Request) (int64, int64, bool) {
+	userID, err := currentUserID(r)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return 0, 0, false
+	}
+	encounterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid encounter ID format"))
+		return 0, 0, false
+	}
+	return userID, encounterID, true
+}
+
+func handleEncounterError(w http.ResponseWriter, err error) {
+	var validationErr *models.ValidationError
+	if errors.As(err, &validationErr) {
+		apperrors.HandleValidationErrors(w, map[string]string{
+			validationErr.Field: validationErr.Message,
+		})
+		return
+	}
+	apperrors.HandleError(w, err)
+}
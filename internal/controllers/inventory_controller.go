@@ -13,6 +13,7 @@ import (
 
 	"github.com/go-chi/chi"
 
+	"mordezzanV4/internal/dice"
 	apperrors "mordezzanV4/internal/errors"
 	"mordezzanV4/internal/logger"
 	"mordezzanV4/internal/models"
@@ -31,24 +32,29 @@ type InventoryController struct {
 	ringRepo           repositories.RingRepository
 	ammoRepo           repositories.AmmoRepository
 	spellScrollRepo    repositories.SpellScrollRepository
+	spellbookRepo      repositories.SpellbookRepository
 	containerRepo      repositories.ContainerRepository
 	equipmentRepo      repositories.EquipmentRepository
 	treasureRepo       repositories.TreasureRepository
 	encumbranceService *services.EncumbranceService
+	accessService      *services.CharacterAccessService
+	roller             *dice.Roller
 	tmpl               *template.Template
 }
 
 // EnrichedInventoryItem contains detailed item information
 type EnrichedInventoryItem struct {
-	ID          int64       `json:"id"`
-	InventoryID int64       `json:"inventory_id"`
-	ItemType    string      `json:"item_type"`
-	ItemID      int64       `json:"item_id"`
-	ItemDetails interface{} `json:"item_details"`
-	Quantity    int         `json:"quantity"`
-	IsEquipped  bool        `json:"is_equipped"`
-	Slot        string      `json:"slot,omitempty"`
-	Notes       string      `json:"notes,omitempty"`
+	ID            int64       `json:"id"`
+	InventoryID   int64       `json:"inventory_id"`
+	ItemType      string      `json:"item_type"`
+	ItemID        int64       `json:"item_id"`
+	ItemDetails   interface{} `json:"item_details"`
+	Quantity      int         `json:"quantity"`
+	IsEquipped    bool        `json:"is_equipped"`
+	Slot          string      `json:"slot,omitempty"`
+	Notes         string      `json:"notes,omitempty"`
+	Charges       *int        `json:"charges,omitempty"`
+	UsesRemaining *int        `json:"uses_remaining,omitempty"`
 }
 
 type EquipmentStatus struct {
@@ -75,10 +81,13 @@ func NewInventoryController(
 	ringRepo repositories.RingRepository,
 	ammoRepo repositories.AmmoRepository,
 	spellScrollRepo repositories.SpellScrollRepository,
+	spellbookRepo repositories.SpellbookRepository,
 	containerRepo repositories.ContainerRepository,
 	equipmentRepo repositories.EquipmentRepository,
 	treasureRepo repositories.TreasureRepository,
 	encumbranceService *services.EncumbranceService,
+	accessService *services.CharacterAccessService,
+	roller *dice.Roller,
 	tmpl *template.Template,
 ) *InventoryController {
 	return &InventoryController{
@@ -92,10 +101,13 @@ func NewInventoryController(
 		ringRepo:           ringRepo,
 		ammoRepo:           ammoRepo,
 		spellScrollRepo:    spellScrollRepo,
+		spellbookRepo:      spellbookRepo,
 		containerRepo:      containerRepo,
 		equipmentRepo:      equipmentRepo,
 		treasureRepo:       treasureRepo,
 		encumbranceService: encumbranceService,
+		accessService:      accessService,
+		roller:             roller,
 		tmpl:               tmpl,
 	}
 }
@@ -108,6 +120,11 @@ func (c *InventoryController) GetInventory(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	if err := c.authorizeInventory(r, id, false); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
 	inventory, err := c.inventoryRepo.GetInventory(r.Context(), id)
 	if err != nil {
 		apperrors.HandleError(w, err)
@@ -135,9 +152,8 @@ func (c *InventoryController) GetInventoryByCharacter(w http.ResponseWriter, r *
 		return
 	}
 
-	// Verify character exists first
-	_, err = c.characterRepo.GetCharacter(r.Context(), characterID)
-	if err != nil {
+	// Verify character exists and belongs to the caller
+	if err := c.authorizeCharacter(r, characterID, false); err != nil {
 		apperrors.HandleError(w, err)
 		return
 	}
@@ -227,12 +243,30 @@ func (c *InventoryController) GetInventoryByCharacter(w http.ResponseWriter, r *
 }
 
 func (c *InventoryController) ListInventories(w http.ResponseWriter, r *http.Request) {
-	inventories, err := c.inventoryRepo.ListInventories(r.Context())
+	userID, err := currentUserID(r)
 	if err != nil {
 		apperrors.HandleError(w, err)
 		return
 	}
 
+	allInventories, err := c.inventoryRepo.ListInventories(r.Context())
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	// Only return inventories for characters the caller can see
+	inventories := make([]*models.Inventory, 0, len(allInventories))
+	for _, inventory := range allInventories {
+		err := c.accessService.AuthorizeCharacter(r.Context(), userID, inventory.CharacterID, false)
+		if err == nil {
+			inventories = append(inventories, inventory)
+		} else if !apperrors.IsForbidden(err) && !apperrors.IsNotFound(err) {
+			apperrors.HandleError(w, err)
+			return
+		}
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	if err := json.NewEncoder(w).Encode(inventories); err != nil {
 		apperrors.HandleError(w, apperrors.NewInternalError(err))
@@ -259,13 +293,10 @@ func (c *InventoryController) CreateInventory(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// Check if character exists
-	if c.characterRepo != nil {
-		_, err := c.characterRepo.GetCharacter(r.Context(), input.CharacterID)
-		if err != nil {
-			apperrors.HandleError(w, err)
-			return
-		}
+	// Check if character exists and the caller may modify it
+	if err := c.authorizeCharacter(r, input.CharacterID, true); err != nil {
+		apperrors.HandleError(w, err)
+		return
 	}
 
 	id, err := c.inventoryRepo.CreateInventory(r.Context(), &input)
@@ -294,6 +325,11 @@ func (c *InventoryController) UpdateInventory(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	if err := c.authorizeInventory(r, id, true); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
 	var input models.UpdateInventoryInput
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
 		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
@@ -337,6 +373,11 @@ func (c *InventoryController) DeleteInventory(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	if err := c.authorizeInventory(r, id, true); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
 	if err := c.inventoryRepo.DeleteInventory(r.Context(), id); err != nil {
 		apperrors.HandleError(w, err)
 		return
@@ -359,6 +400,11 @@ func (c *InventoryController) GetInventoryItem(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	if err := c.authorizeInventory(r, item.InventoryID, false); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
 	// Enrich item with details
 	enrichedItem, err := c.enrichInventoryItem(r.Context(), *item)
 	if err != nil {
@@ -378,9 +424,8 @@ func (c *InventoryController) AddInventoryItem(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// Check if inventory exists
-	_, err = c.inventoryRepo.GetInventory(r.Context(), inventoryID)
-	if err != nil {
+	// Check if inventory exists and the caller may modify it
+	if err := c.authorizeInventory(r, inventoryID, true); err != nil {
 		apperrors.HandleError(w, err)
 		return
 	}
@@ -410,6 +455,24 @@ func (c *InventoryController) AddInventoryItem(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// Each wand, rod or staff tracks its own charges
+	if input.ItemType == models.MagicItemItemType {
+		if err := c.initialiseCharges(r.Context(), &input); err != nil {
+			apperrors.HandleError(w, err)
+			return
+		}
+	}
+
+	// Potions are added unopened unless told otherwise
+	if input.ItemType == models.PotionItemType && input.UsesRemaining == nil {
+		potion, err := c.potionRepo.GetPotion(r.Context(), input.ItemID)
+		if err != nil {
+			apperrors.HandleError(w, err)
+			return
+		}
+		input.UsesRemaining = &potion.Uses
+	}
+
 	// If we're adding it as equipped, validate slot assignment
 	if input.IsEquipped {
 		if err := c.validateEquipItem(r.Context(), inventoryID, input.ItemID, input.ItemType, input.Slot); err != nil {
@@ -502,6 +565,11 @@ func (c *InventoryController) UpdateInventoryItem(w http.ResponseWriter, r *http
 		return
 	}
 
+	if err := c.authorizeInventory(r, existingItem.InventoryID, true); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
 	// Handle equipment slot validation if we're equipping an item
 	if input.IsEquipped != nil && *input.IsEquipped && !existingItem.IsEquipped {
 		proposedSlot := ""
@@ -588,6 +656,11 @@ func (c *InventoryController) RemoveInventoryItem(w http.ResponseWriter, r *http
 		return
 	}
 
+	if err := c.authorizeInventory(r, existingItem.InventoryID, true); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
 	// Store the inventory ID before deleting the item
 	inventoryID := existingItem.InventoryID
 
@@ -631,6 +704,48 @@ func (c *InventoryController) RemoveInventoryItem(w http.ResponseWriter, r *http
 }
 
 // Helper methods
+func (c *InventoryController) authorizeCharacter(r *http.Request, characterID int64, write bool) error {
+	userID, err := currentUserID(r)
+	if err != nil {
+		return err
+	}
+	return c.accessService.AuthorizeCharacter(r.Context(), userID, characterID, write)
+}
+
+func (c *InventoryController) authorizeInventory(r *http.Request, inventoryID int64, write bool) error {
+	userID, err := currentUserID(r)
+	if err != nil {
+		return err
+	}
+	return c.accessService.AuthorizeInventory(r.Context(), userID, inventoryID, write)
+}
+
+// initialiseCharges sets a magic item's starting charges from the requested
+// value, a dice roll or the catalog entry, in that order of preference
+func (c *InventoryController) initialiseCharges(ctx context.Context, input *models.AddItemInput) error {
+	if input.Charges == nil && input.ChargesRoll != "" {
+		expr, err := dice.Parse(input.ChargesRoll)
+		if err != nil {
+			return apperrors.NewValidationError("charges_roll", err.Error())
+		}
+		charges := max(expr.Roll(c.roller).Total, 0)
+		input.Charges = &charges
+	}
+	if input.Charges == nil {
+		item, err := c.magicItemRepo.GetMagicItem(ctx, input.ItemID)
+		if err != nil {
+			return err
+		}
+		input.Charges = item.Charges
+	}
+
+	// Charges belong to a single item, so charged items never stack
+	if input.Charges != nil && input.Quantity > 1 {
+		return apperrors.NewValidationError("quantity", "Magic items with charges are added one at a time")
+	}
+	return nil
+}
+
 func (c *InventoryController) validateItemExists(ctx context.Context, itemType string, itemID int64) error {
 	switch itemType {
 	case "weapon":
@@ -681,6 +796,9 @@ func (c *InventoryController) validateItemExists(ctx context.Context, itemType s
 				return err
 			}
 		}
+	case models.SpellbookItemType:
+		// Spellbooks are individual books, created through the spellbook routes
+		return apperrors.NewBadRequest("Spellbooks are added through /characters/{id}/spellbooks")
 	case "container":
 		if c.containerRepo != nil {
 			if _, err := c.containerRepo.GetContainer(ctx, itemID); err != nil {
@@ -708,15 +826,17 @@ func (c *InventoryController) enrichInventoryItems(ctx context.Context, items []
 			logger.Error("Failed to enrich item %d of type %s: %v", item.ItemID, item.ItemType, err)
 			// Add the item without details
 			enrichedItems = append(enrichedItems, EnrichedInventoryItem{
-				ID:          item.ID,
-				InventoryID: item.InventoryID,
-				ItemType:    item.ItemType,
-				ItemID:      item.ItemID,
-				ItemDetails: nil,
-				Quantity:    item.Quantity,
-				IsEquipped:  item.IsEquipped,
-				Slot:        item.Slot, // Include the slot field
-				Notes:       item.Notes,
+				ID:            item.ID,
+				InventoryID:   item.InventoryID,
+				ItemType:      item.ItemType,
+				ItemID:        item.ItemID,
+				ItemDetails:   nil,
+				Quantity:      item.Quantity,
+				IsEquipped:    item.IsEquipped,
+				Slot:          item.Slot, // Include the slot field
+				Notes:         item.Notes,
+				Charges:       item.Charges,
+				UsesRemaining: item.UsesRemaining,
 			})
 			continue
 		}
@@ -762,6 +882,10 @@ func (c *InventoryController) enrichInventoryItem(ctx context.Context, item mode
 		if c.spellScrollRepo != nil {
 			details, err = c.spellScrollRepo.GetSpellScroll(ctx, item.ItemID)
 		}
+	case models.SpellbookItemType:
+		if c.spellbookRepo != nil {
+			details, err = c.spellbookRepo.GetSpellbook(ctx, item.ItemID)
+		}
 	case "container":
 		if c.containerRepo != nil {
 			details, err = c.containerRepo.GetContainer(ctx, item.ItemID)
@@ -791,20 +915,22 @@ func (c *InventoryController) enrichInventoryItem(ctx context.Context, item mode
 	}
 
 	return EnrichedInventoryItem{
-		ID:          item.ID,
-		InventoryID: item.InventoryID,
-		ItemType:    item.ItemType,
-		ItemID:      item.ItemID,
-		ItemDetails: details,
-		Quantity:    item.Quantity,
-		IsEquipped:  item.IsEquipped,
-		Slot:        item.Slot,
-		Notes:       item.Notes,
+		ID:            item.ID,
+		InventoryID:   item.InventoryID,
+		ItemType:      item.ItemType,
+		ItemID:        item.ItemID,
+		ItemDetails:   details,
+		Quantity:      item.Quantity,
+		IsEquipped:    item.IsEquipped,
+		Slot:          item.Slot,
+		Notes:         item.Notes,
+		Charges:       item.Charges,
+		UsesRemaining: item.UsesRemaining,
 	}, nil
 }
 
 func (c *InventoryController) GetEncumbranceStatus(w http.ResponseWriter, r *http.Request) {
-	characterID, err := strconv.ParseInt(chi.URLParam(r, "characterId"), 10, 64)
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
 	if err != nil {
 		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
 		return
@@ -834,7 +960,7 @@ func (c *InventoryController) GetEncumbranceStatus(w http.ResponseWriter, r *htt
 
 // RecalculateEncumbrance recalculates a character's inventory weights and encumbrance status
 func (c *InventoryController) RecalculateEncumbrance(w http.ResponseWriter, r *http.Request) {
-	characterID, err := strconv.ParseInt(chi.URLParam(r, "characterId"), 10, 64)
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
 	if err != nil {
 		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
 		return
@@ -870,7 +996,7 @@ func (c *InventoryController) RecalculateEncumbrance(w http.ResponseWriter, r *h
 
 // UpdateInventoryCapacity updates a character's inventory capacity manually
 func (c *InventoryController) UpdateInventoryCapacity(w http.ResponseWriter, r *http.Request) {
-	characterID, err := strconv.ParseInt(chi.URLParam(r, "characterId"), 10, 64)
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
 	if err != nil {
 		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
 		return
@@ -1265,15 +1391,31 @@ func (c *InventoryController) GetCombatEquipment(w http.ResponseWriter, r *http.
 
 	// Prepare response structure
 	response := struct {
-		Weapons []map[string]interface{} `json:"weapons"`
-		Armor   []map[string]interface{} `json:"armor"`
+		Weapons    []map[string]interface{} `json:"weapons"`
+		Armor      []map[string]interface{} `json:"armor"`
+		MagicItems []map[string]interface{} `json:"magic_items"`
 	}{
-		Weapons: []map[string]interface{}{},
-		Armor:   []map[string]interface{}{},
+		Weapons:    []map[string]interface{}{},
+		Armor:      []map[string]interface{}{},
+		MagicItems: []map[string]interface{}{},
 	}
 
 	// Process equipped items
 	for _, item := range inventory.Items {
+		// Wands, rods and staves can be brought to bear whenever they are carried
+		if item.ItemType == models.MagicItemItemType {
+			magicItem, err := c.magicItemRepo.GetMagicItem(r.Context(), item.ItemID)
+			if err == nil {
+				magicItemInfo := map[string]interface{}{
+					"inventory_item": item,
+					"magic_item":     magicItem,
+					"charges":        item.Charges,
+				}
+				response.MagicItems = append(response.MagicItems, magicItemInfo)
+			}
+			continue
+		}
+
 		if !item.IsEquipped {
 			continue
 		}
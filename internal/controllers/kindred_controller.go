@@ -0,0 +1,207 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+)
+
+type KindredController struct {
+	kindredRepo repositories.KindredRepository
+	classRepo   repositories.ClassRepository
+}
+
+func NewKindredController(kindredRepo repositories.KindredRepository, classRepo repositories.ClassRepository) *KindredController {
+	return &KindredController{
+		kindredRepo: kindredRepo,
+		classRepo:   classRepo,
+	}
+}
+
+// validateAllowedClasses checks that every allowed class has class rules, so
+// classes added by content packs are accepted along with the built-in ones
+func (c *KindredController) validateAllowedClasses(ctx context.Context, classes []string) (map[string]string, error) {
+	for _, class := range classes {
+		if _, err := c.classRepo.GetClassRules(ctx, class); err != nil {
+			if apperrors.IsNotFound(err) {
+				return map[string]string{"allowed_classes": "Unknown class: " + class}, nil
+			}
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func (c *KindredController) GetKindred(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid kindred ID format"))
+		return
+	}
+
+	kindred, err := c.kindredRepo.GetKindred(r.Context(), id)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(kindred); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+func (c *KindredController) ListKindreds(w http.ResponseWriter, r *http.Request) {
+	kindreds, err := c.kindredRepo.ListKindreds(r.Context())
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(kindreds); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+func (c *KindredController) CreateKindred(w http.ResponseWriter, r *http.Request) {
+	var input models.CreateKindredInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			validationErrors := map[string]string{
+				validationErr.Field: validationErr.Message,
+			}
+			apperrors.HandleValidationErrors(w, validationErrors)
+			return
+		}
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	validationErrors, err := c.validateAllowedClasses(r.Context(), input.AllowedClasses)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+	if validationErrors != nil {
+		apperrors.HandleValidationErrors(w, validationErrors)
+		return
+	}
+
+	existingKindred, err := c.kindredRepo.GetKindredByName(r.Context(), input.Name)
+	if err == nil && existingKindred != nil {
+		validationErrors := map[string]string{
+			"name": "Kindred with this name already exists",
+		}
+		apperrors.HandleValidationErrors(w, validationErrors)
+		return
+	}
+
+	id, err := c.kindredRepo.CreateKindred(r.Context(), &input)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	kindred, err := c.kindredRepo.GetKindred(r.Context(), id)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(kindred); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+func (c *KindredController) UpdateKindred(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid kindred ID format"))
+		return
+	}
+
+	var input models.UpdateKindredInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			validationErrors := map[string]string{
+				validationErr.Field: validationErr.Message,
+			}
+			apperrors.HandleValidationErrors(w, validationErrors)
+			return
+		}
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	validationErrors, err := c.validateAllowedClasses(r.Context(), input.AllowedClasses)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+	if validationErrors != nil {
+		apperrors.HandleValidationErrors(w, validationErrors)
+		return
+	}
+
+	existingKindred, err := c.kindredRepo.GetKindredByName(r.Context(), input.Name)
+	if err == nil && existingKindred != nil && existingKindred.ID != id {
+		validationErrors := map[string]string{
+			"name": "Kindred with this name already exists",
+		}
+		apperrors.HandleValidationErrors(w, validationErrors)
+		return
+	}
+
+	if err := c.kindredRepo.UpdateKindred(r.Context(), id, &input); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	updatedKindred, err := c.kindredRepo.GetKindred(r.Context(), id)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(updatedKindred); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+func (c *KindredController) DeleteKindred(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid kindred ID format"))
+		return
+	}
+
+	if err := c.kindredRepo.DeleteKindred(r.Context(), id); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
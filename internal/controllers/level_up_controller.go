@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/services"
+)
+
+// LevelUpController handles HTTP requests for advancing characters
+type LevelUpController struct {
+	levelUpService *services.LevelUpService
+}
+
+// NewLevelUpController creates a new level-up controller
+func NewLevelUpController(levelUpService *services.LevelUpService) *LevelUpController {
+	return &LevelUpController{
+		levelUpService: levelUpService,
+	}
+}
+
+// GetLevelUpStatus handles checking whether a character can level up
+func (c *LevelUpController) GetLevelUpStatus(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	status, err := c.levelUpService.GetLevelUpStatus(r.Context(), characterID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// LevelUp handles advancing a character by one level
+func (c *LevelUpController) LevelUp(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	levelUp, err := c.levelUpService.LevelUp(r.Context(), characterID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(levelUp); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// GetLevelUps handles retrieving a character's level-up history
+func (c *LevelUpController) GetLevelUps(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	levelUps, err := c.levelUpService.GetLevelUps(r.Context(), characterID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(levelUps); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
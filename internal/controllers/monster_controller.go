@@ -0,0 +1,207 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+)
+
+type MonsterController struct {
+	monsterRepo repositories.MonsterRepository
+}
+
+func NewMonsterController(monsterRepo repositories.MonsterRepository) *MonsterController {
+	return &MonsterController{
+		monsterRepo: monsterRepo,
+	}
+}
+
+func (c *MonsterController) GetMonster(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid monster ID format"))
+		return
+	}
+
+	monster, err := c.monsterRepo.GetMonster(r.Context(), id)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(monster); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// ListMonsters lists the bestiary, optionally filtered by the name, terrain,
+// min_hd and max_hd query parameters
+func (c *MonsterController) ListMonsters(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter := models.MonsterFilter{
+		Name:    query.Get("name"),
+		Terrain: query.Get("terrain"),
+	}
+	for param, target := range map[string]*int{"min_hd": &filter.MinHD, "max_hd": &filter.MaxHD} {
+		if value := query.Get(param); value != "" {
+			hd, err := strconv.Atoi(value)
+			if err != nil {
+				apperrors.HandleError(w, apperrors.NewBadRequest("Invalid "+param+" value"))
+				return
+			}
+			*target = hd
+		}
+	}
+
+	if err := filter.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			validationErrors := map[string]string{
+				validationErr.Field: validationErr.Message,
+			}
+			apperrors.HandleValidationErrors(w, validationErrors)
+			return
+		}
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	var monsters []*models.Monster
+	var err error
+	if filter == (models.MonsterFilter{}) {
+		monsters, err = c.monsterRepo.ListMonsters(r.Context())
+	} else {
+		monsters, err = c.monsterRepo.SearchMonsters(r.Context(), &filter)
+	}
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(monsters); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+func (c *MonsterController) CreateMonster(w http.ResponseWriter, r *http.Request) {
+	var input models.CreateMonsterInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			validationErrors := map[string]string{
+				validationErr.Field: validationErr.Message,
+			}
+			apperrors.HandleValidationErrors(w, validationErrors)
+			return
+		}
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	existingMonster, err := c.monsterRepo.GetMonsterByName(r.Context(), input.Name)
+	if err == nil && existingMonster != nil {
+		validationErrors := map[string]string{
+			"name": "Monster with this name already exists",
+		}
+		apperrors.HandleValidationErrors(w, validationErrors)
+		return
+	}
+
+	id, err := c.monsterRepo.CreateMonster(r.Context(), &input)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	monster, err := c.monsterRepo.GetMonster(r.Context(), id)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(monster); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+func (c *MonsterController) UpdateMonster(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid monster ID format"))
+		return
+	}
+
+	var input models.UpdateMonsterInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			validationErrors := map[string]string{
+				validationErr.Field: validationErr.Message,
+			}
+			apperrors.HandleValidationErrors(w, validationErrors)
+			return
+		}
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	existingMonster, err := c.monsterRepo.GetMonsterByName(r.Context(), input.Name)
+	if err == nil && existingMonster != nil && existingMonster.ID != id {
+		validationErrors := map[string]string{
+			"name": "Monster with this name already exists",
+		}
+		apperrors.HandleValidationErrors(w, validationErrors)
+		return
+	}
+
+	if err := c.monsterRepo.UpdateMonster(r.Context(), id, &input); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	updatedMonster, err := c.monsterRepo.GetMonster(r.Context(), id)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(updatedMonster); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+func (c *MonsterController) DeleteMonster(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid monster ID format"))
+		return
+	}
+
+	if err := c.monsterRepo.DeleteMonster(r.Context(), id); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
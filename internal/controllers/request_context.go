@@ -0,0 +1,23 @@
+package controllers
+
+import (
+	"net/http"
+
+	"mordezzanV4/internal/contextkeys"
+	apperrors "mordezzanV4/internal/errors"
+)
+
+// currentUserID returns the authenticated user's ID placed in the request context by the auth middleware
+func currentUserID(r *http.Request) (int64, error) {
+	userID, ok := r.Context().Value(contextkeys.UserIDKey).(int64)
+	if !ok || userID == 0 {
+		return 0, apperrors.NewUnauthorized("User not authenticated")
+	}
+	return userID, nil
+}
+
+// currentUserRole returns the authenticated user's role placed in the request context by the auth middleware
+func currentUserRole(r *http.Request) string {
+	role, _ := r.Context().Value(contextkeys.UserRoleKey).(string)
+	return role
+}
@@ -29,7 +29,7 @@ func NewSpellCastingController(spellService *services.SpellService) *SpellCastin
 
 // GetCharacterSpellsInfo retrieves all spell-related information for a character
 func (c *SpellCastingController) GetCharacterSpellsInfo(w http.ResponseWriter, r *http.Request) {
-	characterID, err := strconv.ParseInt(chi.URLParam(r, "characterId"), 10, 64)
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
 	if err != nil {
 		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
 		return
@@ -49,7 +49,7 @@ func (c *SpellCastingController) GetCharacterSpellsInfo(w http.ResponseWriter, r
 
 // AddKnownSpell adds a spell to a character's known spells
 func (c *SpellCastingController) AddKnownSpell(w http.ResponseWriter, r *http.Request) {
-	characterID, err := strconv.ParseInt(chi.URLParam(r, "characterId"), 10, 64)
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
 	if err != nil {
 		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
 		return
@@ -100,7 +100,7 @@ func (c *SpellCastingController) AddKnownSpell(w http.ResponseWriter, r *http.Re
 
 // RemoveKnownSpell removes a spell from a character's known spells
 func (c *SpellCastingController) RemoveKnownSpell(w http.ResponseWriter, r *http.Request) {
-	characterID, err := strconv.ParseInt(chi.URLParam(r, "characterId"), 10, 64)
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
 	if err != nil {
 		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
 		return
@@ -123,7 +123,7 @@ func (c *SpellCastingController) RemoveKnownSpell(w http.ResponseWriter, r *http
 
 // PrepareSpell prepares a spell for a character
 func (c *SpellCastingController) PrepareSpell(w http.ResponseWriter, r *http.Request) {
-	characterID, err := strconv.ParseInt(chi.URLParam(r, "characterId"), 10, 64)
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
 	if err != nil {
 		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
 		return
@@ -153,7 +153,7 @@ func (c *SpellCastingController) PrepareSpell(w http.ResponseWriter, r *http.Req
 
 	id, err := c.spellService.PrepareSpell(r.Context(), &input)
 	if err != nil {
-		apperrors.HandleError(w, apperrors.NewInternalError(err))
+		apperrors.HandleError(w, err)
 		return
 	}
 
@@ -176,7 +176,7 @@ func (c *SpellCastingController) PrepareSpell(w http.ResponseWriter, r *http.Req
 
 // UnprepareSpell removes a prepared spell
 func (c *SpellCastingController) UnprepareSpell(w http.ResponseWriter, r *http.Request) {
-	characterID, err := strconv.ParseInt(chi.URLParam(r, "characterId"), 10, 64)
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
 	if err != nil {
 		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
 		return
@@ -188,9 +188,23 @@ func (c *SpellCastingController) UnprepareSpell(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	err = c.spellService.UnprepareSpell(r.Context(), characterID, spellID)
+	input := models.UnprepareSpellInput{
+		CharacterID: characterID,
+		SpellID:     spellID,
+	}
+
+	// An optional slot_index picks which of several slots holding the spell to free
+	if slot := r.URL.Query().Get("slot_index"); slot != "" {
+		input.SlotIndex, err = strconv.Atoi(slot)
+		if err != nil || input.SlotIndex <= 0 {
+			apperrors.HandleError(w, apperrors.NewBadRequest("Invalid slot index"))
+			return
+		}
+	}
+
+	err = c.spellService.UnprepareSpell(r.Context(), &input)
 	if err != nil {
-		apperrors.HandleError(w, apperrors.NewInternalError(err))
+		apperrors.HandleError(w, err)
 		return
 	}
 
@@ -209,7 +223,7 @@ func (c *SpellCastingController) UnprepareSpell(w http.ResponseWriter, r *http.R
 
 // ClearPreparedSpells removes all prepared spells for a character
 func (c *SpellCastingController) ClearPreparedSpells(w http.ResponseWriter, r *http.Request) {
-	characterID, err := strconv.ParseInt(chi.URLParam(r, "characterId"), 10, 64)
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
 	if err != nil {
 		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
 		return
@@ -226,7 +240,7 @@ func (c *SpellCastingController) ClearPreparedSpells(w http.ResponseWriter, r *h
 
 // PrepareAllSpells prepares all spells a character can prepare
 func (c *SpellCastingController) PrepareAllSpells(w http.ResponseWriter, r *http.Request) {
-	characterID, err := strconv.ParseInt(chi.URLParam(r, "characterId"), 10, 64)
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
 	if err != nil {
 		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
 		return
@@ -251,9 +265,129 @@ func (c *SpellCastingController) PrepareAllSpells(w http.ResponseWriter, r *http
 	}
 }
 
+// CastSpell expends a prepared spell and logs the casting
+func (c *SpellCastingController) CastSpell(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	var input models.CastSpellInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	// Set character ID from URL param
+	input.CharacterID = characterID
+
+	if err := input.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			apperrors.HandleValidationErrors(w, map[string]string{
+				validationErr.Field: validationErr.Message,
+			})
+			return
+		}
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	cast, err := c.spellService.CastSpell(r.Context(), &input)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	// Return updated spell info
+	spellsInfo, err := c.spellService.GetCharacterSpellsInfo(r.Context(), characterID)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(map[string]interface{}{
+		"cast":        cast,
+		"spells_info": spellsInfo,
+	}); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// GetSpellCasts lists a character's castings, most recent first
+func (c *SpellCastingController) GetSpellCasts(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	casts, err := c.spellService.GetSpellCasts(r.Context(), characterID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(casts); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// Rest recovers expended spells after rest and study or prayer
+func (c *SpellCastingController) Rest(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	var input models.RestInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			apperrors.HandleValidationErrors(w, map[string]string{
+				validationErr.Field: validationErr.Message,
+			})
+			return
+		}
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	rest, err := c.spellService.Rest(r.Context(), characterID, &input)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	// Return updated spell info
+	spellsInfo, err := c.spellService.GetCharacterSpellsInfo(r.Context(), characterID)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(map[string]interface{}{
+		"rest":        rest,
+		"spells_info": spellsInfo,
+	}); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
 // GetSpellsLearnableOnLevelUp gets spells a character can learn when leveling up
 func (c *SpellCastingController) GetSpellsLearnableOnLevelUp(w http.ResponseWriter, r *http.Request) {
-	characterID, err := strconv.ParseInt(chi.URLParam(r, "characterId"), 10, 64)
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
 	if err != nil {
 		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
 		return
@@ -280,7 +414,7 @@ func (c *SpellCastingController) GetSpellsLearnableOnLevelUp(w http.ResponseWrit
 
 // AddInitialSpellsForNewCharacter adds the starting spells for a new character
 func (c *SpellCastingController) AddInitialSpellsForNewCharacter(w http.ResponseWriter, r *http.Request) {
-	characterID, err := strconv.ParseInt(chi.URLParam(r, "characterId"), 10, 64)
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
 	if err != nil {
 		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
 		return
@@ -0,0 +1,96 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/services"
+)
+
+// SpellLearningController handles HTTP requests for learning spells from
+// scrolls and spellbooks
+type SpellLearningController struct {
+	spellLearningService *services.SpellLearningService
+}
+
+// NewSpellLearningController creates a new spell learning controller
+func NewSpellLearningController(spellLearningService *services.SpellLearningService) *SpellLearningController {
+	return &SpellLearningController{
+		spellLearningService: spellLearningService,
+	}
+}
+
+// LearnSpell rolls to learn a spell from a scroll or another caster's spellbook
+func (c *SpellLearningController) LearnSpell(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	userID, err := currentUserID(r)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	var input models.LearnSpellInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		handleSpellLearningError(w, err)
+		return
+	}
+
+	result, err := c.spellLearningService.LearnSpell(r.Context(), characterID, userID, &input)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// GetLearningAttempts lists a character's attempts to learn spells
+func (c *SpellLearningController) GetLearningAttempts(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	attempts, err := c.spellLearningService.GetLearningAttempts(r.Context(), characterID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(attempts); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+func handleSpellLearningError(w http.ResponseWriter, err error) {
+	var validationErr *models.ValidationError
+	if errors.As(err, &validationErr) {
+		apperrors.HandleValidationErrors(w, map[string]string{
+			validationErr.Field: validationErr.Message,
+		})
+		return
+	}
+	apperrors.HandleError(w, err)
+}
@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/services"
+)
+
+// SpellbookController handles HTTP requests for a character's spellbooks
+type SpellbookController struct {
+	spellbookService *services.SpellbookService
+}
+
+// NewSpellbookController creates a new spellbook controller
+func NewSpellbookController(spellbookService *services.SpellbookService) *SpellbookController {
+	return &SpellbookController{
+		spellbookService: spellbookService,
+	}
+}
+
+// ListSpellbooks lists the spellbooks in a character's inventory
+func (c *SpellbookController) ListSpellbooks(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	books, err := c.spellbookService.ListSpellbooks(r.Context(), characterID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(books); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// GetSpellbook retrieves one of a character's spellbooks with its spells
+func (c *SpellbookController) GetSpellbook(w http.ResponseWriter, r *http.Request) {
+	characterID, spellbookID, ok := parseSpellbookParams(w, r)
+	if !ok {
+		return
+	}
+
+	book, err := c.spellbookService.GetSpellbook(r.Context(), characterID, spellbookID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(book); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// CreateSpellbook adds a new spellbook to a character's inventory
+func (c *SpellbookController) CreateSpellbook(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	var input models.CreateSpellbookInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	input.ApplyDefaults()
+	if err := input.Validate(); err != nil {
+		handleSpellbookError(w, err)
+		return
+	}
+
+	book, err := c.spellbookService.CreateSpellbook(r.Context(), characterID, &input)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(book); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// ScribeSpell copies a known spell into a spellbook
+func (c *SpellbookController) ScribeSpell(w http.ResponseWriter, r *http.Request) {
+	characterID, spellbookID, ok := parseSpellbookParams(w, r)
+	if !ok {
+		return
+	}
+
+	var input models.ScribeSpellInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		handleSpellbookError(w, err)
+		return
+	}
+
+	book, err := c.spellbookService.ScribeSpell(r.Context(), characterID, spellbookID, &input)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(book); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// EraseSpell removes a spell from a spellbook
+func (c *SpellbookController) EraseSpell(w http.ResponseWriter, r *http.Request) {
+	characterID, spellbookID, ok := parseSpellbookParams(w, r)
+	if !ok {
+		return
+	}
+
+	spellID, err := strconv.ParseInt(chi.URLParam(r, "spellId"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid spell ID format"))
+		return
+	}
+
+	book, err := c.spellbookService.EraseSpell(r.Context(), characterID, spellbookID, spellID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(book); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+func parseSpellbookParams(w http.ResponseWriter, r *http.Request) (int64, int64, bool) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return 0, 0, false
+	}
+	spellbookID, err := strconv.ParseInt(chi.URLParam(r, "bookId"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid spellbook ID format"))
+		return 0, 0, false
+	}
+	return characterID, spellbookID, true
+}
+
+func handleSpellbookError(w http.ResponseWriter, err error) {
+	var validationErr *models.ValidationError
+	if errors.As(err, &validationErr) {
+		apperrors.HandleValidationErrors(w, map[string]string{
+			validationErr.Field: validationErr.Message,
+		})
+		return
+	}
+	apperrors.HandleError(w, err)
+}
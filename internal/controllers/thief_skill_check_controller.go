@@ -0,0 +1,86 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/services"
+)
+
+// ThiefSkillCheckController handles HTTP requests for rolling thief skills
+type ThiefSkillCheckController struct {
+	checkService *services.ThiefSkillCheckService
+}
+
+// NewThiefSkillCheckController creates a new thief skill check controller
+func NewThiefSkillCheckController(checkService *services.ThiefSkillCheckService) *ThiefSkillCheckController {
+	return &ThiefSkillCheckController{
+		checkService: checkService,
+	}
+}
+
+// GetThiefSkillChecks returns the character's past skill checks
+func (c *ThiefSkillCheckController) GetThiefSkillChecks(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	checks, err := c.checkService.GetChecks(r.Context(), characterID)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(checks); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
+// CheckThiefSkill rolls one of the character's thief skills and records the
+// outcome
+func (c *ThiefSkillCheckController) CheckThiefSkill(w http.ResponseWriter, r *http.Request) {
+	characterID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid character ID format"))
+		return
+	}
+
+	var input models.ThiefSkillCheckInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		var validationErr *models.ValidationError
+		if errors.As(err, &validationErr) {
+			apperrors.HandleValidationErrors(w, map[string]string{
+				validationErr.Field: validationErr.Message,
+			})
+			return
+		}
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	check, err := c.checkService.CheckSkill(r.Context(), characterID, &input)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(check); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
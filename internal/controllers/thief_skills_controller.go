@@ -148,16 +148,17 @@ func (c *ThiefSkillsController) GetThiefSkillsForCharacter(w http.ResponseWriter
 	// Output the literal class string for debugging
 	logger.Debug("Character class string: '%s'", character.Class)
 
-	// Build attributes map
+	// Build attributes map from the current scores
+	scores := character.EffectiveAttributes()
 	attributes := map[string]int{
-		"DX": int(character.Dexterity),
-		"IN": int(character.Intelligence),
-		"WS": int(character.Wisdom),
+		"DX": scores.Dexterity,
+		"IN": scores.Intelligence,
+		"WS": scores.Wisdom,
 	}
 	logger.Debug("Character attributes - DX: %d, IN: %d, WS: %d", attributes["DX"], attributes["IN"], attributes["WS"])
 
-	// Convert character.Level (int) to int64
-	level := int64(character.Level)
+	// Drained levels lower the character's skills
+	level := int64(character.EffectiveLevel())
 
 	// Get thief skills for the character
 	skills, err := c.thiefSkillsService.GetThiefSkillsForCharacter(
@@ -193,16 +194,17 @@ func (c *ThiefSkillsController) RenderThiefSkillsTab(w http.ResponseWriter, r *h
 	}
 	logger.Debug("Successfully fetched character: %s (Class: %s, Level: %d)", character.Name, character.Class, character.Level)
 
-	// Build attributes map
+	// Build attributes map from the current scores
+	scores := character.EffectiveAttributes()
 	attributes := map[string]int{
-		"DX": int(character.Dexterity),
-		"IN": int(character.Intelligence),
-		"WS": int(character.Wisdom),
+		"DX": scores.Dexterity,
+		"IN": scores.Intelligence,
+		"WS": scores.Wisdom,
 	}
 	logger.Debug("Character attributes - DX: %d, IN: %d, WS: %d", attributes["DX"], attributes["IN"], attributes["WS"])
 
-	// Convert character.Level (int) to int64
-	level := int64(character.Level)
+	// Drained levels lower the character's skills
+	level := int64(character.EffectiveLevel())
 
 	// Get thief skills for the character
 	skills, err := c.thiefSkillsService.GetThiefSkillsForCharacter(
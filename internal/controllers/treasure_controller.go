@@ -7,6 +7,7 @@ import (
 	apperrors "mordezzanV4/internal/errors"
 	"mordezzanV4/internal/models"
 	"mordezzanV4/internal/repositories"
+	"mordezzanV4/internal/services"
 	"net/http"
 	"strconv"
 	"strings"
@@ -16,14 +17,14 @@ import (
 
 type TreasureController struct {
 	treasureRepo  repositories.TreasureRepository
-	characterRepo repositories.CharacterRepository
+	accessService *services.CharacterAccessService
 	tmpl          *template.Template
 }
 
-func NewTreasureController(treasureRepo repositories.TreasureRepository, characterRepo repositories.CharacterRepository, tmpl *template.Template) *TreasureController {
+func NewTreasureController(treasureRepo repositories.TreasureRepository, accessService *services.CharacterAccessService, tmpl *template.Template) *TreasureController {
 	return &TreasureController{
 		treasureRepo:  treasureRepo,
-		characterRepo: characterRepo,
+		accessService: accessService,
 		tmpl:          tmpl,
 	}
 }
@@ -40,6 +41,10 @@ func (c *TreasureController) GetTreasure(w http.ResponseWriter, r *http.Request)
 		apperrors.HandleError(w, err)
 		return
 	}
+	if err := c.authorizeTreasure(r, treasure, false); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
 
 	accept := r.Header.Get("Accept")
 	if strings.Contains(accept, "application/json") {
@@ -62,13 +67,10 @@ func (c *TreasureController) GetTreasureByCharacter(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// Check if character exists
-	if c.characterRepo != nil {
-		_, err := c.characterRepo.GetCharacter(r.Context(), characterID)
-		if err != nil {
-			apperrors.HandleError(w, err)
-			return
-		}
+	// Check the character exists and the caller may see it
+	if err := c.authorizeCharacter(r, characterID, false); err != nil {
+		apperrors.HandleError(w, err)
+		return
 	}
 
 	treasure, err := c.treasureRepo.GetTreasureByCharacter(r.Context(), characterID)
@@ -84,12 +86,24 @@ func (c *TreasureController) GetTreasureByCharacter(w http.ResponseWriter, r *ht
 }
 
 func (c *TreasureController) ListTreasures(w http.ResponseWriter, r *http.Request) {
-	treasures, err := c.treasureRepo.ListTreasures(r.Context())
+	allTreasures, err := c.treasureRepo.ListTreasures(r.Context())
 	if err != nil {
 		apperrors.HandleError(w, err)
 		return
 	}
 
+	// Only return treasure the caller can see
+	treasures := make([]*models.Treasure, 0, len(allTreasures))
+	for _, treasure := range allTreasures {
+		err := c.authorizeTreasure(r, treasure, false)
+		if err == nil {
+			treasures = append(treasures, treasure)
+		} else if !apperrors.IsForbidden(err) && !apperrors.IsNotFound(err) {
+			apperrors.HandleError(w, err)
+			return
+		}
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	if err := json.NewEncoder(w).Encode(treasures); err != nil {
 		apperrors.HandleError(w, apperrors.NewInternalError(err))
@@ -116,13 +130,12 @@ func (c *TreasureController) CreateTreasure(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	// Check if character exists if character_id provided
-	if input.CharacterID != nil && c.characterRepo != nil {
-		_, err := c.characterRepo.GetCharacter(r.Context(), *input.CharacterID)
-		if err != nil {
-			apperrors.HandleError(w, err)
-			return
-		}
+	// Check the caller may change the character the treasure is for
+	if err := c.authorizeTreasure(r, &models.Treasure{CharacterID: input.CharacterID}, true); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+	if input.CharacterID != nil {
 
 		// Check if treasure already exists for this character
 		existingTreasure, err := c.treasureRepo.GetTreasureByCharacter(r.Context(), *input.CharacterID)
@@ -161,6 +174,11 @@ func (c *TreasureController) UpdateTreasure(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	if err := c.authorizeTreasureID(r, id, true); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
 	var input models.UpdateTreasureInput
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
 		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
@@ -204,6 +222,11 @@ func (c *TreasureController) DeleteTreasure(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	if err := c.authorizeTreasureID(r, id, true); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
 	if err := c.treasureRepo.DeleteTreasure(r.Context(), id); err != nil {
 		apperrors.HandleError(w, err)
 		return
@@ -211,3 +234,33 @@ func (c *TreasureController) DeleteTreasure(w http.ResponseWriter, r *http.Reque
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// Helper methods
+func (c *TreasureController) authorizeCharacter(r *http.Request, characterID int64, write bool) error {
+	userID, err := currentUserID(r)
+	if err != nil {
+		return err
+	}
+	return c.accessService.AuthorizeCharacter(r.Context(), userID, characterID, write)
+}
+
+// authorizeTreasure checks access to the character holding the treasure.
+// Treasure not yet given to a character can be seen by anyone but only
+// changed by an admin.
+func (c *TreasureController) authorizeTreasure(r *http.Request, treasure *models.Treasure, write bool) error {
+	if treasure.CharacterID != nil {
+		return c.authorizeCharacter(r, *treasure.CharacterID, write)
+	}
+	if write && currentUserRole(r) != models.RoleAdmin {
+		return apperrors.NewForbidden("Only an admin can change unassigned treasure")
+	}
+	return nil
+}
+
+func (c *TreasureController) authorizeTreasureID(r *http.Request, treasureID int64, write bool) error {
+	treasure, err := c.treasureRepo.GetTreasure(r.Context(), treasureID)
+	if err != nil {
+		return err
+	}
+	return c.authorizeTreasure(r, treasure, write)
+}
@@ -26,6 +26,8 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, username, email, passwordHash string) (int64, error)
 	UpdateUser(ctx context.Context, id int64, username, email string) error
 	DeleteUser(ctx context.Context, id int64) error
+	UpdateUserRole(ctx context.Context, id int64, role string) error
+	CountUsersByRole(ctx context.Context, role string) (int64, error)
 }
 
 type UserController struct {
@@ -228,6 +230,60 @@ func (c *UserController) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (c *UserController) UpdateUserRole(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid user ID format"))
+		return
+	}
+
+	var input models.UpdateUserRoleInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		apperrors.HandleError(w, apperrors.NewBadRequest("Invalid request body format"))
+		return
+	}
+
+	if err := input.Validate(); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	user, err := c.userRepo.GetUser(r.Context(), id)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	// Never leave the site without an administrator
+	if user.IsAdmin() && input.Role != models.RoleAdmin {
+		adminCount, err := c.userRepo.CountUsersByRole(r.Context(), models.RoleAdmin)
+		if err != nil {
+			apperrors.HandleError(w, err)
+			return
+		}
+		if adminCount <= 1 {
+			apperrors.HandleError(w, apperrors.NewValidationError("role", "Cannot remove the last administrator"))
+			return
+		}
+	}
+
+	if err := c.userRepo.UpdateUserRole(r.Context(), id, input.Role); err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	updatedUser, err := c.userRepo.GetUser(r.Context(), id)
+	if err != nil {
+		apperrors.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(updatedUser); err != nil {
+		apperrors.HandleError(w, apperrors.NewInternalError(err))
+	}
+}
+
 func (c *UserController) RenderSettingsPage(w http.ResponseWriter, r *http.Request) {
 	// Get user ID from context
 	userIDValue := r.Context().Value(contextkeys.UserIDKey)
@@ -102,7 +102,7 @@ func (c *WeaponMasteryController) AddWeaponMastery(w http.ResponseWriter, r *htt
 	}
 
 	// Calculate available slots
-	availableSlots := models.GetAvailableMasterySlots(character.Class, character.Level)
+	availableSlots := models.GetAvailableMasterySlots(character.Class, character.EffectiveLevel())
 
 	// Get current mastery count
 	masteredCount, err := c.weaponMasteryRepo.CountWeaponMasteries(r.Context(), characterID, "mastered")
@@ -120,13 +120,13 @@ func (c *WeaponMasteryController) AddWeaponMastery(w http.ResponseWriter, r *htt
 	totalMasteries := masteredCount + grandMasteryCount
 
 	if totalMasteries >= availableSlots {
-		apperrors.HandleError(w, apperrors.NewBadRequest(fmt.Sprintf("Character can only have %d weapon masteries at level %d", availableSlots, character.Level)))
+		apperrors.HandleError(w, apperrors.NewBadRequest(fmt.Sprintf("Character can only have %d weapon masteries at level %d", availableSlots, character.EffectiveLevel())))
 		return
 	}
 
 	// Check grand mastery requirements
 	if input.MasteryLevel == "grand_mastery" {
-		if character.Level < 4 {
+		if character.EffectiveLevel() < 4 {
 			apperrors.HandleError(w, apperrors.NewBadRequest("Grand mastery is only available at level 4 or higher"))
 			return
 		}
@@ -206,7 +206,7 @@ func (c *WeaponMasteryController) UpdateWeaponMastery(w http.ResponseWriter, r *
 			return
 		}
 
-		if character.Level < 4 {
+		if character.EffectiveLevel() < 4 {
 			apperrors.HandleError(w, apperrors.NewBadRequest("Grand mastery is only available at level 4 or higher"))
 			return
 		}
@@ -328,8 +328,8 @@ func (c *WeaponMasteryController) GetAvailableWeaponsForMastery(w http.ResponseW
 	}
 
 	// Calculate available masteries and slots
-	availableSlots := models.GetAvailableMasterySlots(character.Class, character.Level)
-	grandMasteryAvailable := character.Level >= 4
+	availableSlots := models.GetAvailableMasterySlots(character.Class, character.EffectiveLevel())
+	grandMasteryAvailable := character.EffectiveLevel() >= 4
 	grandMasteryUsed := false
 
 	for _, mastery := range masteries {
@@ -345,7 +345,7 @@ func (c *WeaponMasteryController) GetAvailableWeaponsForMastery(w http.ResponseW
 		"total_slots":       availableSlots,
 		"used_slots":        len(masteries),
 		"can_grand_master":  grandMasteryAvailable && !grandMasteryUsed,
-		"character_level":   character.Level,
+		"character_level":   character.EffectiveLevel(),
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -0,0 +1,278 @@
+package dice
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxExplosions caps how many times a single exploding die can be rerolled
+const maxExplosions = 100
+
+// Roller rolls dice using its own random source so rolls can be reproduced from a seed
+type Roller struct {
+	mu   sync.Mutex
+	rng  *rand.Rand
+	seed int64
+}
+
+// NewRoller creates a roller that produces the same rolls for the same seed
+func NewRoller(seed int64) *Roller {
+	return &Roller{
+		rng:  rand.New(rand.NewSource(seed)),
+		seed: seed,
+	}
+}
+
+// NewRandomRoller creates a roller seeded from the current time
+func NewRandomRoller() *Roller {
+	return NewRoller(time.Now().UnixNano())
+}
+
+// Seed returns the seed the roller was created with
+func (r *Roller) Seed() int64 {
+	return r.seed
+}
+
+// Die rolls a single die with the given number of sides
+func (r *Roller) Die(sides int) int {
+	if sides <= 0 {
+		return 0
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.rng.Intn(sides) + 1
+}
+
+// Int63 returns a random non-negative 63-bit integer, useful for seeding child rollers
+func (r *Roller) Int63() int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.rng.Int63()
+}
+
+// Roll parses and evaluates a dice expression
+func (r *Roller) Roll(expr string) (*Result, error) {
+	expression, err := Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	return expression.Roll(r), nil
+}
+
+// Expression is a parsed dice expression such as "2d6+1" or "4d6kh3"
+type Expression struct {
+	Source string `json:"source"`
+	Terms  []Term `json:"terms"`
+}
+
+// Term is one signed part of an expression: a group of dice or a constant
+type Term struct {
+	Sign       int    `json:"sign"`
+	Count      int    `json:"count,omitempty"`
+	Sides      int    `json:"sides,omitempty"`
+	Constant   int    `json:"constant,omitempty"`
+	Explode    bool   `json:"explode,omitempty"`
+	Keep       string `json:"keep,omitempty"` // "kh", "kl", "dh" or "dl"
+	KeepCount  int    `json:"keep_count,omitempty"`
+	Multiplier int    `json:"multiplier,omitempty"`
+}
+
+// IsDice reports whether the term rolls dice rather than adding a constant
+func (t Term) IsDice() bool {
+	return t.Sides > 0
+}
+
+// String formats the term without its sign
+func (t Term) String() string {
+	var b strings.Builder
+	if t.IsDice() {
+		if t.Count != 1 {
+			b.WriteString(strconv.Itoa(t.Count))
+		}
+		if t.Sides == 100 {
+			b.WriteString("d%")
+		} else {
+			fmt.Fprintf(&b, "d%d", t.Sides)
+		}
+		if t.Explode {
+			b.WriteString("!")
+		}
+		if t.Keep != "" {
+			fmt.Fprintf(&b, "%s%d", t.Keep, t.KeepCount)
+		}
+	} else {
+		b.WriteString(strconv.Itoa(t.Constant))
+	}
+	if t.Multiplier > 1 {
+		fmt.Fprintf(&b, "x%d", t.Multiplier)
+	}
+	return b.String()
+}
+
+// String formats the expression from its terms, e.g. "3d6+2"
+func (e *Expression) String() string {
+	var b strings.Builder
+	for i, term := range e.Terms {
+		if term.Sign < 0 {
+			b.WriteString("-")
+		} else if i > 0 {
+			b.WriteString("+")
+		}
+		b.WriteString(term.String())
+	}
+	return b.String()
+}
+
+// Result is the outcome of rolling an expression
+type Result struct {
+	Expression string       `json:"expression"`
+	Total      int          `json:"total"`
+	Terms      []TermResult `json:"terms"`
+}
+
+// TermResult is the outcome of a single term with its individual dice
+type TermResult struct {
+	Term     string      `json:"term"`
+	Sign     int         `json:"sign"`
+	Dice     []DieResult `json:"dice,omitempty"`
+	Subtotal int         `json:"subtotal"`
+}
+
+// DieResult records one physical die; dropped dice do not count toward the total
+type DieResult struct {
+	Sides    int  `json:"sides"`
+	Value    int  `json:"value"`
+	Dropped  bool `json:"dropped,omitempty"`
+	Exploded bool `json:"exploded,omitempty"`
+}
+
+// Roll evaluates the expression with the given roller
+func (e *Expression) Roll(r *Roller) *Result {
+	result := &Result{
+		Expression: e.Source,
+		Terms:      make([]TermResult, 0, len(e.Terms)),
+	}
+
+	for _, term := range e.Terms {
+		termResult := TermResult{
+			Term: term.String(),
+			Sign: term.Sign,
+		}
+
+		subtotal := term.Constant
+		if term.IsDice() {
+			termResult.Dice = rollDice(r, term)
+			subtotal = 0
+			for _, die := range termResult.Dice {
+				if !die.Dropped {
+					subtotal += die.Value
+				}
+			}
+		}
+		if term.Multiplier > 1 {
+			subtotal *= term.Multiplier
+		}
+
+		termResult.Subtotal = subtotal
+		result.Total += term.Sign * subtotal
+		result.Terms = append(result.Terms, termResult)
+	}
+
+	return result
+}
+
+// Min returns the lowest total the expression can produce
+func (e *Expression) Min() int {
+	return e.bound(false)
+}
+
+// Max returns the highest total the expression can produce, ignoring explosions
+func (e *Expression) Max() int {
+	return e.bound(true)
+}
+
+func (e *Expression) bound(high bool) int {
+	total := 0
+	for _, term := range e.Terms {
+		value := term.Constant
+		if term.IsDice() {
+			kept := keptCount(term)
+			// A term that counts toward the total pushes it the same way as the bound
+			// we want; a subtracted term pushes it the opposite way.
+			if high == (term.Sign > 0) {
+				value = kept * term.Sides
+			} else {
+				value = kept
+			}
+		}
+		if term.Multiplier > 1 {
+			value *= term.Multiplier
+		}
+		total += term.Sign * value
+	}
+	return total
+}
+
+func keptCount(term Term) int {
+	switch term.Keep {
+	case "kh", "kl":
+		return term.KeepCount
+	case "dh", "dl":
+		return term.Count - term.KeepCount
+	}
+	return term.Count
+}
+
+func rollDice(r *Roller, term Term) []DieResult {
+	dice := make([]DieResult, 0, term.Count)
+	for i := 0; i < term.Count; i++ {
+		value := r.Die(term.Sides)
+		die := DieResult{Sides: term.Sides, Value: value}
+
+		if term.Explode && term.Sides > 1 {
+			// Exploding dice: each maximum roll adds another die
+			for n := 0; value == term.Sides && n < maxExplosions; n++ {
+				die.Exploded = true
+				value = r.Die(term.Sides)
+				die.Value += value
+			}
+		}
+		dice = append(dice, die)
+	}
+
+	if term.Keep == "" {
+		return dice
+	}
+
+	// Work out which dice to drop by ranking them, lowest first
+	order := make([]int, len(dice))
+	for i := range order {
+		order[i] = i
+	}
+	for i := 1; i < len(order); i++ {
+		for j := i; j > 0 && dice[order[j]].Value < dice[order[j-1]].Value; j-- {
+			order[j], order[j-1] = order[j-1], order[j]
+		}
+	}
+
+	var drop []int
+	switch term.Keep {
+	case "kh":
+		drop = order[:len(order)-term.KeepCount]
+	case "kl":
+		drop = order[term.KeepCount:]
+	case "dl":
+		drop = order[:term.KeepCount]
+	case "dh":
+		drop = order[len(order)-term.KeepCount:]
+	}
+	for _, i := range drop {
+		dice[i].Dropped = true
+	}
+
+	return dice
+}
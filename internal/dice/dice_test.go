@@ -0,0 +1,149 @@
+package dice_test
+
+import (
+	"testing"
+
+	"mordezzanV4/internal/dice"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expr     string
+		min, max int
+	}{
+		{"1d6", 1, 6},
+		{"2d6+1", 3, 13},
+		{"1d20-2", -1, 18},
+		{"1d8x2", 2, 16},
+		{"1d8*2", 2, 16},
+		{"4d6kh3", 3, 18},
+		{"4d6dl1", 3, 18},
+		{"2d20kl1", 1, 20},
+		{"d%", 1, 100},
+		{"1d6!", 1, 6},
+		{"+1", 1, 1},
+		{" 3D6 + 2 ", 5, 20},
+		{"9d10+9", 18, 99},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			e, err := dice.Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tt.expr, err)
+			}
+			if got := e.Min(); got != tt.min {
+				t.Errorf("Min() = %d, want %d", got, tt.min)
+			}
+			if got := e.Max(); got != tt.max {
+				t.Errorf("Max() = %d, want %d", got, tt.max)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, expr := range []string{"", "-", "d", "2d", "1d0", "4d6kh5", "4d6dl4", "1d6x0", "1d6 1d6", "abc", "1d1!", "2000d6"} {
+		if _, err := dice.Parse(expr); err == nil {
+			t.Errorf("Parse(%q) expected an error", expr)
+		}
+	}
+}
+
+func TestRollIsReproducible(t *testing.T) {
+	for _, expr := range []string{"2d6+1", "4d6kh3", "1d6!+1d20-2", "1d8x2"} {
+		a, err := dice.NewRoller(42).Roll(expr)
+		if err != nil {
+			t.Fatalf("Roll(%q) returned error: %v", expr, err)
+		}
+		b, _ := dice.NewRoller(42).Roll(expr)
+		if a.Total != b.Total {
+			t.Errorf("Roll(%q) with the same seed gave %d and %d", expr, a.Total, b.Total)
+		}
+	}
+}
+
+func TestRollBreakdown(t *testing.T) {
+	roller := dice.NewRoller(7)
+
+	for i := 0; i < 200; i++ {
+		result, err := roller.Roll("4d6kh3+1")
+		if err != nil {
+			t.Fatalf("Roll returned error: %v", err)
+		}
+		if len(result.Terms) != 2 {
+			t.Fatalf("expected 2 terms, got %d", len(result.Terms))
+		}
+
+		dropped, sum := 0, 0
+		for _, die := range result.Terms[0].Dice {
+			if die.Value < 1 || die.Value > 6 {
+				t.Fatalf("die value %d out of range", die.Value)
+			}
+			if die.Dropped {
+				dropped++
+				continue
+			}
+			sum += die.Value
+		}
+		if dropped != 1 {
+			t.Fatalf("expected 1 dropped die, got %d", dropped)
+		}
+		if result.Terms[0].Subtotal != sum {
+			t.Fatalf("subtotal %d does not match kept dice %d", result.Terms[0].Subtotal, sum)
+		}
+		if result.Total != sum+1 {
+			t.Fatalf("total %d, want %d", result.Total, sum+1)
+		}
+	}
+}
+
+func TestRollMultiplierAndExplode(t *testing.T) {
+	roller := dice.NewRoller(1)
+
+	for i := 0; i < 200; i++ {
+		result, err := roller.Roll("1d4x3")
+		if err != nil {
+			t.Fatalf("Roll returned error: %v", err)
+		}
+		if result.Total != result.Terms[0].Dice[0].Value*3 {
+			t.Fatalf("total %d is not three times %d", result.Total, result.Terms[0].Dice[0].Value)
+		}
+	}
+
+	exploded := false
+	for i := 0; i < 500; i++ {
+		result, _ := roller.Roll("1d2!")
+		die := result.Terms[0].Dice[0]
+		if die.Exploded {
+			exploded = true
+			if die.Value <= 2 {
+				t.Fatalf("exploded die has value %d", die.Value)
+			}
+		}
+	}
+	if !exploded {
+		t.Error("expected at least one exploding d2 in 500 rolls")
+	}
+}
+
+func TestExpressionString(t *testing.T) {
+	tests := map[string]string{
+		"1d6":      "d6",
+		"3d6+2":    "3d6+2",
+		"1d20-2":   "d20-2",
+		"4d6kh3+1": "4d6kh3+1",
+		"1d8x2":    "d8x2",
+		"-1+1d4":   "-1+d4",
+	}
+
+	for expr, want := range tests {
+		e, err := dice.Parse(expr)
+		if err != nil {
+			t.Fatalf("Parse(%q) returned error: %v", expr, err)
+		}
+		if got := e.String(); got != want {
+			t.Errorf("Parse(%q).String() = %q, want %q", expr, got, want)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package dice
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Limits that keep a single expression from doing unbounded work
+const (
+	maxDiceCount  = 1000
+	maxDieSides   = 1000
+	maxTerms      = 50
+	maxMultiplier = 100
+)
+
+// ParseError describes why an expression could not be parsed
+type ParseError struct {
+	Expression string
+	Position   int
+	Message    string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid dice expression %q at position %d: %s", e.Expression, e.Position, e.Message)
+}
+
+// Parse parses a dice expression.
+//
+// Supported syntax, case-insensitive and ignoring whitespace:
+//
+//	2d6+1     dice plus a constant
+//	1d20-2    dice minus a constant
+//	d%        percentile die (same as 1d100)
+//	1d8x2     multiply a term (also 1d8*2)
+//	4d6kh3    keep the highest 3 (kl keeps lowest, dh/dl drop highest/lowest)
+//	1d6!      exploding die: rolling the maximum adds another roll
+func Parse(expr string) (*Expression, error) {
+	p := &parser{
+		source: expr,
+		input:  strings.ToLower(strings.Join(strings.Fields(expr), "")),
+	}
+	return p.parse()
+}
+
+// MustParse is like Parse but panics on error; intended for constant expressions
+func MustParse(expr string) *Expression {
+	e, err := Parse(expr)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+type parser struct {
+	source string
+	input  string
+	pos    int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return &ParseError{
+		Expression: p.source,
+		Position:   p.pos,
+		Message:    fmt.Sprintf(format, args...),
+	}
+}
+
+func (p *parser) peek() byte {
+	if p.pos >= len(p.input) {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+func (p *parser) parse() (*Expression, error) {
+	if p.input == "" {
+		return nil, p.errorf("expression is empty")
+	}
+
+	expression := &Expression{Source: p.source}
+
+	for p.pos < len(p.input) {
+		sign := 1
+		switch p.peek() {
+		case '+':
+			p.pos++
+		case '-':
+			sign = -1
+			p.pos++
+		default:
+			if len(expression.Terms) > 0 {
+				return nil, p.errorf("expected '+' or '-'")
+			}
+		}
+
+		term, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		term.Sign = sign
+		expression.Terms = append(expression.Terms, term)
+
+		if len(expression.Terms) > maxTerms {
+			return nil, p.errorf("too many terms (maximum %d)", maxTerms)
+		}
+	}
+
+	return expression, nil
+}
+
+func (p *parser) parseTerm() (Term, error) {
+	var term Term
+
+	count, hasCount := p.parseNumber()
+
+	if p.peek() == 'd' {
+		p.pos++
+		if !hasCount {
+			count = 1
+		}
+		if count < 1 || count > maxDiceCount {
+			return term, p.errorf("dice count must be between 1 and %d", maxDiceCount)
+		}
+
+		var sides int
+		if p.peek() == '%' {
+			p.pos++
+			sides = 100
+		} else {
+			var ok bool
+			sides, ok = p.parseNumber()
+			if !ok {
+				return term, p.errorf("expected number of sides")
+			}
+		}
+		if sides < 1 || sides > maxDieSides {
+			return term, p.errorf("die sides must be between 1 and %d", maxDieSides)
+		}
+
+		term.Count = count
+		term.Sides = sides
+
+		if p.peek() == '!' {
+			p.pos++
+			if sides < 2 {
+				return term, p.errorf("a d1 cannot explode")
+			}
+			term.Explode = true
+		}
+
+		if err := p.parseKeep(&term); err != nil {
+			return term, err
+		}
+	} else {
+		if !hasCount {
+			return term, p.errorf("expected a number or dice")
+		}
+		term.Constant = count
+	}
+
+	if c := p.peek(); c == 'x' || c == '*' {
+		p.pos++
+		multiplier, ok := p.parseNumber()
+		if !ok {
+			return term, p.errorf("expected multiplier")
+		}
+		if multiplier < 1 || multiplier > maxMultiplier {
+			return term, p.errorf("multiplier must be between 1 and %d", maxMultiplier)
+		}
+		term.Multiplier = multiplier
+	}
+
+	return term, nil
+}
+
+func (p *parser) parseKeep(term *Term) error {
+	if p.pos+1 >= len(p.input) {
+		return nil
+	}
+
+	mode := p.input[p.pos : p.pos+2]
+	switch mode {
+	case "kh", "kl", "dh", "dl":
+	default:
+		return nil
+	}
+	p.pos += 2
+
+	n, ok := p.parseNumber()
+	if !ok {
+		return p.errorf("expected a count after %q", mode)
+	}
+
+	switch mode {
+	case "kh", "kl":
+		if n < 1 || n > term.Count {
+			return p.errorf("can only keep between 1 and %d dice", term.Count)
+		}
+	case "dh", "dl":
+		if n < 0 || n >= term.Count {
+			return p.errorf("can only drop between 0 and %d dice", term.Count-1)
+		}
+	}
+
+	term.Keep = mode
+	term.KeepCount = n
+	return nil
+}
+
+func (p *parser) parseNumber() (int, bool) {
+	start := p.pos
+	for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+		p.pos++
+	}
+	if start == p.pos {
+		return 0, false
+	}
+	n, err := strconv.Atoi(p.input[start:p.pos])
+	if err != nil || n > 1_000_000 {
+		// Anything this large is beyond every limit checked by callers
+		return 1_000_001, true
+	}
+	return n, true
+}
@@ -169,3 +169,24 @@ func NewUnauthorized(msg string) *AppError {
 		Code:    http.StatusUnauthorized,
 	}
 }
+
+func NewForbidden(msg string) *AppError {
+	return &AppError{
+		Err:     ErrForbidden,
+		Message: msg,
+		Code:    http.StatusForbidden,
+	}
+}
+
+func IsForbidden(err error) bool {
+	var appErr *AppError
+	return (errors.As(err, &appErr) && errors.Is(appErr.Err, ErrForbidden))
+}
+
+func NewConflict(msg string) *AppError {
+	return &AppError{
+		Err:     ErrConflict,
+		Message: msg,
+		Code:    http.StatusConflict,
+	}
+}
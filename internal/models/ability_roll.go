@@ -0,0 +1,127 @@
+package models
+
+import (
+	"time"
+
+	"mordezzanV4/internal/dice"
+)
+
+// Ability score generation methods
+const (
+	AbilityRollThreeD6InOrder      = "3d6_in_order"
+	AbilityRollFourD6DropLowest    = "4d6_drop_lowest"
+	AbilityRollThreeD6BestOfTwelve = "3d6_best_of_twelve"
+	AbilityRollPointBuy            = "point_buy"
+)
+
+// Point-buy rules: every score starts at PointBuyBaseScore and the player
+// spends up to PointBuyBudget points raising them
+const (
+	PointBuyBaseScore = 8
+	PointBuyBudget    = 30
+	PointBuyMaxScore  = 18
+)
+
+// MaxUnusedAbilityRolls caps the dice rolls a player may hold without
+// creating a character from one. Rolls are never deleted, so a GM can see
+// every set a player rolled before choosing one.
+const MaxUnusedAbilityRolls = 3
+
+// AbilityScores holds the six attributes in character sheet order
+type AbilityScores struct {
+	Strength     int `json:"strength"`
+	Dexterity    int `json:"dexterity"`
+	Constitution int `json:"constitution"`
+	Intelligence int `json:"intelligence"`
+	Wisdom       int `json:"wisdom"`
+	Charisma     int `json:"charisma"`
+}
+
+// AbilityRoll is a server-generated set of ability scores. The signature covers
+// the scores and dice so a GM can confirm the stats were not edited.
+type AbilityRoll struct {
+	ID          int64          `json:"id"`
+	UserID      int64          `json:"user_id"`
+	Method      string         `json:"method"`
+	Scores      []int          `json:"scores"`
+	Rolls       []*dice.Result `json:"rolls,omitempty"`
+	Seed        int64          `json:"seed"`
+	Nonce       string         `json:"nonce"`
+	Signature   string         `json:"signature"`
+	Verified    bool           `json:"verified"`
+	CharacterID *int64         `json:"character_id,omitempty"`
+	UsedAt      *time.Time     `json:"used_at,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
+// CreateAbilityRollInput is used for generating a new set of ability scores
+type CreateAbilityRollInput struct {
+	Method   string         `json:"method"`
+	PointBuy *AbilityScores `json:"point_buy,omitempty"`
+}
+
+// IsValidAbilityRollMethod reports whether method is a supported generation method
+func IsValidAbilityRollMethod(method string) bool {
+	switch method {
+	case AbilityRollThreeD6InOrder, AbilityRollFourD6DropLowest, AbilityRollThreeD6BestOfTwelve, AbilityRollPointBuy:
+		return true
+	}
+	return false
+}
+
+// IsArranged reports whether the player may assign the scores to attributes in
+// any order. 3d6 in order and point-buy fix each score to its attribute.
+func (r *AbilityRoll) IsArranged() bool {
+	return r.Method == AbilityRollFourD6DropLowest || r.Method == AbilityRollThreeD6BestOfTwelve
+}
+
+// Slice returns the scores in character sheet order
+func (s AbilityScores) Slice() []int {
+	return []int{s.Strength, s.Dexterity, s.Constitution, s.Intelligence, s.Wisdom, s.Charisma}
+}
+
+// PointBuyCost returns the total points needed to raise a score from the base to score
+func PointBuyCost(score int) int {
+	cost := 0
+	for s := PointBuyBaseScore + 1; s <= score; s++ {
+		switch {
+		case s <= 13:
+			cost++
+		case s <= 15:
+			cost += 2
+		case s <= 17:
+			cost += 3
+		default:
+			cost += 4
+		}
+	}
+	return cost
+}
+
+// Validate ensures that the ability roll input is valid
+func (i *CreateAbilityRollInput) Validate() error {
+	if !IsValidAbilityRollMethod(i.Method) {
+		return NewValidationError("method", "Method must be one of '3d6_in_order', '4d6_drop_lowest', '3d6_best_of_twelve' or 'point_buy'")
+	}
+	if i.Method != AbilityRollPointBuy {
+		if i.PointBuy != nil {
+			return NewValidationError("point_buy", "Point-buy scores are only allowed with the point_buy method")
+		}
+		return nil
+	}
+
+	if i.PointBuy == nil {
+		return NewValidationError("point_buy", "Point-buy scores are required")
+	}
+	total := 0
+	for _, score := range i.PointBuy.Slice() {
+		if score < PointBuyBaseScore || score > PointBuyMaxScore {
+			return NewValidationError("point_buy", "Point-buy scores must be between 8 and 18")
+		}
+		total += PointBuyCost(score)
+	}
+	if total > PointBuyBudget {
+		return NewValidationError("point_buy", "Point-buy scores exceed the 30 point budget")
+	}
+	return nil
+}
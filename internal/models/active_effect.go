@@ -0,0 +1,121 @@
+package models
+
+import "time"
+
+// Durations are counted in combat rounds; a turn is ten rounds and a day
+// is 144 turns
+const (
+	RoundsPerTurn = 10
+	TurnsPerDay   = 144
+)
+
+// Sources of an active effect
+const (
+	EffectSourcePotion = "potion"
+)
+
+// Attributes an active effect can set
+const (
+	AttributeStrength     = "strength"
+	AttributeDexterity    = "dexterity"
+	AttributeConstitution = "constitution"
+	AttributeIntelligence = "intelligence"
+	AttributeWisdom       = "wisdom"
+	AttributeCharisma     = "charisma"
+)
+
+// ActiveEffect is a timed magical effect on a character, such as a potion of
+// giant strength. While rounds remain, an effect with an Attribute sets that
+// attribute to Score.
+type ActiveEffect struct {
+	ID              int64     `json:"id"`
+	CharacterID     int64     `json:"character_id"`
+	SourceType      string    `json:"source_type"`
+	SourceID        int64     `json:"source_id"`
+	Name            string    `json:"name"`
+	Attribute       string    `json:"attribute,omitempty"`
+	Score           *int      `json:"score,omitempty"`
+	DurationRounds  int       `json:"duration_rounds"`
+	RemainingRounds int       `json:"remaining_rounds"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// AdvanceTimeInput moves game time forward for a character's active effects
+type AdvanceTimeInput struct {
+	Rounds int `json:"rounds,omitempty"`
+	Turns  int `json:"turns,omitempty"`
+	Days   int `json:"days,omitempty"`
+}
+
+// AdvanceTimeResult lists the effects and conditions that ran out and those
+// still active, and the hit points a dying character lost meanwhile
+type AdvanceTimeResult struct {
+	Rounds            int                   `json:"rounds"`
+	Expired           []*ActiveEffect       `json:"expired"`
+	Active            []*ActiveEffect       `json:"active"`
+	ExpiredConditions []*CharacterCondition `json:"expired_conditions"`
+	Conditions        []*CharacterCondition `json:"conditions"`
+	HitPointsLost     int                   `json:"hit_points_lost,omitempty"`
+	Vitality          string                `json:"vitality"`
+}
+
+// Validate checks if the input is valid
+func (i *AdvanceTimeInput) Validate() error {
+	if i.Rounds < 0 {
+		return NewValidationError("rounds", "Rounds cannot be negative")
+	}
+	if i.Turns < 0 {
+		return NewValidationError("turns", "Turns cannot be negative")
+	}
+	if i.Days < 0 {
+		return NewValidationError("days", "Days cannot be negative")
+	}
+	if i.TotalRounds() == 0 {
+		return NewValidationError("rounds", "Time must advance by at least one round")
+	}
+	return nil
+}
+
+// TotalRounds returns the time to advance in rounds
+func (i *AdvanceTimeInput) TotalRounds() int {
+	return durationInRounds(i.Rounds, i.Turns, i.Days)
+}
+
+func durationInRounds(rounds, turns, days int) int {
+	return rounds + (turns+days*TurnsPerDay)*RoundsPerTurn
+}
+
+// IsValidEffectAttribute reports whether an effect can set the attribute
+func IsValidEffectAttribute(attribute string) bool {
+	switch attribute {
+	case AttributeStrength, AttributeDexterity, AttributeConstitution,
+		AttributeIntelligence, AttributeWisdom, AttributeCharisma:
+		return true
+	}
+	return false
+}
+
+// applyEffects sets the attributes overridden by active effects. Where
+// several effects set the same attribute the most recent one wins.
+func (s *AbilityScores) applyEffects(effects []ActiveEffect) {
+	for _, effect := range effects {
+		if effect.Score == nil || effect.RemainingRounds <= 0 {
+			continue
+		}
+		score := clampAttribute(*effect.Score)
+		switch effect.Attribute {
+		case AttributeStrength:
+			s.Strength = score
+		case AttributeDexterity:
+			s.Dexterity = score
+		case AttributeConstitution:
+			s.Constitution = score
+		case AttributeIntelligence:
+			s.Intelligence = score
+		case AttributeWisdom:
+			s.Wisdom = score
+		case AttributeCharisma:
+			s.Charisma = score
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"fmt"
+
+	"mordezzanV4/internal/dice"
+)
+
+// AttackTarget is the number an attack roll plus the target's AC must reach
+// to hit, so a total of 11 hits AC 9 and a total of 20 hits AC 0
+const AttackTarget = 20
+
+// Armor class limits accepted for the target of an attack
+const (
+	MinTargetAC = -10
+	MaxTargetAC = 10
+)
+
+// Range bands of a missile attack
+const (
+	RangeBandShort  = "short"
+	RangeBandMedium = "medium"
+	RangeBandLong   = "long"
+)
+
+// To-hit modifiers for missile attacks by range band
+const (
+	ShortRangeModifier  = 1
+	MediumRangeModifier = 0
+	LongRangeModifier   = -2
+)
+
+// RearAttackBonus is the to-hit bonus for attacking a foe from behind
+const RearAttackBonus = 2
+
+// BackstabBonus is the to-hit bonus of a backstab, which is always made from
+// behind and replaces the rear attack bonus
+const BackstabBonus = 4
+
+// MaxBackstabWeaponClass is the largest weapon class that can backstab
+const MaxBackstabWeaponClass = 2
+
+// AttackInput describes an attack with a weapon from the character's inventory
+type AttackInput struct {
+	InventoryItemID int64 `json:"inventory_item_id"`
+	TargetAC        int   `json:"target_ac"`
+	// Range is the distance to the target in feet; zero is a melee attack
+	Range      int  `json:"range,omitempty"`
+	RearAttack bool `json:"rear_attack,omitempty"`
+	Backstab   bool `json:"backstab,omitempty"`
+	TwoHanded  bool `json:"two_handed,omitempty"`
+	// Modifiers are situational adjustments given by the referee, such as
+	// cover or a higher position
+	Modifiers []RollModifier `json:"modifiers,omitempty"`
+}
+
+// AttackDamage is the damage roll of a hit
+type AttackDamage struct {
+	*dice.Result
+	// Multiplier is how many times the weapon dice were rolled for a backstab
+	Multiplier int `json:"multiplier,omitempty"`
+}
+
+// AttackResult is the outcome of an attack with each modifier that went into
+// it. The attack hits when Total meets or beats Needed; a natural 20 always
+// hits and a natural 1 always misses.
+type AttackResult struct {
+	CharacterID     int64          `json:"character_id"`
+	InventoryItemID int64          `json:"inventory_item_id"`
+	Weapon          string         `json:"weapon"`
+	TargetAC        int            `json:"target_ac"`
+	RangeBand       string         `json:"range_band,omitempty"`
+	Roll            int            `json:"roll"`
+	FightingAbility int            `json:"fighting_ability"`
+	Modifiers       []RollModifier `json:"modifiers"`
+	TotalModifier   int            `json:"total_modifier"`
+	Total           int            `json:"total"`
+	Needed          int            `json:"needed"`
+	Hit             bool           `json:"hit"`
+	Damage          *AttackDamage  `json:"damage,omitempty"`
+	Summary         string         `json:"summary"`
+}
+
+// Validate checks if the input is valid
+func (i *AttackInput) Validate() error {
+	if i.InventoryItemID <= 0 {
+		return NewValidationError("inventory_item_id", "Inventory item ID must be positive")
+	}
+	if i.TargetAC < MinTargetAC || i.TargetAC > MaxTargetAC {
+		return NewValidationError("target_ac", fmt.Sprintf("Target AC must be between %d and %d", MinTargetAC, MaxTargetAC))
+	}
+	if i.Range < 0 {
+		return NewValidationError("range", "Range cannot be negative")
+	}
+	if i.Backstab && i.Range > 0 {
+		return NewValidationError("backstab", "Backstabs are made in melee")
+	}
+	return validateSituationalModifiers(i.Modifiers)
+}
@@ -0,0 +1,223 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// AttributeLevel lets an adjustment apply to the character's level, as the
+// touch of a wight drains it
+const AttributeLevel = "level"
+
+// Kinds of attribute adjustment. Damage is temporary and is restored by rest
+// or healing; drain lasts until restored by magic; a loss, such as the
+// constitution given up to return from death, is never restored; a bonus
+// lasts until its source, such as a magic item, is removed.
+const (
+	AdjustmentDamage = "damage"
+	AdjustmentDrain  = "drain"
+	AdjustmentLoss   = "loss"
+	AdjustmentBonus  = "bonus"
+)
+
+// MaxAttributeAdjustment bounds the amount of a single adjustment
+const MaxAttributeAdjustment = 18
+
+// AttributeAdjustment is a sourced change to one of a character's attributes
+// or their level. Damage, drain and loss lower the value by Amount; a bonus
+// raises it by Amount or, when Score is set, to at least Score.
+type AttributeAdjustment struct {
+	ID          int64     `json:"id"`
+	CharacterID int64     `json:"character_id"`
+	Attribute   string    `json:"attribute"`
+	Kind        string    `json:"kind"`
+	Amount      int       `json:"amount"`
+	Score       *int      `json:"score,omitempty"`
+	Source      string    `json:"source,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// AddAttributeAdjustmentInput damages, drains or improves an attribute.
+// Amount is the number of points (or levels) lost or gained.
+type AddAttributeAdjustmentInput struct {
+	Attribute string `json:"attribute"`
+	Kind      string `json:"kind"`
+	Amount    int    `json:"amount,omitempty"`
+	Score     *int   `json:"score,omitempty"`
+	Source    string `json:"source"`
+}
+
+// Validate checks if the input is valid
+func (i *AddAttributeAdjustmentInput) Validate() error {
+	if i.Attribute != AttributeLevel && !IsValidEffectAttribute(i.Attribute) {
+		return NewValidationError("attribute", "Attribute must be an ability score or level")
+	}
+	switch i.Kind {
+	case AdjustmentDamage, AdjustmentDrain:
+		if i.Score != nil {
+			return NewValidationError("score", "Only a bonus can set a score")
+		}
+	case AdjustmentBonus:
+		if i.Attribute == AttributeLevel {
+			return NewValidationError("kind", "Levels cannot be gained as a bonus")
+		}
+	default:
+		return NewValidationError("kind", "Kind must be damage, drain or bonus")
+	}
+	if i.Score != nil {
+		if *i.Score < 3 || *i.Score > 18 {
+			return NewValidationError("score", "Score must be between 3 and 18")
+		}
+		if i.Amount != 0 {
+			return NewValidationError("amount", "Give either an amount or a score")
+		}
+	} else if i.Amount < 1 || i.Amount > MaxAttributeAdjustment {
+		return NewValidationError("amount", fmt.Sprintf("Amount must be between 1 and %d", MaxAttributeAdjustment))
+	}
+	if i.Source == "" {
+		return NewValidationError("source", "Source cannot be empty")
+	}
+	if len(i.Source) > 200 {
+		return NewValidationError("source", "Source cannot exceed 200 characters")
+	}
+	return nil
+}
+
+// IsPermanent reports whether the adjustment can never be removed
+func (a *AttributeAdjustment) IsPermanent() bool {
+	return a.Kind == AdjustmentLoss
+}
+
+// SignedAmount returns the amount the adjustment adds to its attribute
+func (a *AttributeAdjustment) SignedAmount() int {
+	if a.Kind == AdjustmentBonus {
+		return a.Amount
+	}
+	return -a.Amount
+}
+
+// RestoreAttributesInput removes the character's damage or drain, of every
+// attribute or only the one named
+type RestoreAttributesInput struct {
+	Kind      string `json:"kind"`
+	Attribute string `json:"attribute,omitempty"`
+}
+
+// Validate checks if the input is valid
+func (i *RestoreAttributesInput) Validate() error {
+	if i.Kind != AdjustmentDamage && i.Kind != AdjustmentDrain {
+		return NewValidationError("kind", "Kind must be damage or drain")
+	}
+	if i.Attribute != "" && i.Attribute != AttributeLevel && !IsValidEffectAttribute(i.Attribute) {
+		return NewValidationError("attribute", "Attribute must be an ability score or level")
+	}
+	return nil
+}
+
+// RestoreAttributesResult is how many adjustments a restoration removed and
+// the character's attributes afterwards
+type RestoreAttributesResult struct {
+	Restored   int                  `json:"restored"`
+	Attributes *CharacterAttributes `json:"attributes"`
+}
+
+// AttributeValue is an attribute's recorded base value, the kindred modifier
+// and adjustments on it, and the current value after those and any active
+// effects. Current values stay within 3-18, and a level never falls below 1.
+type AttributeValue struct {
+	Attribute  string `json:"attribute"`
+	Base       int    `json:"base"`
+	Kindred    int    `json:"kindred,omitempty"`
+	Adjustment int    `json:"adjustment"`
+	Current    int    `json:"current"`
+}
+
+// CharacterAttributes is the breakdown of a character's attributes and level
+// with the adjustments on them
+type CharacterAttributes struct {
+	CharacterID int64                 `json:"character_id"`
+	Attributes  []AttributeValue      `json:"attributes"`
+	Level       AttributeValue        `json:"level"`
+	Adjustments []AttributeAdjustment `json:"adjustments"`
+}
+
+// AttributeBreakdown returns the base, adjusted and current values of the
+// character's attributes and level
+func (c *Character) AttributeBreakdown() *CharacterAttributes {
+	base := AbilityScores{
+		Strength:     c.Strength,
+		Dexterity:    c.Dexterity,
+		Constitution: c.Constitution,
+		Intelligence: c.Intelligence,
+		Wisdom:       c.Wisdom,
+		Charisma:     c.Charisma,
+	}
+	current := c.EffectiveAttributes()
+
+	breakdown := &CharacterAttributes{
+		CharacterID: c.ID,
+		Level: AttributeValue{
+			Attribute:  AttributeLevel,
+			Base:       c.Level,
+			Adjustment: c.adjustmentTotal(AttributeLevel),
+			Current:    c.EffectiveLevel(),
+		},
+		Adjustments: c.AttributeAdjustments,
+	}
+	if breakdown.Adjustments == nil {
+		breakdown.Adjustments = []AttributeAdjustment{}
+	}
+	for _, attribute := range Attributes {
+		value := AttributeValue{
+			Attribute:  attribute,
+			Base:       base.Score(attribute),
+			Adjustment: c.adjustmentTotal(attribute),
+			Current:    current.Score(attribute),
+		}
+		if c.KindredTraits != nil {
+			value.Kindred = c.KindredTraits.Modifier(attribute)
+		}
+		breakdown.Attributes = append(breakdown.Attributes, value)
+	}
+	return breakdown
+}
+
+// EffectiveLevel returns the character's level less any drained levels, and
+// never below first level
+func (c *Character) EffectiveLevel() int {
+	return max(c.Level+c.adjustmentTotal(AttributeLevel), 1)
+}
+
+// adjustmentTotal sums the amounts the character's adjustments add to an
+// attribute, leaving out bonuses that set a score
+func (c *Character) adjustmentTotal(attribute string) int {
+	total := 0
+	for _, adjustment := range c.AttributeAdjustments {
+		if adjustment.Attribute == attribute && adjustment.Score == nil {
+			total += adjustment.SignedAmount()
+		}
+	}
+	return total
+}
+
+// applyAdjustments adds damage, drain, losses and bonuses to the attributes, keeping
+// them within the 3-18 range, then raises any attribute a bonus sets to its
+// score
+func (s *AbilityScores) applyAdjustments(adjustments []AttributeAdjustment) {
+	totals := make(map[string]int)
+	for _, adjustment := range adjustments {
+		if adjustment.Score == nil {
+			totals[adjustment.Attribute] += adjustment.SignedAmount()
+		}
+	}
+	for attribute, total := range totals {
+		if score := s.field(attribute); score != nil && total != 0 {
+			*score = clampAttribute(*score + total)
+		}
+	}
+	for _, adjustment := range adjustments {
+		if score := s.field(adjustment.Attribute); score != nil && adjustment.Score != nil {
+			*score = max(*score, clampAttribute(*adjustment.Score))
+		}
+	}
+}
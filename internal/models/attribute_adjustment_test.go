@@ -0,0 +1,134 @@
+package models_test
+
+import (
+	"testing"
+
+	"mordezzanV4/internal/models"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestEffectiveAttributesWithAdjustments(t *testing.T) {
+	tests := []struct {
+		name        string
+		kindred     *models.Kindred
+		adjustments []models.AttributeAdjustment
+		strength    int
+		wisdom      int
+	}{
+		{"no adjustments", nil, nil, 12, 9},
+		{"damage lowers the score", nil, []models.AttributeAdjustment{
+			{Attribute: models.AttributeStrength, Kind: models.AdjustmentDamage, Amount: 3},
+		}, 9, 9},
+		{"damage and drain add up", nil, []models.AttributeAdjustment{
+			{Attribute: models.AttributeStrength, Kind: models.AdjustmentDamage, Amount: 2},
+			{Attribute: models.AttributeStrength, Kind: models.AdjustmentDrain, Amount: 1},
+		}, 9, 9},
+		{"scores cannot fall below 3", nil, []models.AttributeAdjustment{
+			{Attribute: models.AttributeStrength, Kind: models.AdjustmentDrain, Amount: 15},
+		}, 3, 9},
+		{"scores cannot rise above 18", nil, []models.AttributeAdjustment{
+			{Attribute: models.AttributeStrength, Kind: models.AdjustmentBonus, Amount: 10},
+		}, 18, 9},
+		{"a bonus offsets damage before clamping", nil, []models.AttributeAdjustment{
+			{Attribute: models.AttributeStrength, Kind: models.AdjustmentDamage, Amount: 12},
+			{Attribute: models.AttributeStrength, Kind: models.AdjustmentBonus, Amount: 4},
+		}, 4, 9},
+		{"a score bonus raises the attribute to its score", nil, []models.AttributeAdjustment{
+			{Attribute: models.AttributeStrength, Kind: models.AdjustmentBonus, Score: intPtr(18)},
+		}, 18, 9},
+		{"a score bonus never lowers the attribute", nil, []models.AttributeAdjustment{
+			{Attribute: models.AttributeStrength, Kind: models.AdjustmentBonus, Score: intPtr(8)},
+		}, 12, 9},
+		{"a score bonus applies after damage", nil, []models.AttributeAdjustment{
+			{Attribute: models.AttributeStrength, Kind: models.AdjustmentBonus, Score: intPtr(16)},
+			{Attribute: models.AttributeStrength, Kind: models.AdjustmentDamage, Amount: 6},
+		}, 16, 9},
+		{"adjustments apply after the kindred modifier", &models.Kindred{WisdomModifier: 1}, []models.AttributeAdjustment{
+			{Attribute: models.AttributeWisdom, Kind: models.AdjustmentDrain, Amount: 2},
+		}, 12, 8},
+		{"level adjustments leave attributes alone", nil, []models.AttributeAdjustment{
+			{Attribute: models.AttributeLevel, Kind: models.AdjustmentDrain, Amount: 2},
+		}, 12, 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := &models.Character{
+				Strength:             12,
+				Dexterity:            10,
+				Constitution:         10,
+				Intelligence:         10,
+				Wisdom:               9,
+				Charisma:             10,
+				KindredTraits:        tt.kindred,
+				AttributeAdjustments: tt.adjustments,
+			}
+			scores := character.EffectiveAttributes()
+			if scores.Strength != tt.strength {
+				t.Errorf("Strength = %d, want %d", scores.Strength, tt.strength)
+			}
+			if scores.Wisdom != tt.wisdom {
+				t.Errorf("Wisdom = %d, want %d", scores.Wisdom, tt.wisdom)
+			}
+		})
+	}
+}
+
+func TestEffectiveLevel(t *testing.T) {
+	tests := []struct {
+		name        string
+		level       int
+		adjustments []models.AttributeAdjustment
+		want        int
+	}{
+		{"no drain", 5, nil, 5},
+		{"drained levels", 5, []models.AttributeAdjustment{
+			{Attribute: models.AttributeLevel, Kind: models.AdjustmentDrain, Amount: 2},
+		}, 3},
+		{"never below first level", 2, []models.AttributeAdjustment{
+			{Attribute: models.AttributeLevel, Kind: models.AdjustmentDrain, Amount: 4},
+		}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := &models.Character{Level: tt.level, AttributeAdjustments: tt.adjustments}
+			if got := character.EffectiveLevel(); got != tt.want {
+				t.Errorf("EffectiveLevel() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddAttributeAdjustmentInputValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   models.AddAttributeAdjustmentInput
+		wantErr bool
+	}{
+		{"damage", models.AddAttributeAdjustmentInput{Attribute: models.AttributeStrength, Kind: models.AdjustmentDamage, Amount: 2, Source: "Shadow"}, false},
+		{"level drain", models.AddAttributeAdjustmentInput{Attribute: models.AttributeLevel, Kind: models.AdjustmentDrain, Amount: 1, Source: "Wight"}, false},
+		{"score bonus", models.AddAttributeAdjustmentInput{Attribute: models.AttributeStrength, Kind: models.AdjustmentBonus, Score: intPtr(18), Source: "Gauntlets"}, false},
+		{"unknown attribute", models.AddAttributeAdjustmentInput{Attribute: "luck", Kind: models.AdjustmentDamage, Amount: 1, Source: "Curse"}, true},
+		{"unknown kind", models.AddAttributeAdjustmentInput{Attribute: models.AttributeStrength, Kind: "curse", Amount: 1, Source: "Curse"}, true},
+		{"level bonus", models.AddAttributeAdjustmentInput{Attribute: models.AttributeLevel, Kind: models.AdjustmentBonus, Amount: 1, Source: "Wish"}, true},
+		{"damage with a score", models.AddAttributeAdjustmentInput{Attribute: models.AttributeStrength, Kind: models.AdjustmentDamage, Score: intPtr(8), Source: "Shadow"}, true},
+		{"score out of range", models.AddAttributeAdjustmentInput{Attribute: models.AttributeStrength, Kind: models.AdjustmentBonus, Score: intPtr(19), Source: "Gauntlets"}, true},
+		{"amount and score", models.AddAttributeAdjustmentInput{Attribute: models.AttributeStrength, Kind: models.AdjustmentBonus, Amount: 1, Score: intPtr(18), Source: "Gauntlets"}, true},
+		{"zero amount", models.AddAttributeAdjustmentInput{Attribute: models.AttributeStrength, Kind: models.AdjustmentDamage, Source: "Shadow"}, true},
+		{"amount too large", models.AddAttributeAdjustmentInput{Attribute: models.AttributeStrength, Kind: models.AdjustmentDamage, Amount: 19, Source: "Shadow"}, true},
+		{"missing source", models.AddAttributeAdjustmentInput{Attribute: models.AttributeStrength, Kind: models.AdjustmentDamage, Amount: 1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kinds of attribute check. A test is the everyday x-in-6 chance listed for
+// strength, dexterity and constitution, and a feat the percentage chance of an
+// extraordinary effort with them. A check rolls a d20 at or under the score
+// itself and serves the attributes that have no test.
+const (
+	AttributeCheckTest  = "test"
+	AttributeCheckFeat  = "feat"
+	AttributeCheckScore = "check"
+)
+
+// Dice rolled for each kind of attribute check
+const (
+	AttributeTestDie  = 6
+	AttributeFeatDie  = 100
+	AttributeCheckDie = 20
+)
+
+// FeatPercentPerModifier is the percentage a feat is adjusted by for each
+// point of a check modifier
+const FeatPercentPerModifier = 5
+
+// AttributeCheckInput names the attribute to check and the kind of check.
+// Kind defaults to a test for the attributes that have one and a check for
+// the others. Modifiers are situational adjustments given by the referee.
+type AttributeCheckInput struct {
+	Attribute string         `json:"attribute"`
+	Kind      string         `json:"kind,omitempty"`
+	Modifiers []RollModifier `json:"modifiers,omitempty"`
+}
+
+// Validate checks if the input is valid
+func (i *AttributeCheckInput) Validate() error {
+	if !IsValidEffectAttribute(i.Attribute) {
+		return NewValidationError("attribute", "Attribute must be one of strength, dexterity, constitution, intelligence, wisdom or charisma")
+	}
+	switch i.Kind {
+	case "", AttributeCheckTest, AttributeCheckFeat, AttributeCheckScore:
+	default:
+		return NewValidationError("kind", "Kind must be test, feat or check")
+	}
+	return validateSituationalModifiers(i.Modifiers)
+}
+
+// ResolvedKind returns the kind of check to roll
+func (i *AttributeCheckInput) ResolvedKind() string {
+	if i.Kind != "" {
+		return i.Kind
+	}
+	if HasAttributeTest(i.Attribute) {
+		return AttributeCheckTest
+	}
+	return AttributeCheckScore
+}
+
+// Attributes lists the six attributes in the order they are written down
+var Attributes = []string{
+	AttributeStrength,
+	AttributeDexterity,
+	AttributeConstitution,
+	AttributeIntelligence,
+	AttributeWisdom,
+	AttributeCharisma,
+}
+
+// HasAttributeTest reports whether the attribute has a test and a feat
+func HasAttributeTest(attribute string) bool {
+	switch attribute {
+	case AttributeStrength, AttributeDexterity, AttributeConstitution:
+		return true
+	}
+	return false
+}
+
+// AttributeCheckModifier converts a check modifier in points to the units of
+// the kind of check: one in six for a test, one on the d20 for a check and
+// FeatPercentPerModifier per cent for a feat
+func AttributeCheckModifier(kind string, modifier int) int {
+	if kind == AttributeCheckFeat {
+		return modifier * FeatPercentPerModifier
+	}
+	return modifier
+}
+
+// BoundAttributeChance keeps a modified chance between 0 and one less than
+// the die, so the highest roll always fails
+func BoundAttributeChance(chance, die int) int {
+	return min(max(chance, 0), die-1)
+}
+
+// AttributeCheckResult is the outcome of an attribute check with the
+// modifiers that went into it
+type AttributeCheckResult struct {
+	CharacterID   int64          `json:"character_id"`
+	Attribute     string         `json:"attribute"`
+	Kind          string         `json:"kind"`
+	Score         int            `json:"score"`
+	Die           int            `json:"die"`
+	BaseChance    int            `json:"base_chance"`
+	Modifiers     []RollModifier `json:"modifiers"`
+	TotalModifier int            `json:"total_modifier"`
+	Chance        int            `json:"chance"`
+	Roll          int            `json:"roll"`
+	Success       bool           `json:"success"`
+	Summary       string         `json:"summary"`
+}
+
+// AttributeCheckSummary describes an outcome, such as "Strength test failed"
+func AttributeCheckSummary(attribute, kind string, success bool) string {
+	name := strings.ToUpper(attribute[:1]) + attribute[1:]
+	if success {
+		return fmt.Sprintf("%s %s succeeded", name, kind)
+	}
+	return fmt.Sprintf("%s %s failed", name, kind)
+}
+
+// AttributeChances lists an attribute's score and the chances it gives
+// before modifiers. Test and Feat are empty for attributes without them.
+type AttributeChances struct {
+	Attribute string `json:"attribute"`
+	Score     int    `json:"score"`
+	Test      string `json:"test,omitempty"`
+	Feat      string `json:"feat,omitempty"`
+	Check     string `json:"check"`
+}
+
+// Score returns the score of an attribute by its lowercase name
+func (s AbilityScores) Score(attribute string) int {
+	if score := s.field(attribute); score != nil {
+		return *score
+	}
+	return 0
+}
+
+// field returns the score of an attribute by its lowercase name, or nil for
+// an unknown attribute
+func (s *AbilityScores) field(attribute string) *int {
+	switch attribute {
+	case AttributeStrength:
+		return &s.Strength
+	case AttributeDexterity:
+		return &s.Dexterity
+	case AttributeConstitution:
+		return &s.Constitution
+	case AttributeIntelligence:
+		return &s.Intelligence
+	case AttributeWisdom:
+		return &s.Wisdom
+	case AttributeCharisma:
+		return &s.Charisma
+	}
+	return nil
+}
+
+// AttributeTests returns the test and feat listed for an attribute, empty
+// for the attributes without them. Derived stats must be calculated first.
+func (c *Character) AttributeTests(attribute string) (string, string) {
+	switch attribute {
+	case AttributeStrength:
+		return c.StrengthTest, c.ExtraStrengthFeat
+	case AttributeDexterity:
+		return c.DexterityTest, c.ExtraDexterityFeat
+	case AttributeConstitution:
+		return c.ConstitutionTest, c.ExtraConstitutionFeat
+	}
+	return "", ""
+}
+
+// AttributeCheckChance returns the base chance of the kind of check for an
+// attribute and the die rolled against it
+func (c *Character) AttributeCheckChance(attribute, kind string) (int, int, error) {
+	if kind == AttributeCheckScore {
+		return c.EffectiveAttributes().Score(attribute), AttributeCheckDie, nil
+	}
+
+	test, feat := c.AttributeTests(attribute)
+	if test == "" {
+		return 0, 0, fmt.Errorf("%s has no %s", attribute, kind)
+	}
+	if kind == AttributeCheckFeat {
+		percent, ok := parsePercent(feat)
+		if !ok {
+			return 0, 0, fmt.Errorf("unexpected format for %s feat: %s", attribute, feat)
+		}
+		return percent, AttributeFeatDie, nil
+	}
+	chance, ok := parseChanceIn(test, AttributeTestDie)
+	if !ok {
+		return 0, 0, fmt.Errorf("unexpected format for %s test: %s", attribute, test)
+	}
+	return chance, AttributeTestDie, nil
+}
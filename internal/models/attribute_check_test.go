@@ -0,0 +1,110 @@
+package models_test
+
+import (
+	"testing"
+
+	"mordezzanV4/internal/models"
+)
+
+func attributeCheckCharacter() *models.Character {
+	return &models.Character{
+		Strength:              14,
+		Dexterity:             9,
+		Constitution:          16,
+		Intelligence:          11,
+		Wisdom:                8,
+		Charisma:              13,
+		StrengthTest:          "3:6",
+		DexterityTest:         "2:6",
+		ConstitutionTest:      "4:6",
+		ExtraStrengthFeat:     "8%",
+		ExtraDexterityFeat:    "4%",
+		ExtraConstitutionFeat: "12%",
+	}
+}
+
+func TestAttributeCheckChance(t *testing.T) {
+	tests := []struct {
+		attribute  string
+		kind       string
+		wantChance int
+		wantDie    int
+	}{
+		{models.AttributeStrength, models.AttributeCheckTest, 3, models.AttributeTestDie},
+		{models.AttributeDexterity, models.AttributeCheckTest, 2, models.AttributeTestDie},
+		{models.AttributeConstitution, models.AttributeCheckTest, 4, models.AttributeTestDie},
+		{models.AttributeStrength, models.AttributeCheckFeat, 8, models.AttributeFeatDie},
+		{models.AttributeDexterity, models.AttributeCheckFeat, 4, models.AttributeFeatDie},
+		{models.AttributeConstitution, models.AttributeCheckFeat, 12, models.AttributeFeatDie},
+		{models.AttributeStrength, models.AttributeCheckScore, 14, models.AttributeCheckDie},
+		{models.AttributeWisdom, models.AttributeCheckScore, 8, models.AttributeCheckDie},
+		{models.AttributeCharisma, models.AttributeCheckScore, 13, models.AttributeCheckDie},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attribute+" "+tt.kind, func(t *testing.T) {
+			chance, die, err := attributeCheckCharacter().AttributeCheckChance(tt.attribute, tt.kind)
+			if err != nil {
+				t.Fatalf("AttributeCheckChance() error = %v", err)
+			}
+			if chance != tt.wantChance || die != tt.wantDie {
+				t.Errorf("AttributeCheckChance() = %d, %d, want %d, %d", chance, die, tt.wantChance, tt.wantDie)
+			}
+		})
+	}
+}
+
+func TestAttributeCheckChanceMalformed(t *testing.T) {
+	tests := []struct {
+		name      string
+		attribute string
+		kind      string
+		setup     func(c *models.Character)
+	}{
+		{"unavailable test", models.AttributeStrength, models.AttributeCheckTest, func(c *models.Character) { c.StrengthTest = "x:6" }},
+		{"test on the wrong die", models.AttributeDexterity, models.AttributeCheckTest, func(c *models.Character) { c.DexterityTest = "3:12" }},
+		{"test without a die", models.AttributeConstitution, models.AttributeCheckTest, func(c *models.Character) { c.ConstitutionTest = "4" }},
+		{"feat without a percent sign", models.AttributeStrength, models.AttributeCheckFeat, func(c *models.Character) { c.ExtraStrengthFeat = "8" }},
+		{"empty feat", models.AttributeDexterity, models.AttributeCheckFeat, func(c *models.Character) { c.ExtraDexterityFeat = "" }},
+		{"derived stats not calculated", models.AttributeStrength, models.AttributeCheckTest, func(c *models.Character) { c.StrengthTest = "" }},
+		{"attribute without a test", models.AttributeIntelligence, models.AttributeCheckTest, func(c *models.Character) {}},
+		{"attribute without a feat", models.AttributeWisdom, models.AttributeCheckFeat, func(c *models.Character) {}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := attributeCheckCharacter()
+			tt.setup(character)
+			if _, _, err := character.AttributeCheckChance(tt.attribute, tt.kind); err == nil {
+				t.Errorf("AttributeCheckChance(%q, %q) error = nil, want an error", tt.attribute, tt.kind)
+			}
+		})
+	}
+}
+
+func TestBoundAttributeChance(t *testing.T) {
+	tests := []struct {
+		name      string
+		kind      string
+		base      int
+		die       int
+		condition int
+		want      int
+	}{
+		{"test within the die", models.AttributeCheckTest, 3, models.AttributeTestDie, 1, 4},
+		{"test bonus stops short of the die", models.AttributeCheckTest, 4, models.AttributeTestDie, 3, 5},
+		{"test penalty stops at 0", models.AttributeCheckTest, 2, models.AttributeTestDie, -4, 0},
+		{"feat bonus stops short of 100", models.AttributeCheckFeat, 95, models.AttributeFeatDie, 2, 99},
+		{"feat penalty stops at 0", models.AttributeCheckFeat, 8, models.AttributeFeatDie, -2, 0},
+		{"check bonus stops short of 20", models.AttributeCheckScore, 18, models.AttributeCheckDie, 4, 19},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chance := tt.base + models.AttributeCheckModifier(tt.kind, tt.condition)
+			if got := models.BoundAttributeChance(chance, tt.die); got != tt.want {
+				t.Errorf("BoundAttributeChance(%d, %d) = %d, want %d", chance, tt.die, got, tt.want)
+			}
+		})
+	}
+}
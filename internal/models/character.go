@@ -1,7 +1,6 @@
 package models
 
 import (
-	"fmt"
 	"time"
 )
 
@@ -10,6 +9,7 @@ type Character struct {
 	UserID           int64  `json:"user_id"`
 	Name             string `json:"name"`
 	Class            string `json:"class"`
+	Kindred          string `json:"kindred"`
 	Level            int    `json:"level"`
 	ExperiencePoints int    `json:"experience_points"`
 	Strength         int    `json:"strength"`
@@ -19,6 +19,28 @@ type Character struct {
 	Intelligence     int    `json:"intelligence"`
 	Charisma         int    `json:"charisma"`
 
+	// Kindred details, loaded when the character is enriched. AdjustedAttributes
+	// are the scores after kindred modifiers and drive the derived stats.
+	KindredTraits      *Kindred       `json:"kindred_traits,omitempty"`
+	AdjustedAttributes *AbilityScores `json:"adjusted_attributes,omitempty"`
+	Languages          []string       `json:"languages,omitempty"`
+
+	// Timed effects such as potions, loaded with the character. Attributes
+	// they set are reflected in AdjustedAttributes and the derived stats.
+	ActiveEffects []ActiveEffect `json:"active_effects,omitempty"`
+
+	// Damage, drain and bonuses to the attributes and level, loaded with the
+	// character. Level and the attributes above hold the base values; see
+	// EffectiveAttributes and EffectiveLevel for the current ones.
+	AttributeAdjustments []AttributeAdjustment `json:"attribute_adjustments,omitempty"`
+
+	// Conditions such as blinded or blessed, loaded with the character, and
+	// their combined modifiers. Movement is applied to MovementRate; the rest
+	// are applied by the AC, weapon, saving throw and attribute check
+	// calculations.
+	Conditions         []CharacterCondition `json:"conditions,omitempty"`
+	ConditionModifiers *ConditionModifiers  `json:"condition_modifiers,omitempty"`
+
 	SurpriseChance  int `json:"surprise_chance,omitempty"`
 	FindSecretDoors int `json:"find_secret_doors,omitempty"`
 
@@ -26,6 +48,13 @@ type Character struct {
 	CurrentHitPoints   int `json:"current_hit_points"`
 	TemporaryHitPoints int `json:"temporary_hit_points"`
 
+	// Vitality follows from the hit points above and whether a dying
+	// character's wounds have been bound; see VitalityFor. Dead and retired
+	// characters are read-only.
+	Vitality   string     `json:"vitality"`
+	Stabilized bool       `json:"stabilized,omitempty"`
+	RetiredAt  *time.Time `json:"retired_at,omitempty"`
+
 	HitDice         string `json:"hit_dice,omitempty"`
 	SavingThrow     int    `json:"saving_throw,omitempty"`
 	FightingAbility int    `json:"fighting_ability,omitempty"`
@@ -49,6 +78,12 @@ type Character struct {
 	NaturalAC           int               `json:"natural_ac,omitempty"`
 	ThickSkinArmorBonus int               `json:"thick_skin_armor_bonus,omitempty"`
 
+	// Armor restrictions from the class rules; ArmorWarning is set when the
+	// equipped armor or shield breaks them
+	MaxArmor       string `json:"max_armor,omitempty"`
+	ShieldsAllowed bool   `json:"shields_allowed"`
+	ArmorWarning   string `json:"armor_warning,omitempty"`
+
 	//Non Derived Stats
 	MovementRate int `json:"movement_rate,omitempty"`
 
@@ -93,6 +128,7 @@ type CreateCharacterInput struct {
 	UserID           int64  `json:"user_id"`
 	Name             string `json:"name"`
 	Class            string `json:"class"`
+	Kindred          string `json:"kindred,omitempty"`
 	Level            int    `json:"level"`
 	ExperiencePoints int    `json:"experience_points"`
 	Strength         int    `json:"strength"`
@@ -105,11 +141,15 @@ type CreateCharacterInput struct {
 	MaxHitPoints       int `json:"max_hit_points"`
 	CurrentHitPoints   int `json:"current_hit_points"`
 	TemporaryHitPoints int `json:"temporary_hit_points"`
+
+	// AbilityRollID links the attributes to a server-generated ability roll
+	AbilityRollID int64 `json:"ability_roll_id,omitempty"`
 }
 
 type UpdateCharacterInput struct {
 	Name               string `json:"name"`
 	Class              string `json:"class"`
+	Kindred            string `json:"kindred,omitempty"`
 	Level              int    `json:"level"`
 	ExperiencePoints   int    `json:"experience_points"`
 	Strength           int    `json:"strength"`
@@ -230,110 +270,260 @@ func (e *ValidationError) Error() string {
 }
 
 func (c *Character) CalculateDerivedStats() {
-	c.calculateStrengthModifiers()
-	c.calculateDexterityModifiers()
-	c.calculateConstitutionModifiers()
-	c.calculateIntelligenceModifiers()
-	c.calculateWisdomModifiers()
-	c.calculateCharismaModifiers()
+	scores := c.EffectiveAttributes()
+	c.AdjustedAttributes = nil
+	if c.KindredTraits != nil || len(c.ActiveEffects) > 0 || len(c.AttributeAdjustments) > 0 {
+		c.AdjustedAttributes = &scores
+	}
+	if c.KindredTraits != nil {
+		c.Languages = c.KindredTraits.Languages
+	}
+
+	c.calculateStrengthModifiers(scores.Strength)
+	c.calculateDexterityModifiers(scores.Dexterity)
+	c.calculateConstitutionModifiers(scores.Constitution)
+	c.calculateIntelligenceModifiers(scores.Intelligence)
+	c.calculateWisdomModifiers(scores.Wisdom)
+	c.calculateCharismaModifiers(scores.Charisma)
 
 	c.SurpriseChance = 2
 	c.MovementRate = 40
 	c.FindSecretDoors = 2
 
+	c.ConditionModifiers = nil
+	if len(c.Conditions) > 0 {
+		modifiers := sumConditionModifiers(c.Conditions)
+		c.ConditionModifiers = &modifiers
+		c.MovementRate = max(c.MovementRate+modifiers.Movement, 0)
+	}
+
 }
 
-func (c *Character) calculateStrengthModifiers() {
+// Saving throw categories
+const (
+	SaveDeath          = "death"
+	SaveTransformation = "transformation"
+	SaveDevice         = "device"
+	SaveSorcery        = "sorcery"
+	SaveAvoidance      = "avoidance"
+)
+
+// IsValidSaveCategory reports whether category is one of the five saving
+// throw categories
+func IsValidSaveCategory(category string) bool {
+	switch category {
+	case SaveDeath, SaveTransformation, SaveDevice, SaveSorcery, SaveAvoidance:
+		return true
+	}
+	return false
+}
+
+// SaveBonus returns the character's bonus to saving throws of the given
+// category, including the modifiers of any conditions on them
+func (c *Character) SaveBonus(category string) int {
+	bonus := c.ClassSaveBonus(category)
+	if c.ConditionModifiers != nil {
+		bonus += c.ConditionModifiers.Save
+	}
+	return bonus
+}
+
+// ClassSaveBonus returns the bonus the character's class grants to saving
+// throws of the given category
+func (c *Character) ClassSaveBonus(category string) int {
+	switch category {
+	case SaveDeath:
+		return c.DeathSaveBonus
+	case SaveTransformation:
+		return c.TransformationSaveBonus
+	case SaveDevice:
+		return c.DeviceSaveBonus
+	case SaveSorcery:
+		return c.SorcerySaveBonus
+	case SaveAvoidance:
+		return c.AvoidanceSaveBonus
+	}
+	return 0
+}
+
+// AttributeSaveBonus returns the attribute modifier that applies to saving
+// throws of the given category and the name it goes by. Willpower (WIS)
+// applies to sorcery; the poison/radiation modifier (CON) applies to death,
+// which covers poison, and to transformation, which covers radiation.
+func (c *Character) AttributeSaveBonus(category string) (string, int) {
+	switch category {
+	case SaveSorcery:
+		return "willpower", c.WillpowerModifier
+	case SaveDeath, SaveTransformation:
+		return "poison/radiation", c.PoisonRadModifier
+	}
+	return "", 0
+}
+
+// MinHitPoints is the lowest a character's hit points can fall; at this point
+// the character is dead
+const MinHitPoints = -10
+
+// ModifyHitPoints applies damage (negative delta) or healing (positive delta).
+// Damage is taken from temporary hit points first and cannot take the
+// character below MinHitPoints; healing cannot exceed MaxHitPoints. When temp
+// is set a positive delta is added as temporary hit points instead. Fresh
+// wounds, or healing back above 0, end any stabilization.
+func (c *Character) ModifyHitPoints(delta int, temp bool) {
+	if delta < 0 {
+		damage := -delta
+		absorbed := min(damage, c.TemporaryHitPoints)
+		c.TemporaryHitPoints -= absorbed
+		damage -= absorbed
+		c.CurrentHitPoints = max(c.CurrentHitPoints-damage, MinHitPoints)
+		if damage > 0 {
+			c.Stabilized = false
+		}
+	} else if delta > 0 {
+		if temp {
+			c.TemporaryHitPoints += delta
+		} else {
+			c.CurrentHitPoints = min(c.CurrentHitPoints+delta, c.MaxHitPoints)
+		}
+	}
+	if c.CurrentHitPoints > 0 {
+		c.Stabilized = false
+	}
+	c.UpdateVitality()
+}
+
+// UpdateInput returns an update that writes back the character's current values
+func (c *Character) UpdateInput() *UpdateCharacterInput {
+	return &UpdateCharacterInput{
+		Name:               c.Name,
+		Class:              c.Class,
+		Kindred:            c.Kindred,
+		Level:              c.Level,
+		ExperiencePoints:   c.ExperiencePoints,
+		Strength:           c.Strength,
+		Dexterity:          c.Dexterity,
+		Constitution:       c.Constitution,
+		Wisdom:             c.Wisdom,
+		Intelligence:       c.Intelligence,
+		Charisma:           c.Charisma,
+		MaxHitPoints:       c.MaxHitPoints,
+		CurrentHitPoints:   c.CurrentHitPoints,
+		TemporaryHitPoints: c.TemporaryHitPoints,
+	}
+}
+
+// EffectiveAttributes returns the character's attributes with any kindred
+// modifiers and attribute adjustments applied, kept within the 3-18 range,
+// then overridden by any active effects
+func (c *Character) EffectiveAttributes() AbilityScores {
+	scores := AbilityScores{
+		Strength:     c.Strength,
+		Dexterity:    c.Dexterity,
+		Constitution: c.Constitution,
+		Intelligence: c.Intelligence,
+		Wisdom:       c.Wisdom,
+		Charisma:     c.Charisma,
+	}
+	if k := c.KindredTraits; k != nil {
+		scores.Strength = clampAttribute(scores.Strength + k.StrengthModifier)
+		scores.Dexterity = clampAttribute(scores.Dexterity + k.DexterityModifier)
+		scores.Constitution = clampAttribute(scores.Constitution + k.ConstitutionModifier)
+		scores.Intelligence = clampAttribute(scores.Intelligence + k.IntelligenceModifier)
+		scores.Wisdom = clampAttribute(scores.Wisdom + k.WisdomModifier)
+		scores.Charisma = clampAttribute(scores.Charisma + k.CharismaModifier)
+	}
+	scores.applyAdjustments(c.AttributeAdjustments)
+	scores.applyEffects(c.ActiveEffects)
+	return scores
+}
+
+func clampAttribute(score int) int {
+	return max(3, min(18, score))
+}
+
+func (c *Character) calculateStrengthModifiers(strength int) {
 	switch {
-	case c.Strength == 3:
+	case strength == 3:
 		c.MeleeModifier = -2
 		c.DamageAdjustment = -2
 		c.StrengthTest = "1:6"
 		c.ExtraStrengthFeat = "0%"
-	case c.Strength >= 4 && c.Strength <= 6:
+	case strength >= 4 && strength <= 6:
 		c.MeleeModifier = -1
 		c.DamageAdjustment = -1
 		c.StrengthTest = "1:6"
 		c.ExtraStrengthFeat = "1%"
-	case c.Strength >= 7 && c.Strength <= 8:
+	case strength >= 7 && strength <= 8:
 		c.MeleeModifier = 0
 		c.DamageAdjustment = -1
 		c.StrengthTest = "2:6"
 		c.ExtraStrengthFeat = "2%"
-	case c.Strength >= 9 && c.Strength <= 12:
+	case strength >= 9 && strength <= 12:
 		c.MeleeModifier = 0
 		c.DamageAdjustment = 0
 		c.StrengthTest = "2:6"
 		c.ExtraStrengthFeat = "4%"
-	case c.Strength >= 13 && c.Strength <= 14:
+	case strength >= 13 && strength <= 14:
 		c.MeleeModifier = 0
 		c.DamageAdjustment = 1
 		c.StrengthTest = "3:6"
-		c.ExtraStrengthFeat = "8"
-	case c.Strength >= 15 && c.Strength <= 16:
+		c.ExtraStrengthFeat = "8%"
+	case strength >= 15 && strength <= 16:
 		c.MeleeModifier = 1
 		c.DamageAdjustment = 1
 		c.StrengthTest = "3:6"
 		c.ExtraStrengthFeat = "16%"
-	case c.Strength == 17:
+	case strength == 17:
 		c.MeleeModifier = 1
 		c.DamageAdjustment = 2
 		c.StrengthTest = "4:6"
 		c.ExtraStrengthFeat = "24%"
-	case c.Strength == 18:
+	case strength == 18:
 		c.MeleeModifier = 2
 		c.DamageAdjustment = 3
 		c.StrengthTest = "5:6"
 		c.ExtraStrengthFeat = "32%"
 	}
-	if c.Class == "Fighter" {
-		// Parse the current percentage value
-		var currentPercent int
-		fmt.Sscanf(c.ExtraStrengthFeat, "%d%%", &currentPercent)
-
-		// Apply the 8% bonus
-		c.ExtraStrengthFeat = fmt.Sprintf("%d%%", currentPercent+8)
-	}
 }
 
-func (c *Character) calculateDexterityModifiers() {
+func (c *Character) calculateDexterityModifiers(dexterity int) {
 	switch {
-	case c.Dexterity == 3:
+	case dexterity == 3:
 		c.RangedModifier = -2
 		c.DefenceAdjustment = -2
 		c.DexterityTest = "1:6"
 		c.ExtraDexterityFeat = "0%"
-	case c.Dexterity >= 4 && c.Dexterity <= 6:
+	case dexterity >= 4 && dexterity <= 6:
 		c.RangedModifier = -1
 		c.DefenceAdjustment = -1
 		c.DexterityTest = "1:6"
 		c.ExtraDexterityFeat = "1%"
-	case c.Dexterity >= 7 && c.Dexterity <= 8:
+	case dexterity >= 7 && dexterity <= 8:
 		c.RangedModifier = -1
 		c.DefenceAdjustment = 0
 		c.DexterityTest = "2:6"
 		c.ExtraDexterityFeat = "2%"
-	case c.Dexterity >= 9 && c.Dexterity <= 12:
+	case dexterity >= 9 && dexterity <= 12:
 		c.RangedModifier = 0
 		c.DefenceAdjustment = 0
 		c.DexterityTest = "2:6"
 		c.ExtraDexterityFeat = "4%"
-	case c.Dexterity >= 13 && c.Dexterity <= 14:
+	case dexterity >= 13 && dexterity <= 14:
 		c.RangedModifier = 1
 		c.DefenceAdjustment = 0
 		c.DexterityTest = "3:6"
 		c.ExtraDexterityFeat = "8%"
-	case c.Dexterity >= 15 && c.Dexterity <= 16:
+	case dexterity >= 15 && dexterity <= 16:
 		c.RangedModifier = 1
 		c.DefenceAdjustment = 1
 		c.DexterityTest = "3:6"
 		c.ExtraDexterityFeat = "16%"
-	case c.Dexterity == 17:
+	case dexterity == 17:
 		c.RangedModifier = 2
 		c.DefenceAdjustment = 1
 		c.DexterityTest = "4:6"
 		c.ExtraDexterityFeat = "24%"
-	case c.Dexterity == 18:
+	case dexterity == 18:
 		c.RangedModifier = 3
 		c.DefenceAdjustment = 2
 		c.DexterityTest = "5:6"
@@ -341,51 +531,51 @@ func (c *Character) calculateDexterityModifiers() {
 	}
 }
 
-func (c *Character) calculateConstitutionModifiers() {
+func (c *Character) calculateConstitutionModifiers(constitution int) {
 	switch {
-	case c.Constitution == 3:
+	case constitution == 3:
 		c.HPModifier = -1
 		c.PoisonRadModifier = -2
 		c.TraumaSurvival = "45%"
 		c.ConstitutionTest = "1:6"
 		c.ExtraConstitutionFeat = "0%"
-	case c.Constitution >= 4 && c.Constitution <= 6:
+	case constitution >= 4 && constitution <= 6:
 		c.HPModifier = -1
 		c.PoisonRadModifier = -1
 		c.TraumaSurvival = "55%"
 		c.ConstitutionTest = "1:6"
 		c.ExtraConstitutionFeat = "1%"
-	case c.Constitution >= 7 && c.Constitution <= 8:
+	case constitution >= 7 && constitution <= 8:
 		c.HPModifier = 0
 		c.PoisonRadModifier = 0
 		c.TraumaSurvival = "65%"
 		c.ConstitutionTest = "2:6"
 		c.ExtraConstitutionFeat = "2%"
-	case c.Constitution >= 9 && c.Constitution <= 12:
+	case constitution >= 9 && constitution <= 12:
 		c.HPModifier = 0
 		c.PoisonRadModifier = 0
 		c.TraumaSurvival = "75%"
 		c.ConstitutionTest = "2:6"
 		c.ExtraConstitutionFeat = "4%"
-	case c.Constitution >= 13 && c.Constitution <= 14:
+	case constitution >= 13 && constitution <= 14:
 		c.HPModifier = 1
 		c.PoisonRadModifier = 0
 		c.TraumaSurvival = "80%"
 		c.ConstitutionTest = "3:6"
 		c.ExtraConstitutionFeat = "8%"
-	case c.Constitution >= 15 && c.Constitution <= 16:
+	case constitution >= 15 && constitution <= 16:
 		c.HPModifier = 1
 		c.PoisonRadModifier = 1
 		c.TraumaSurvival = "85%"
 		c.ConstitutionTest = "3:6"
 		c.ExtraConstitutionFeat = "16%"
-	case c.Constitution == 17:
+	case constitution == 17:
 		c.HPModifier = 2
 		c.PoisonRadModifier = 1
 		c.TraumaSurvival = "90%"
 		c.ConstitutionTest = "4:6"
 		c.ExtraConstitutionFeat = "24%"
-	case c.Constitution == 18:
+	case constitution == 18:
 		c.HPModifier = 3
 		c.PoisonRadModifier = 2
 		c.TraumaSurvival = "95%"
@@ -394,111 +584,111 @@ func (c *Character) calculateConstitutionModifiers() {
 	}
 }
 
-func (c *Character) calculateIntelligenceModifiers() {
+func (c *Character) calculateIntelligenceModifiers(intelligence int) {
 	switch {
-	case c.Intelligence == 3:
+	case intelligence == 3:
 		c.LanguageModifier = "Illiterate"
 		c.MagiciansBonus = "N/A"
 		c.MagiciansChance = "N/A"
-	case c.Intelligence >= 4 && c.Intelligence <= 6:
+	case intelligence >= 4 && intelligence <= 6:
 		c.LanguageModifier = "Illiterate"
 		c.MagiciansBonus = "N/A"
 		c.MagiciansChance = "N/A"
-	case c.Intelligence >= 7 && c.Intelligence <= 8:
+	case intelligence >= 7 && intelligence <= 8:
 		c.LanguageModifier = "0"
 		c.MagiciansBonus = "N/A"
 		c.MagiciansChance = "N/A"
-	case c.Intelligence >= 9 && c.Intelligence <= 12:
+	case intelligence >= 9 && intelligence <= 12:
 		c.LanguageModifier = "0"
 		c.MagiciansBonus = "-"
 		c.MagiciansChance = "50%"
-	case c.Intelligence >= 13 && c.Intelligence <= 14:
+	case intelligence >= 13 && intelligence <= 14:
 		c.LanguageModifier = "+1"
 		c.MagiciansBonus = "One Level 1"
 		c.MagiciansChance = "65%"
-	case c.Intelligence >= 15 && c.Intelligence <= 16:
+	case intelligence >= 15 && intelligence <= 16:
 		c.LanguageModifier = "+1"
 		c.MagiciansBonus = "One Level 2"
 		c.MagiciansChance = "75%"
-	case c.Intelligence == 17:
+	case intelligence == 17:
 		c.LanguageModifier = "+2"
 		c.MagiciansBonus = "One Level 3"
 		c.MagiciansChance = "85%"
-	case c.Intelligence == 18:
+	case intelligence == 18:
 		c.LanguageModifier = "+3"
 		c.MagiciansBonus = "One Level 4"
 		c.MagiciansChance = "95%"
 	}
 }
 
-func (c *Character) calculateWisdomModifiers() {
+func (c *Character) calculateWisdomModifiers(wisdom int) {
 	switch {
-	case c.Wisdom == 3:
+	case wisdom == 3:
 		c.WillpowerModifier = -2
 		c.ClericBonus = "N/A"
 		c.ClericChance = "N/A"
-	case c.Wisdom >= 4 && c.Wisdom <= 6:
+	case wisdom >= 4 && wisdom <= 6:
 		c.WillpowerModifier = -1
 		c.ClericBonus = "N/A"
 		c.ClericChance = "N/A"
-	case c.Wisdom >= 7 && c.Wisdom <= 8:
+	case wisdom >= 7 && wisdom <= 8:
 		c.WillpowerModifier = 0
 		c.ClericBonus = "-"
 		c.ClericChance = "50%"
-	case c.Wisdom >= 9 && c.Wisdom <= 12:
+	case wisdom >= 9 && wisdom <= 12:
 		c.WillpowerModifier = 0
 		c.ClericBonus = "-"
 		c.ClericChance = "50%"
-	case c.Wisdom >= 13 && c.Wisdom <= 14:
+	case wisdom >= 13 && wisdom <= 14:
 		c.WillpowerModifier = 1
 		c.ClericBonus = "One Level 1"
 		c.ClericChance = "65%"
-	case c.Wisdom >= 15 && c.Wisdom <= 16:
+	case wisdom >= 15 && wisdom <= 16:
 		c.WillpowerModifier = 1
 		c.ClericBonus = "One Level 2"
 		c.ClericChance = "75%"
-	case c.Wisdom == 17:
+	case wisdom == 17:
 		c.WillpowerModifier = 2
 		c.ClericBonus = "One Level 3"
 		c.ClericChance = "85%"
-	case c.Wisdom == 18:
+	case wisdom == 18:
 		c.WillpowerModifier = 3
 		c.ClericBonus = "One Level 4"
 		c.ClericChance = "95%"
 	}
 }
 
-func (c *Character) calculateCharismaModifiers() {
+func (c *Character) calculateCharismaModifiers(charisma int) {
 	switch {
-	case c.Charisma == 3:
+	case charisma == 3:
 		c.ReactionModifier = -3
 		c.MaxFollowers = 1
 		c.UndeadTurningModifier = -1
-	case c.Charisma >= 4 && c.Charisma <= 6:
+	case charisma >= 4 && charisma <= 6:
 		c.ReactionModifier = -2
 		c.MaxFollowers = 2
 		c.UndeadTurningModifier = -1
-	case c.Charisma >= 7 && c.Charisma <= 8:
+	case charisma >= 7 && charisma <= 8:
 		c.ReactionModifier = -1
 		c.MaxFollowers = 3
 		c.UndeadTurningModifier = 0
-	case c.Charisma >= 9 && c.Charisma <= 12:
+	case charisma >= 9 && charisma <= 12:
 		c.ReactionModifier = 0
 		c.MaxFollowers = 4
 		c.UndeadTurningModifier = 0
-	case c.Charisma >= 13 && c.Charisma <= 14:
+	case charisma >= 13 && charisma <= 14:
 		c.ReactionModifier = 1
 		c.MaxFollowers = 6
 		c.UndeadTurningModifier = +1
-	case c.Charisma >= 15 && c.Charisma <= 16:
+	case charisma >= 15 && charisma <= 16:
 		c.ReactionModifier = 1
 		c.MaxFollowers = 8
 		c.UndeadTurningModifier = +1
-	case c.Charisma == 17:
+	case charisma == 17:
 		c.ReactionModifier = 2
 		c.MaxFollowers = 10
 		c.UndeadTurningModifier = +1
-	case c.Charisma == 18:
+	case charisma == 18:
 		c.ReactionModifier = 3
 		c.MaxFollowers = 12
 		c.UndeadTurningModifier = +1
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+)
+
+const (
+	GrantTypeShare = "share"
+	GrantTypeGM    = "gm"
+)
+
+// CharacterGrant gives a user other than the owner access to a character.
+// A share grant is read-only; a GM grant allows the same changes the owner can make.
+type CharacterGrant struct {
+	ID          int64     `json:"id"`
+	CharacterID int64     `json:"character_id"`
+	UserID      int64     `json:"user_id"`
+	GrantType   string    `json:"grant_type"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// CreateCharacterGrantInput represents input data for granting access to a character
+type CreateCharacterGrantInput struct {
+	CharacterID int64  `json:"character_id"`
+	UserID      int64  `json:"user_id"`
+	GrantType   string `json:"grant_type"`
+}
+
+func (i *CreateCharacterGrantInput) Validate() error {
+	if i.CharacterID <= 0 {
+		return NewValidationError("character_id", "Character ID must be positive")
+	}
+	if i.UserID <= 0 {
+		return NewValidationError("user_id", "User ID must be positive")
+	}
+	if i.GrantType != GrantTypeShare && i.GrantType != GrantTypeGM {
+		return NewValidationError("grant_type", "Grant type must be 'share' or 'gm'")
+	}
+	return nil
+}
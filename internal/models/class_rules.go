@@ -0,0 +1,131 @@
+package models
+
+// Class rule hooks name behaviour implemented in code that a class opts into
+// through its class_rules row
+const (
+	ClassHookAgile              = "agile"
+	ClassHookRun                = "run"
+	ClassHookExtraStrengthFeat  = "extra_strength_feat"
+	ClassHookExtraDexterityFeat = "extra_dexterity_feat"
+	ClassHookNaturalAC          = "natural_ac"
+	ClassHookRunesPerDay        = "runes_per_day"
+	ClassHookMartialArts        = "martial_arts"
+	ClassHookBackstab           = "backstab"
+)
+
+// IsClassHook reports whether name is a hook the class service implements
+func IsClassHook(name string) bool {
+	switch name {
+	case ClassHookAgile, ClassHookRun, ClassHookExtraStrengthFeat, ClassHookExtraDexterityFeat,
+		ClassHookNaturalAC, ClassHookRunesPerDay, ClassHookMartialArts, ClassHookBackstab:
+		return true
+	}
+	return false
+}
+
+// Caster types. Arcane casters study spells from spellbooks; divine casters
+// receive them through prayer.
+const (
+	CasterArcane = "arcane"
+	CasterDivine = "divine"
+)
+
+// Maximum armor a class may wear, from least to most restrictive
+const (
+	MaxArmorHeavy  = "Heavy"
+	MaxArmorMedium = "Medium"
+	MaxArmorLight  = "Light"
+	MaxArmorNone   = "None"
+)
+
+// ClassRules holds the declarative rules of a class: its save bonuses,
+// armor restrictions, prime attributes, how it casts spells and the hooks
+// applied when a character is enriched. CastingClass names the spell list
+// the class learns from, and CastingAttribute the attribute that sets how
+// many spells it may know.
+type ClassRules struct {
+	ClassName               string   `json:"class_name"`
+	DeathSaveBonus          int      `json:"death_save_bonus"`
+	TransformationSaveBonus int      `json:"transformation_save_bonus"`
+	DeviceSaveBonus         int      `json:"device_save_bonus"`
+	SorcerySaveBonus        int      `json:"sorcery_save_bonus"`
+	AvoidanceSaveBonus      int      `json:"avoidance_save_bonus"`
+	SurpriseChance          *int     `json:"surprise_chance,omitempty"`
+	MaxArmor                string   `json:"max_armor"`
+	ShieldsAllowed          bool     `json:"shields_allowed"`
+	Hooks                   []string `json:"hooks"`
+	PrimeAttributes         []string `json:"prime_attributes"`
+	CasterType              string   `json:"caster_type"`
+	CastingClass            string   `json:"casting_class"`
+	CastingAttribute        string   `json:"casting_attribute"`
+}
+
+// DefaultClassRules returns unrestricted rules with no bonuses, used for
+// classes that have no class_rules row
+func DefaultClassRules(className string) *ClassRules {
+	return &ClassRules{
+		ClassName:       className,
+		MaxArmor:        MaxArmorHeavy,
+		ShieldsAllowed:  true,
+		Hooks:           []string{},
+		PrimeAttributes: []string{},
+	}
+}
+
+// HasHook reports whether the class opts into the named hook
+func (r *ClassRules) HasHook(name string) bool {
+	for _, hook := range r.Hooks {
+		if hook == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsArcane reports whether the class casts arcane spells
+func (r *ClassRules) IsArcane() bool {
+	return r.CasterType == CasterArcane
+}
+
+// IsDivine reports whether the class casts divine spells
+func (r *ClassRules) IsDivine() bool {
+	return r.CasterType == CasterDivine
+}
+
+// MaxSpellLevel returns the highest spell level the class can cast at a
+// character level, or 0 if it cannot cast
+func (r *ClassRules) MaxSpellLevel(level int) int {
+	var thresholds []int
+	switch r.CasterType {
+	case CasterDivine:
+		thresholds = []int{1, 3, 5, 9, 15, 17}
+	case CasterArcane:
+		thresholds = []int{1, 3, 7, 11, 14, 17}
+	}
+	maxLevel := 0
+	for i, minLevel := range thresholds {
+		if level >= minLevel {
+			maxLevel = i + 1
+		}
+	}
+	return maxLevel
+}
+
+// AllowsArmor reports whether armor of the given weight class may be worn
+func (r *ClassRules) AllowsArmor(weightClass string) bool {
+	rank := map[string]int{
+		MaxArmorNone:   0,
+		MaxArmorLight:  1,
+		MaxArmorMedium: 2,
+		MaxArmorHeavy:  3,
+	}
+	allowed, ok := rank[r.MaxArmor]
+	if !ok {
+		return true
+	}
+	worn, ok := rank[weightClass]
+	if !ok {
+		return true
+	}
+	return worn <= allowed
+}
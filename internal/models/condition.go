@@ -0,0 +1,125 @@
+package models
+
+import (
+	"time"
+)
+
+// Condition is a state a character can be in, such as blinded or blessed,
+// with the modifiers it applies while it lasts. ACBonus follows the shield
+// convention: a positive bonus improves (lowers) armour class. CheckModifier
+// adjusts attribute checks; see AttributeCheckModifier.
+type Condition struct {
+	ID               int64     `json:"id"`
+	Name             string    `json:"name"`
+	Description      string    `json:"description"`
+	ToHitModifier    int       `json:"to_hit_modifier"`
+	ACBonus          int       `json:"ac_bonus"`
+	SaveModifier     int       `json:"save_modifier"`
+	MovementModifier int       `json:"movement_modifier"`
+	CheckModifier    int       `json:"check_modifier"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+type CreateConditionInput struct {
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	ToHitModifier    int    `json:"to_hit_modifier"`
+	ACBonus          int    `json:"ac_bonus"`
+	SaveModifier     int    `json:"save_modifier"`
+	MovementModifier int    `json:"movement_modifier"`
+	CheckModifier    int    `json:"check_modifier"`
+}
+
+type UpdateConditionInput = CreateConditionInput
+
+// MaxConditionModifier bounds the to-hit, AC, save and check modifiers of a
+// condition
+const MaxConditionModifier = 10
+
+func (i *CreateConditionInput) Validate() error {
+	if i.Name == "" {
+		return NewValidationError("name", "Name cannot be empty")
+	}
+	modifiers := map[string]int{
+		"to_hit_modifier": i.ToHitModifier,
+		"ac_bonus":        i.ACBonus,
+		"save_modifier":   i.SaveModifier,
+		"check_modifier":  i.CheckModifier,
+	}
+	for field, modifier := range modifiers {
+		if modifier < -MaxConditionModifier || modifier > MaxConditionModifier {
+			return NewValidationError(field, "Modifier must be between -10 and 10")
+		}
+	}
+	return nil
+}
+
+// CharacterCondition is a condition affecting a character. RemainingRounds
+// counts down as game time is advanced; nil lasts until it is removed.
+type CharacterCondition struct {
+	ID               int64     `json:"id"`
+	CharacterID      int64     `json:"character_id"`
+	ConditionID      int64     `json:"condition_id"`
+	Name             string    `json:"name"`
+	ToHitModifier    int       `json:"to_hit_modifier"`
+	ACBonus          int       `json:"ac_bonus"`
+	SaveModifier     int       `json:"save_modifier"`
+	MovementModifier int       `json:"movement_modifier"`
+	CheckModifier    int       `json:"check_modifier"`
+	Source           string    `json:"source,omitempty"`
+	DurationRounds   *int      `json:"duration_rounds,omitempty"`
+	RemainingRounds  *int      `json:"remaining_rounds,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// ApplyConditionInput puts a condition on a character, for the given time or
+// until removed when no time is given
+type ApplyConditionInput struct {
+	ConditionID int64  `json:"condition_id"`
+	Rounds      int    `json:"rounds,omitempty"`
+	Turns       int    `json:"turns,omitempty"`
+	Days        int    `json:"days,omitempty"`
+	Source      string `json:"source,omitempty"`
+}
+
+func (i *ApplyConditionInput) Validate() error {
+	if i.ConditionID <= 0 {
+		return NewValidationError("condition_id", "Condition ID must be positive")
+	}
+	if i.Rounds < 0 || i.Turns < 0 || i.Days < 0 {
+		return NewValidationError("rounds", "Duration cannot be negative")
+	}
+	if len(i.Source) > 200 {
+		return NewValidationError("source", "Source cannot exceed 200 characters")
+	}
+	return nil
+}
+
+// TotalRounds returns the condition's duration in rounds, zero if it lasts
+// until removed
+func (i *ApplyConditionInput) TotalRounds() int {
+	return durationInRounds(i.Rounds, i.Turns, i.Days)
+}
+
+// ConditionModifiers are the combined modifiers of a character's conditions
+type ConditionModifiers struct {
+	ToHit    int `json:"to_hit"`
+	ACBonus  int `json:"ac_bonus"`
+	Save     int `json:"save"`
+	Movement int `json:"movement"`
+	Check    int `json:"check"`
+}
+
+// sumConditionModifiers totals the modifiers of the given conditions
+func sumConditionModifiers(conditions []CharacterCondition) ConditionModifiers {
+	var total ConditionModifiers
+	for _, condition := range conditions {
+		total.ToHit += condition.ToHitModifier
+		total.ACBonus += condition.ACBonus
+		total.Save += condition.SaveModifier
+		total.Movement += condition.MovementModifier
+		total.Check += condition.CheckModifier
+	}
+	return total
+}
@@ -0,0 +1,88 @@
+package models_test
+
+import (
+	"testing"
+
+	"mordezzanV4/internal/models"
+)
+
+func newConditionTestCharacter(conditions ...models.CharacterCondition) *models.Character {
+	return &models.Character{
+		Strength:                10,
+		Dexterity:               10,
+		Constitution:            10,
+		Intelligence:            10,
+		Wisdom:                  10,
+		Charisma:                10,
+		DeathSaveBonus:          2,
+		TransformationSaveBonus: 2,
+		Conditions:              conditions,
+	}
+}
+
+func TestConditionModifiers(t *testing.T) {
+	blessed := models.CharacterCondition{Name: "Blessed", ToHitModifier: 1, SaveModifier: 1}
+	blinded := models.CharacterCondition{Name: "Blinded", ToHitModifier: -4, ACBonus: -2, MovementModifier: -20}
+	slowed := models.CharacterCondition{Name: "Slowed", ACBonus: -2, MovementModifier: -30, CheckModifier: -2}
+
+	tests := []struct {
+		name       string
+		conditions []models.CharacterCondition
+		want       *models.ConditionModifiers
+		movement   int
+		deathSave  int
+	}{
+		{"no conditions", nil, nil, 40, 2},
+		{"single condition", []models.CharacterCondition{blessed},
+			&models.ConditionModifiers{ToHit: 1, Save: 1}, 40, 3},
+		{"conditions stack", []models.CharacterCondition{blessed, blinded},
+			&models.ConditionModifiers{ToHit: -3, ACBonus: -2, Save: 1, Movement: -20}, 20, 3},
+		{"movement cannot fall below 0", []models.CharacterCondition{blinded, slowed},
+			&models.ConditionModifiers{ToHit: -4, ACBonus: -4, Movement: -50, Check: -2}, 0, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := newConditionTestCharacter(tt.conditions...)
+			character.CalculateDerivedStats()
+
+			switch {
+			case tt.want == nil && character.ConditionModifiers != nil:
+				t.Errorf("ConditionModifiers = %+v, want nil", *character.ConditionModifiers)
+			case tt.want != nil && character.ConditionModifiers == nil:
+				t.Errorf("ConditionModifiers = nil, want %+v", *tt.want)
+			case tt.want != nil && *character.ConditionModifiers != *tt.want:
+				t.Errorf("ConditionModifiers = %+v, want %+v", *character.ConditionModifiers, *tt.want)
+			}
+			if character.MovementRate != tt.movement {
+				t.Errorf("MovementRate = %d, want %d", character.MovementRate, tt.movement)
+			}
+			if got := character.SaveBonus(models.SaveDeath); got != tt.deathSave {
+				t.Errorf("SaveBonus(death) = %d, want %d", got, tt.deathSave)
+			}
+		})
+	}
+}
+
+func TestCreateConditionInputValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   models.CreateConditionInput
+		wantErr bool
+	}{
+		{"valid", models.CreateConditionInput{Name: "Blessed", ToHitModifier: 1, SaveModifier: 1}, false},
+		{"modifiers at the bounds", models.CreateConditionInput{Name: "Cursed", ToHitModifier: -10, ACBonus: 10}, false},
+		{"missing name", models.CreateConditionInput{ToHitModifier: 1}, true},
+		{"modifier too large", models.CreateConditionInput{Name: "Hasted", ACBonus: 11}, true},
+		{"modifier too small", models.CreateConditionInput{Name: "Doomed", SaveModifier: -11}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
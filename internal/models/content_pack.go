@@ -0,0 +1,458 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"mordezzanV4/internal/dice"
+)
+
+// ContentPackNamespaceSeparator joins a pack's namespace to the names of the
+// entries it installs, e.g. "frostlands:Ice Reaver"
+const ContentPackNamespaceSeparator = ":"
+
+// Content pack entry types
+const (
+	ContentPackEntryClass   = "class"
+	ContentPackEntryAbility = "ability"
+	ContentPackEntrySpell   = "spell"
+	ContentPackEntryWeapon  = "weapon"
+	ContentPackEntryArmor   = "armor"
+)
+
+// MaxContentPackClassLevel bounds the level table of a homebrew class
+const MaxContentPackClassLevel = 20
+
+// Bounds on class hit dice, matching what a dice expression can roll
+const (
+	MaxClassHitDice = 1000
+	MaxHitDieSides  = 1000
+)
+
+var (
+	contentPackNamespacePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,31}$`)
+
+	// HitDicePattern matches class hit dice such as "3d8" or "9d8+2"
+	HitDicePattern = regexp.MustCompile(`^(\d+)d(\d+)(?:\+(\d+))?$`)
+)
+
+// ContentPack is a homebrew bundle of classes, spells, weapons and armor.
+// Every entry is installed under the pack's namespace so it can sit beside
+// the core catalog without clashing with it or with other packs.
+type ContentPack struct {
+	Namespace   string              `json:"namespace"`
+	Name        string              `json:"name"`
+	Version     string              `json:"version"`
+	Author      string              `json:"author"`
+	Description string              `json:"description"`
+	Classes     []ContentPackClass  `json:"classes"`
+	Spells      []CreateSpellInput  `json:"spells"`
+	Weapons     []CreateWeaponInput `json:"weapons"`
+	Armors      []CreateArmorInput  `json:"armors"`
+}
+
+// ContentPackClass describes a homebrew class: its rules, level table and
+// abilities. Classes with the backstab hook list their backstab multipliers.
+// Spellcasting classes name their caster type and the core spell list they
+// learn from.
+type ContentPackClass struct {
+	Name                    string                          `json:"name"`
+	DeathSaveBonus          int                             `json:"death_save_bonus"`
+	TransformationSaveBonus int                             `json:"transformation_save_bonus"`
+	DeviceSaveBonus         int                             `json:"device_save_bonus"`
+	SorcerySaveBonus        int                             `json:"sorcery_save_bonus"`
+	AvoidanceSaveBonus      int                             `json:"avoidance_save_bonus"`
+	SurpriseChance          *int                            `json:"surprise_chance,omitempty"`
+	MaxArmor                string                          `json:"max_armor"`
+	ShieldsAllowed          *bool                           `json:"shields_allowed,omitempty"`
+	Hooks                   []string                        `json:"hooks"`
+	PrimeAttributes         []string                        `json:"prime_attributes"`
+	BackstabMultipliers     []ContentPackBackstabMultiplier `json:"backstab_multipliers"`
+	CasterType              string                          `json:"caster_type"`
+	CastingClass            string                          `json:"casting_class"`
+	CastingAttribute        string                          `json:"casting_attribute"`
+	Levels                  []ContentPackClassLevel         `json:"levels"`
+	Abilities               []ContentPackAbility            `json:"abilities"`
+}
+
+// ContentPackBackstabMultiplier is how many times a backstab rolls the
+// weapon's damage dice from MinLevel until the next multiplier
+type ContentPackBackstabMultiplier struct {
+	MinLevel   int `json:"min_level"`
+	Multiplier int `json:"multiplier"`
+}
+
+// ContentPackClassLevel is one row of a homebrew class's level table
+type ContentPackClassLevel struct {
+	Level            int    `json:"level"`
+	ExperiencePoints int    `json:"experience_points"`
+	HitDice          string `json:"hit_dice"`
+	SavingThrow      int    `json:"saving_throw"`
+	FightingAbility  int    `json:"fighting_ability"`
+	CastingAbility   int    `json:"casting_ability"`
+	// SpellSlots lists the slots for spell levels 1 to 6
+	SpellSlots []int `json:"spell_slots"`
+	// NaturalAC feeds the natural_ac hook, ACBonus and EmptyHandDamage the
+	// martial_arts hook, and RunesPerDay (levels 1 to 6) the runes_per_day hook
+	NaturalAC       int    `json:"natural_ac,omitempty"`
+	ACBonus         int    `json:"ac_bonus,omitempty"`
+	EmptyHandDamage string `json:"empty_hand_damage,omitempty"`
+	RunesPerDay     []int  `json:"runes_per_day,omitempty"`
+}
+
+// ContentPackAbility is a class ability gained at MinLevel
+type ContentPackAbility struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	MinLevel    int    `json:"min_level"`
+}
+
+// InstalledContentPack is a content pack as recorded in the database
+type InstalledContentPack struct {
+	ID          int64              `json:"id"`
+	Namespace   string             `json:"namespace"`
+	Name        string             `json:"name"`
+	Version     string             `json:"version"`
+	Author      string             `json:"author"`
+	Description string             `json:"description"`
+	InstalledBy int64              `json:"installed_by,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+	Entries     []ContentPackEntry `json:"entries"`
+}
+
+// ContentPackEntry is a catalog row installed by a content pack. ID is the row
+// id for spells, weapons, armor and abilities; classes are identified by name.
+type ContentPackEntry struct {
+	Type string `json:"type"`
+	ID   int64  `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+// ContentPackValidation reports a pack that passed validation and the
+// entries installing it would create
+type ContentPackValidation struct {
+	Valid     bool               `json:"valid"`
+	Namespace string             `json:"namespace"`
+	Name      string             `json:"name"`
+	Version   string             `json:"version"`
+	Entries   []ContentPackEntry `json:"entries"`
+}
+
+// QualifiedName returns the namespaced name an entry is installed under
+func (p *ContentPack) QualifiedName(name string) string {
+	return p.Namespace + ContentPackNamespaceSeparator + name
+}
+
+// EntryCount returns the number of classes, spells, weapons and armors in the pack
+func (p *ContentPack) EntryCount() int {
+	return len(p.Classes) + len(p.Spells) + len(p.Weapons) + len(p.Armors)
+}
+
+// Entries lists the namespaced entries the pack installs
+func (p *ContentPack) Entries() []ContentPackEntry {
+	var entries []ContentPackEntry
+	for _, class := range p.Classes {
+		entries = append(entries, ContentPackEntry{Type: ContentPackEntryClass, Name: p.QualifiedName(class.Name)})
+		for _, ability := range class.Abilities {
+			entries = append(entries, ContentPackEntry{Type: ContentPackEntryAbility, Name: p.QualifiedName(ability.Name)})
+		}
+	}
+	for _, spell := range p.Spells {
+		entries = append(entries, ContentPackEntry{Type: ContentPackEntrySpell, Name: p.QualifiedName(spell.Name)})
+	}
+	for _, weapon := range p.Weapons {
+		entries = append(entries, ContentPackEntry{Type: ContentPackEntryWeapon, Name: p.QualifiedName(weapon.Name)})
+	}
+	for _, armor := range p.Armors {
+		entries = append(entries, ContentPackEntry{Type: ContentPackEntryArmor, Name: p.QualifiedName(armor.Name)})
+	}
+	return entries
+}
+
+func (p *ContentPack) Validate() error {
+	if !contentPackNamespacePattern.MatchString(p.Namespace) {
+		return NewValidationError("namespace", "Namespace must be 2-32 lowercase letters, digits, '-' or '_'")
+	}
+	if p.Name == "" {
+		return NewValidationError("name", "Name cannot be empty")
+	}
+	if p.EntryCount() == 0 {
+		return NewValidationError("classes", "Content pack must contain at least one class, spell, weapon or armor")
+	}
+
+	classNames := map[string]bool{}
+	abilityNames := map[string]bool{}
+	for i := range p.Classes {
+		class := &p.Classes[i]
+		field := fmt.Sprintf("classes[%d]", i)
+		if err := class.validate(field); err != nil {
+			return err
+		}
+		if classNames[class.Name] {
+			return NewValidationError(field+".name", "Duplicate class name: "+class.Name)
+		}
+		classNames[class.Name] = true
+		for j, ability := range class.Abilities {
+			if abilityNames[ability.Name] {
+				return NewValidationError(fmt.Sprintf("%s.abilities[%d].name", field, j), "Duplicate ability name: "+ability.Name)
+			}
+			abilityNames[ability.Name] = true
+		}
+	}
+
+	for i := range p.Spells {
+		if err := prefixValidationError(fmt.Sprintf("spells[%d]", i), p.Spells[i].Validate()); err != nil {
+			return err
+		}
+		if err := validateEntryName(fmt.Sprintf("spells[%d].name", i), p.Spells[i].Name); err != nil {
+			return err
+		}
+	}
+	for i := range p.Weapons {
+		if err := prefixValidationError(fmt.Sprintf("weapons[%d]", i), p.Weapons[i].Validate()); err != nil {
+			return err
+		}
+		if err := validateEntryName(fmt.Sprintf("weapons[%d].name", i), p.Weapons[i].Name); err != nil {
+			return err
+		}
+	}
+	for i := range p.Armors {
+		if err := prefixValidationError(fmt.Sprintf("armors[%d]", i), p.Armors[i].Validate()); err != nil {
+			return err
+		}
+		if err := validateEntryName(fmt.Sprintf("armors[%d].name", i), p.Armors[i].Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ContentPackClass) validate(field string) error {
+	if err := validateEntryName(field+".name", c.Name); err != nil {
+		return err
+	}
+	switch c.MaxArmor {
+	case "", MaxArmorNone, MaxArmorLight, MaxArmorMedium, MaxArmorHeavy:
+	default:
+		return NewValidationError(field+".max_armor", "Max armor must be 'None', 'Light', 'Medium' or 'Heavy'")
+	}
+	if c.SurpriseChance != nil && (*c.SurpriseChance < 0 || *c.SurpriseChance > 6) {
+		return NewValidationError(field+".surprise_chance", "Surprise chance must be between 0 and 6")
+	}
+	for _, hook := range c.Hooks {
+		if !IsClassHook(hook) {
+			return NewValidationError(field+".hooks", "Unknown class hook: "+hook)
+		}
+	}
+	attributes := map[string]bool{}
+	for _, attribute := range Attributes {
+		attributes[attribute] = true
+	}
+	primes := map[string]bool{}
+	for _, attribute := range c.PrimeAttributes {
+		if !attributes[attribute] {
+			return NewValidationError(field+".prime_attributes", "Unknown attribute: "+attribute)
+		}
+		if primes[attribute] {
+			return NewValidationError(field+".prime_attributes", "Duplicate prime attribute: "+attribute)
+		}
+		primes[attribute] = true
+	}
+	if err := c.validateCasting(field, attributes); err != nil {
+		return err
+	}
+
+	if len(c.Levels) == 0 {
+		return NewValidationError(field+".levels", "Class must have a level table")
+	}
+	if len(c.Levels) > MaxContentPackClassLevel {
+		return NewValidationError(field+".levels", fmt.Sprintf("Level table cannot exceed %d levels", MaxContentPackClassLevel))
+	}
+	for i, level := range c.Levels {
+		levelField := fmt.Sprintf("%s.levels[%d]", field, i)
+		if level.Level != i+1 {
+			return NewValidationError(levelField+".level", "Levels must run consecutively from 1")
+		}
+		if i > 0 && level.ExperiencePoints <= c.Levels[i-1].ExperiencePoints {
+			return NewValidationError(levelField+".experience_points", "Experience points must increase with each level")
+		}
+		if level.ExperiencePoints < 0 {
+			return NewValidationError(levelField+".experience_points", "Experience points cannot be negative")
+		}
+		count, sides, _, err := ParseClassHitDice(level.HitDice)
+		if err != nil {
+			return NewValidationError(levelField+".hit_dice", err.Error())
+		}
+		if i > 0 {
+			prevCount, prevSides, _, _ := ParseClassHitDice(c.Levels[i-1].HitDice)
+			if sides != prevSides {
+				return NewValidationError(levelField+".hit_dice", "Every level must use the same hit die")
+			}
+			if count < prevCount {
+				return NewValidationError(levelField+".hit_dice", "Hit dice cannot decrease from one level to the next")
+			}
+		}
+		if level.SavingThrow < 1 || level.SavingThrow > 20 {
+			return NewValidationError(levelField+".saving_throw", "Saving throw must be between 1 and 20")
+		}
+		if len(level.SpellSlots) > 6 {
+			return NewValidationError(levelField+".spell_slots", "Spell slots cover spell levels 1 to 6 only")
+		}
+		for _, slots := range level.SpellSlots {
+			if slots < 0 {
+				return NewValidationError(levelField+".spell_slots", "Spell slots cannot be negative")
+			}
+		}
+		if err := c.validateHookLevel(levelField, level); err != nil {
+			return err
+		}
+	}
+
+	if err := c.validateBackstabMultipliers(field); err != nil {
+		return err
+	}
+
+	for i, ability := range c.Abilities {
+		abilityField := fmt.Sprintf("%s.abilities[%d]", field, i)
+		if err := validateEntryName(abilityField+".name", ability.Name); err != nil {
+			return err
+		}
+		if ability.Description == "" {
+			return NewValidationError(abilityField+".description", "Description cannot be empty")
+		}
+		if ability.MinLevel < 1 || ability.MinLevel > len(c.Levels) {
+			return NewValidationError(abilityField+".min_level", "Min level must fall within the class's level table")
+		}
+	}
+	return nil
+}
+
+// ParseClassHitDice splits class hit dice such as "9d8+2" into the number of
+// dice, the die size and the fixed hit points added past name level
+func ParseClassHitDice(hitDice string) (count, sides, bonus int, err error) {
+	matches := HitDicePattern.FindStringSubmatch(hitDice)
+	if matches == nil {
+		return 0, 0, 0, fmt.Errorf("Hit dice must look like '3d8' or '9d8+2'")
+	}
+	count, err = strconv.Atoi(matches[1])
+	if err != nil || count < 1 || count > MaxClassHitDice {
+		return 0, 0, 0, fmt.Errorf("Hit dice must have between 1 and %d dice", MaxClassHitDice)
+	}
+	sides, err = strconv.Atoi(matches[2])
+	if err != nil || sides < 1 || sides > MaxHitDieSides {
+		return 0, 0, 0, fmt.Errorf("Hit die must have between 1 and %d sides", MaxHitDieSides)
+	}
+	if matches[3] != "" {
+		if bonus, err = strconv.Atoi(matches[3]); err != nil {
+			return 0, 0, 0, fmt.Errorf("Hit dice bonus is out of range")
+		}
+	}
+	return count, sides, bonus, nil
+}
+
+// validateCasting checks that a spellcasting class names an arcane or divine
+// caster type together with a core spell list, and that a casting attribute
+// is only given to a caster
+func (c *ContentPackClass) validateCasting(field string, attributes map[string]bool) error {
+	switch c.CasterType {
+	case "":
+		if c.CastingClass != "" || c.CastingAttribute != "" {
+			return NewValidationError(field+".caster_type", "Casting class and attribute need a caster type")
+		}
+		return nil
+	case CasterArcane, CasterDivine:
+	default:
+		return NewValidationError(field+".caster_type", "Caster type must be 'arcane' or 'divine'")
+	}
+	if !IsSpellList(c.CastingClass) {
+		return NewValidationError(field+".casting_class", "Casting class must name a core spell list")
+	}
+	if c.CastingAttribute != "" && !attributes[c.CastingAttribute] {
+		return NewValidationError(field+".casting_attribute", "Unknown attribute: "+c.CastingAttribute)
+	}
+	return nil
+}
+
+// validateHookLevel checks a level's natural AC, martial arts and runes,
+// which are only given to classes with the matching hook
+func (c *ContentPackClass) validateHookLevel(field string, level ContentPackClassLevel) error {
+	rules := ClassRules{Hooks: c.Hooks}
+	if level.NaturalAC != 0 && !rules.HasHook(ClassHookNaturalAC) {
+		return NewValidationError(field+".natural_ac", "Natural AC needs the natural_ac hook")
+	}
+	if rules.HasHook(ClassHookMartialArts) {
+		if _, err := dice.Parse(level.EmptyHandDamage); err != nil {
+			return NewValidationError(field+".empty_hand_damage", "The martial_arts hook needs empty hand damage such as '1d4'")
+		}
+	} else if level.ACBonus != 0 || level.EmptyHandDamage != "" {
+		return NewValidationError(field+".empty_hand_damage", "AC bonus and empty hand damage need the martial_arts hook")
+	}
+	if len(level.RunesPerDay) > 0 && !rules.HasHook(ClassHookRunesPerDay) {
+		return NewValidationError(field+".runes_per_day", "Runes per day need the runes_per_day hook")
+	}
+	if len(level.RunesPerDay) > 6 {
+		return NewValidationError(field+".runes_per_day", "Runes per day cover rune levels 1 to 6 only")
+	}
+	for _, runes := range level.RunesPerDay {
+		if runes < 0 {
+			return NewValidationError(field+".runes_per_day", "Runes per day cannot be negative")
+		}
+	}
+	return nil
+}
+
+// validateBackstabMultipliers checks that a class with the backstab hook has
+// multipliers starting at level 1 and rising within its level table
+func (c *ContentPackClass) validateBackstabMultipliers(field string) error {
+	field += ".backstab_multipliers"
+	rules := ClassRules{Hooks: c.Hooks}
+	if !rules.HasHook(ClassHookBackstab) {
+		if len(c.BackstabMultipliers) > 0 {
+			return NewValidationError(field, "Backstab multipliers need the backstab hook")
+		}
+		return nil
+	}
+	if len(c.BackstabMultipliers) == 0 {
+		return NewValidationError(field, "The backstab hook needs backstab multipliers")
+	}
+	for i, backstab := range c.BackstabMultipliers {
+		backstabField := fmt.Sprintf("%s[%d]", field, i)
+		if i == 0 && backstab.MinLevel != 1 {
+			return NewValidationError(backstabField+".min_level", "Backstab multipliers must start at level 1")
+		}
+		if i > 0 && backstab.MinLevel <= c.BackstabMultipliers[i-1].MinLevel {
+			return NewValidationError(backstabField+".min_level", "Min levels must increase with each multiplier")
+		}
+		if backstab.MinLevel > len(c.Levels) {
+			return NewValidationError(backstabField+".min_level", "Min level must fall within the class's level table")
+		}
+		if backstab.Multiplier < 1 {
+			return NewValidationError(backstabField+".multiplier", "Multiplier must be at least 1")
+		}
+	}
+	return nil
+}
+
+// validateEntryName rejects empty names and names that are already namespaced
+func validateEntryName(field, name string) error {
+	if strings.TrimSpace(name) == "" {
+		return NewValidationError(field, "Name cannot be empty")
+	}
+	if strings.Contains(name, ContentPackNamespaceSeparator) {
+		return NewValidationError(field, "Name cannot contain '"+ContentPackNamespaceSeparator+"'")
+	}
+	return nil
+}
+
+// prefixValidationError qualifies the field of a nested validation error
+func prefixValidationError(prefix string, err error) error {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return NewValidationError(prefix+"."+validationErr.Field, validationErr.Message)
+	}
+	return err
+}
@@ -0,0 +1,90 @@
+package models_test
+
+import (
+	"testing"
+
+	"mordezzanV4/internal/models"
+)
+
+func TestParseClassHitDice(t *testing.T) {
+	tests := []struct {
+		hitDice string
+		count   int
+		sides   int
+		bonus   int
+		wantErr bool
+	}{
+		{"1d8", 1, 8, 0, false},
+		{"9d10+2", 9, 10, 2, false},
+		{"1d1", 1, 1, 0, false},
+		{"1d1000", 1, 1000, 0, false},
+		{"0d8", 0, 0, 0, true},
+		{"1d0", 0, 0, 0, true},
+		{"2d0", 0, 0, 0, true},
+		{"1d2000", 0, 0, 0, true},
+		{"1001d6", 0, 0, 0, true},
+		{"d8", 0, 0, 0, true},
+		{"1d8-1", 0, 0, 0, true},
+		{"1d8+99999999999999999999", 0, 0, 0, true},
+		{"", 0, 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.hitDice, func(t *testing.T) {
+			count, sides, bonus, err := models.ParseClassHitDice(tt.hitDice)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseClassHitDice(%q) error = %v, wantErr %v", tt.hitDice, err, tt.wantErr)
+			}
+			if count != tt.count || sides != tt.sides || bonus != tt.bonus {
+				t.Errorf("ParseClassHitDice(%q) = %d, %d, %d, want %d, %d, %d",
+					tt.hitDice, count, sides, bonus, tt.count, tt.sides, tt.bonus)
+			}
+		})
+	}
+}
+
+func TestContentPackHitDiceTable(t *testing.T) {
+	tests := []struct {
+		name     string
+		hitDice  []string
+		wantErr  bool
+		errField string
+	}{
+		{"rising dice", []string{"1d8", "2d8", "3d8"}, false, ""},
+		{"fixed bonus past name level", []string{"1d8", "2d8", "2d8+2"}, false, ""},
+		{"zero sides", []string{"1d0", "2d0"}, true, "classes[0].levels[0].hit_dice"},
+		{"too many sides", []string{"1d2000"}, true, "classes[0].levels[0].hit_dice"},
+		{"decreasing dice", []string{"1d8", "3d8", "2d8"}, true, "classes[0].levels[2].hit_dice"},
+		{"changing die size", []string{"1d8", "2d10"}, true, "classes[0].levels[1].hit_dice"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			class := models.ContentPackClass{Name: "Reaver"}
+			for i, hitDice := range tt.hitDice {
+				class.Levels = append(class.Levels, models.ContentPackClassLevel{
+					Level:            i + 1,
+					ExperiencePoints: i * 2000,
+					HitDice:          hitDice,
+					SavingThrow:      16,
+				})
+			}
+			pack := &models.ContentPack{Namespace: "frostlands", Name: "Frostlands", Classes: []models.ContentPackClass{class}}
+
+			err := pack.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				return
+			}
+			validationErr, ok := err.(*models.ValidationError)
+			if !ok {
+				t.Fatalf("Validate() error = %T, want *models.ValidationError", err)
+			}
+			if validationErr.Field != tt.errField {
+				t.Errorf("Validate() field = %q, want %q", validationErr.Field, tt.errField)
+			}
+		})
+	}
+}
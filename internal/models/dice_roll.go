@@ -0,0 +1,79 @@
+package models
+
+import (
+	"fmt"
+
+	"mordezzanV4/internal/dice"
+)
+
+// MaxSituationalModifier limits each situational modifier the referee gives
+// to a roll
+const MaxSituationalModifier = 10
+
+// RollModifier is one adjustment to a roll and where it comes from
+type RollModifier struct {
+	Source string `json:"source"`
+	Value  int    `json:"value"`
+}
+
+// RollDiceInput is used for rolling an arbitrary dice expression
+type RollDiceInput struct {
+	Expression string `json:"expression"`
+	Seed       *int64 `json:"seed,omitempty"`
+}
+
+// CharacterRollInput is used for rolling on behalf of a character, either a
+// free-form expression or the damage of a weapon in the character's inventory
+type CharacterRollInput struct {
+	Expression      string `json:"expression,omitempty"`
+	InventoryItemID int64  `json:"inventory_item_id,omitempty"`
+	TwoHanded       bool   `json:"two_handed,omitempty"`
+	Seed            *int64 `json:"seed,omitempty"`
+}
+
+// Validate ensures that the dice roll input is valid
+func (i *RollDiceInput) Validate() error {
+	if i.Expression == "" {
+		return NewValidationError("expression", "Expression cannot be empty")
+	}
+	if _, err := dice.Parse(i.Expression); err != nil {
+		return NewValidationError("expression", err.Error())
+	}
+	return nil
+}
+
+// Validate ensures that the character roll input is valid
+func (i *CharacterRollInput) Validate() error {
+	if i.Expression == "" && i.InventoryItemID == 0 {
+		return NewValidationError("expression", "Either an expression or an inventory item ID is required")
+	}
+	if i.Expression != "" && i.InventoryItemID != 0 {
+		return NewValidationError("expression", "Provide either an expression or an inventory item ID, not both")
+	}
+	if i.InventoryItemID < 0 {
+		return NewValidationError("inventory_item_id", "Inventory item ID must be positive")
+	}
+	if i.Expression != "" {
+		if _, err := dice.Parse(i.Expression); err != nil {
+			return NewValidationError("expression", err.Error())
+		}
+	}
+	return nil
+}
+
+// validateSituationalModifiers checks the situational modifiers given for a roll
+func validateSituationalModifiers(modifiers []RollModifier) error {
+	for _, modifier := range modifiers {
+		if modifier.Source == "" {
+			return NewValidationError("modifiers", "Each modifier needs a source")
+		}
+		if len(modifier.Source) > 100 {
+			return NewValidationError("modifiers", "Modifier source cannot exceed 100 characters")
+		}
+		if modifier.Value < -MaxSituationalModifier || modifier.Value > MaxSituationalModifier {
+			return NewValidationError("modifiers", fmt.Sprintf("Modifiers must be between -%d and %d",
+				MaxSituationalModifier, MaxSituationalModifier))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package models
+
+import "time"
+
+// Sides of an encounter
+const (
+	EncounterSideParty     = "party"
+	EncounterSideOpponents = "opponents"
+)
+
+// Ways initiative is rolled: one d6 per side, or one per participant with
+// characters adding their dexterity adjustment
+const (
+	InitiativeBySide       = "side"
+	InitiativeByIndividual = "individual"
+)
+
+// InitiativeDie is the die rolled for initiative and surprise
+const InitiativeDie = 6
+
+// DefaultMonsterSurpriseChance is the chance in six that monsters are
+// surprised when the GM does not give one
+const DefaultMonsterSurpriseChance = 2
+
+// MaxMonstersPerAdd bounds how many copies of a monster are added at once
+const MaxMonstersPerAdd = 50
+
+// Encounter is a combat run by a user. Round is zero until initiative is
+// rolled; Turn indexes the participant acting in initiative order.
+type Encounter struct {
+	ID             int64                   `json:"id"`
+	UserID         int64                   `json:"user_id"`
+	Name           string                  `json:"name"`
+	InitiativeMode string                  `json:"initiative_mode"`
+	Round          int                     `json:"round"`
+	Turn           int                     `json:"turn"`
+	Participants   []*EncounterParticipant `json:"participants"`
+	Current        *EncounterParticipant   `json:"current,omitempty"`
+	CreatedAt      time.Time               `json:"created_at"`
+	UpdatedAt      time.Time               `json:"updated_at"`
+}
+
+// EncounterParticipant is a character or monster in an encounter. Hit points
+// of characters are read from the character; monsters track their own.
+type EncounterParticipant struct {
+	ID          int64     `json:"id"`
+	EncounterID int64     `json:"encounter_id"`
+	Side        string    `json:"side"`
+	CharacterID *int64    `json:"character_id,omitempty"`
+	MonsterID   *int64    `json:"monster_id,omitempty"`
+	Name        string    `json:"name"`
+	ArmorClass  *int      `json:"armor_class,omitempty"`
+	MaxHP       int       `json:"max_hp"`
+	CurrentHP   int       `json:"current_hp"`
+	Initiative  *int      `json:"initiative,omitempty"`
+	Surprised   bool      `json:"surprised"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// IsCharacter reports whether the participant is a player character
+func (p *EncounterParticipant) IsCharacter() bool {
+	return p.CharacterID != nil
+}
+
+// CanAct reports whether the participant takes a turn in the given round:
+// the fallen do not act, and the surprised lose the first round
+func (p *EncounterParticipant) CanAct(round int) bool {
+	if p.CurrentHP <= 0 {
+		return false
+	}
+	return !(p.Surprised && round == 1)
+}
+
+type CreateEncounterInput struct {
+	Name string `json:"name"`
+}
+
+// AddParticipantInput adds a character, copies of a bestiary monster, or an
+// ad-hoc monster described by name, armour class and hit points
+type AddParticipantInput struct {
+	Side        string `json:"side"`
+	CharacterID int64  `json:"character_id,omitempty"`
+	MonsterID   int64  `json:"monster_id,omitempty"`
+	// Count is how many copies of the monster to add, one if unset
+	Count      int    `json:"count,omitempty"`
+	Name       string `json:"name,omitempty"`
+	ArmorClass *int   `json:"armor_class,omitempty"`
+	HitPoints  int    `json:"hit_points,omitempty"`
+}
+
+// RollInitiativeInput sets how initiative is rolled. OpponentSurpriseChance is
+// the chance in six that monsters are surprised, DefaultMonsterSurpriseChance
+// if unset; characters use their own surprise chance.
+type RollInitiativeInput struct {
+	Mode                   string `json:"mode"`
+	CheckSurprise          bool   `json:"check_surprise"`
+	OpponentSurpriseChance int    `json:"opponent_surprise_chance,omitempty"`
+}
+
+// ParticipantHPInput damages (negative delta) or heals a participant. Temp
+// adds temporary hit points to a character.
+type ParticipantHPInput struct {
+	Delta int  `json:"delta"`
+	Temp  bool `json:"temp"`
+}
+
+// InitiativeResult is the encounter after initiative and the side rolls, when
+// initiative was rolled by side
+type InitiativeResult struct {
+	Encounter      *Encounter     `json:"encounter"`
+	SideInitiative map[string]int `json:"side_initiative,omitempty"`
+}
+
+// NextTurnResult is the encounter after advancing and, when a new round
+// began, the effects and conditions that ran out on each character and the
+// hit points the dying lost
+type NextTurnResult struct {
+	Encounter *Encounter                   `json:"encounter"`
+	NewRound  bool                         `json:"new_round"`
+	Expired   map[int64]*AdvanceTimeResult `json:"expired,omitempty"`
+}
+
+func (i *CreateEncounterInput) Validate() error {
+	if i.Name == "" {
+		return NewValidationError("name", "Name cannot be empty")
+	}
+	if len(i.Name) > 100 {
+		return NewValidationError("name", "Name cannot exceed 100 characters")
+	}
+	return nil
+}
+
+// IsValidEncounterSide reports whether side is party or opponents
+func IsValidEncounterSide(side string) bool {
+	return side == EncounterSideParty || side == EncounterSideOpponents
+}
+
+func (i *AddParticipantInput) Validate() error {
+	if !IsValidEncounterSide(i.Side) {
+		return NewValidationError("side", "Side must be party or opponents")
+	}
+	if i.CharacterID != 0 && i.MonsterID != 0 {
+		return NewValidationError("character_id", "Add either a character or a monster, not both")
+	}
+	if i.Count < 0 || i.Count > MaxMonstersPerAdd {
+		return NewValidationError("count", "Count must be between 1 and 50")
+	}
+	if i.CharacterID != 0 {
+		if i.Count > 1 {
+			return NewValidationError("count", "A character can only be added once")
+		}
+		return nil
+	}
+	if i.MonsterID == 0 {
+		if i.Name == "" {
+			return NewValidationError("name", "Name is required for a monster not in the bestiary")
+		}
+		if i.HitPoints <= 0 {
+			return NewValidationError("hit_points", "Hit points must be positive")
+		}
+	}
+	if len(i.Name) > 100 {
+		return NewValidationError("name", "Name cannot exceed 100 characters")
+	}
+	if i.HitPoints < 0 {
+		return NewValidationError("hit_points", "Hit points cannot be negative")
+	}
+	if i.ArmorClass != nil && (*i.ArmorClass < -10 || *i.ArmorClass > 10) {
+		return NewValidationError("armor_class", "Armor class must be between -10 and 10")
+	}
+	return nil
+}
+
+func (i *RollInitiativeInput) Validate() error {
+	if i.Mode != "" && i.Mode != InitiativeBySide && i.Mode != InitiativeByIndividual {
+		return NewValidationError("mode", "Mode must be side or individual")
+	}
+	if i.OpponentSurpriseChance < 0 || i.OpponentSurpriseChance > InitiativeDie {
+		return NewValidationError("opponent_surprise_chance", "Surprise chance must be between 0 and 6")
+	}
+	return nil
+}
+
+func (i *ParticipantHPInput) Validate() error {
+	if i.Delta == 0 {
+		return NewValidationError("delta", "Delta cannot be zero")
+	}
+	if i.Temp && i.Delta < 0 {
+		return NewValidationError("temp", "Temporary hit points must be positive")
+	}
+	return nil
+}
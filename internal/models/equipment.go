@@ -1,6 +1,7 @@
 package models
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -10,6 +11,7 @@ type Equipment struct {
 	Description string    `json:"description"`
 	Cost        float64   `json:"cost"`
 	Weight      int       `json:"weight"`
+	SaveBonus   int       `json:"save_bonus"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
@@ -19,6 +21,7 @@ type CreateEquipmentInput struct {
 	Description string  `json:"description"`
 	Cost        float64 `json:"cost"`
 	Weight      int     `json:"weight"`
+	SaveBonus   int     `json:"save_bonus"`
 }
 
 type UpdateEquipmentInput struct {
@@ -26,6 +29,7 @@ type UpdateEquipmentInput struct {
 	Description string  `json:"description"`
 	Cost        float64 `json:"cost"`
 	Weight      int     `json:"weight"`
+	SaveBonus   int     `json:"save_bonus"`
 }
 
 func (i *CreateEquipmentInput) Validate() error {
@@ -41,6 +45,9 @@ func (i *CreateEquipmentInput) Validate() error {
 	if i.Weight <= 0 {
 		return NewValidationError("weight", "Weight must be positive")
 	}
+	if i.SaveBonus < 0 || i.SaveBonus > MaxItemSaveBonus {
+		return NewValidationError("save_bonus", fmt.Sprintf("Save bonus must be between 0 and %d", MaxItemSaveBonus))
+	}
 	return nil
 }
 
@@ -57,5 +64,8 @@ func (i *UpdateEquipmentInput) Validate() error {
 	if i.Weight <= 0 {
 		return NewValidationError("weight", "Weight must be positive")
 	}
+	if i.SaveBonus < 0 || i.SaveBonus > MaxItemSaveBonus {
+		return NewValidationError("save_bonus", fmt.Sprintf("Save bonus must be between 0 and %d", MaxItemSaveBonus))
+	}
 	return nil
 }
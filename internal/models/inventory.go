@@ -6,16 +6,18 @@ import (
 
 // InventoryItem represents a generic item in an inventory
 type InventoryItem struct {
-	ID          int64     `json:"id"`
-	InventoryID int64     `json:"inventory_id"`
-	ItemType    string    `json:"item_type"`
-	ItemID      int64     `json:"item_id"`
-	Quantity    int       `json:"quantity"`
-	IsEquipped  bool      `json:"is_equipped"`
-	Slot        string    `json:"slot,omitempty"`
-	Notes       string    `json:"notes,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID            int64     `json:"id"`
+	InventoryID   int64     `json:"inventory_id"`
+	ItemType      string    `json:"item_type"`
+	ItemID        int64     `json:"item_id"`
+	Quantity      int       `json:"quantity"`
+	IsEquipped    bool      `json:"is_equipped"`
+	Slot          string    `json:"slot,omitempty"`
+	Notes         string    `json:"notes,omitempty"`
+	Charges       *int      `json:"charges,omitempty"`        // Charges left in a wand, rod or staff
+	UsesRemaining *int      `json:"uses_remaining,omitempty"` // Uses left in the open potion of a stack
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
 }
 
 // Inventory represents a character's inventory
@@ -50,6 +52,13 @@ type AddItemInput struct {
 	IsEquipped bool   `json:"is_equipped"`
 	Slot       string `json:"slot,omitempty"`
 	Notes      string `json:"notes,omitempty"`
+	// A magic item starts with Charges if given, otherwise with the result of
+	// the ChargesRoll dice expression, otherwise with the catalog's charges
+	Charges     *int   `json:"charges,omitempty"`
+	ChargesRoll string `json:"charges_roll,omitempty"`
+	// A potion stack starts unopened unless UsesRemaining gives the uses
+	// left in its top potion
+	UsesRemaining *int `json:"uses_remaining,omitempty"`
 }
 
 // UpdateItemInput represents input data for updating an inventory item
@@ -90,6 +99,25 @@ func (i *AddItemInput) Validate() error {
 	if i.Quantity <= 0 {
 		return NewValidationError("quantity", "Quantity must be positive")
 	}
+	if i.Charges != nil || i.ChargesRoll != "" {
+		if i.ItemType != MagicItemItemType {
+			return NewValidationError("charges", "Only magic items have charges")
+		}
+		if i.Charges != nil && i.ChargesRoll != "" {
+			return NewValidationError("charges_roll", "Give either charges or a charges roll, not both")
+		}
+		if i.Charges != nil && *i.Charges < 0 {
+			return NewValidationError("charges", "Charges cannot be negative")
+		}
+	}
+	if i.UsesRemaining != nil {
+		if i.ItemType != PotionItemType {
+			return NewValidationError("uses_remaining", "Only potions have uses")
+		}
+		if *i.UsesRemaining < 1 {
+			return NewValidationError("uses_remaining", "Uses remaining must be at least 1")
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,101 @@
+package models
+
+import "time"
+
+// Ways a character can read a spell scroll
+const (
+	// ScrollReadBySpellcasting is a caster reading a scroll of their own tradition
+	ScrollReadBySpellcasting = "spellcasting"
+	// ScrollReadByThiefSkill is a thief deciphering an arcane scroll
+	ScrollReadByThiefSkill = "read_scrolls"
+)
+
+// ScrollFailurePerSpellLevel is the percentage chance a caster miscasts a
+// scroll for each level the spell is above the highest they can cast
+const ScrollFailurePerSpellLevel = 10
+
+// ScrollCastingChance returns the percentage chance that a caster able to
+// cast spells up to maxSpellLevel reads a scroll of a spellLevel spell
+// without miscasting. Spells within their reach always succeed.
+func ScrollCastingChance(spellLevel, maxSpellLevel int) int {
+	over := spellLevel - maxSpellLevel
+	if over <= 0 {
+		return 100
+	}
+	return max(100-over*ScrollFailurePerSpellLevel, 0)
+}
+
+// Ways a character can work a wand, rod or staff
+const (
+	// ItemUseByCharges is a character expending charges from the item
+	ItemUseByCharges = "charges"
+	// ItemUseByRecharge is a caster restoring charges to the item
+	ItemUseByRecharge = "recharge"
+)
+
+// ItemUseByDrinking is a character drinking a potion
+const ItemUseByDrinking = "drink"
+
+// MinRechargeLevel is the level a spellcaster must reach to recharge magic items
+const MinRechargeLevel = 9
+
+// ItemUse records a character using an item from their inventory
+type ItemUse struct {
+	ID              int64  `json:"id"`
+	CharacterID     int64  `json:"character_id"`
+	InventoryItemID int64  `json:"inventory_item_id"`
+	ItemType        string `json:"item_type"`
+	ItemID          int64  `json:"item_id"`
+	ItemName        string `json:"item_name"`
+	Method          string `json:"method,omitempty"`
+	// Die is the number of sides rolled against Chance, zero if no roll was needed
+	Die     int       `json:"die,omitempty"`
+	Roll    int       `json:"roll,omitempty"`
+	Chance  int       `json:"chance,omitempty"`
+	Success bool      `json:"success"`
+	Mishap  bool      `json:"mishap"`
+	Effect  string    `json:"effect"`
+	Target  string    `json:"target,omitempty"`
+	UsedAt  time.Time `json:"used_at"`
+}
+
+// UseItemInput describes how an inventory item is used
+type UseItemInput struct {
+	Target string `json:"target,omitempty"`
+	// Charges is how many charges a wand, rod or staff expends, one if unset
+	Charges int `json:"charges,omitempty"`
+}
+
+// RechargeItemInput describes how many charges a caster restores to an item
+type RechargeItemInput struct {
+	Charges int `json:"charges"`
+}
+
+// ItemUseResult is the outcome of using an item and what is left of it
+type ItemUseResult struct {
+	Use               *ItemUse      `json:"use"`
+	RemainingQuantity int           `json:"remaining_quantity"`
+	RemainingCharges  *int          `json:"remaining_charges,omitempty"`
+	Depleted          bool          `json:"depleted,omitempty"`
+	RemainingUses     *int          `json:"remaining_uses,omitempty"`
+	ActiveEffect      *ActiveEffect `json:"active_effect,omitempty"`
+}
+
+// Validate checks if the input is valid
+func (i *UseItemInput) Validate() error {
+	if len(i.Target) > 200 {
+		return NewValidationError("target", "Target cannot exceed 200 characters")
+	}
+	if i.Charges < 0 {
+		return NewValidationError("charges", "Charges cannot be negative")
+	}
+	return nil
+}
+
+// Validate checks if the input is valid
+func (i *RechargeItemInput) Validate() error {
+	if i.Charges <= 0 {
+		return NewValidationError("charges", "Charges must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package models_test
+
+import (
+	"fmt"
+	"testing"
+
+	"mordezzanV4/internal/models"
+)
+
+func TestScrollCastingChance(t *testing.T) {
+	tests := []struct {
+		spellLevel, maxSpellLevel, want int
+	}{
+		{1, 1, 100},
+		{1, 3, 100},
+		{2, 1, 90},
+		{4, 1, 70},
+		{6, 0, 40},
+		{9, 0, 10},
+		{11, 0, 0},
+		{12, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("level %d spell, casts up to %d", tt.spellLevel, tt.maxSpellLevel), func(t *testing.T) {
+			if got := models.ScrollCastingChance(tt.spellLevel, tt.maxSpellLevel); got != tt.want {
+				t.Errorf("ScrollCastingChance() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"time"
+)
+
+// Kindred is a people of Hyperborea. Its modifiers adjust a character's
+// attributes when derived stats are calculated; the stored scores are unchanged.
+type Kindred struct {
+	ID                   int64     `json:"id"`
+	Name                 string    `json:"name"`
+	Description          string    `json:"description"`
+	StrengthModifier     int       `json:"strength_modifier"`
+	DexterityModifier    int       `json:"dexterity_modifier"`
+	ConstitutionModifier int       `json:"constitution_modifier"`
+	IntelligenceModifier int       `json:"intelligence_modifier"`
+	WisdomModifier       int       `json:"wisdom_modifier"`
+	CharismaModifier     int       `json:"charisma_modifier"`
+	Languages            []string  `json:"languages"`
+	AllowedClasses       []string  `json:"allowed_classes"`
+	SpecialAbilities     []string  `json:"special_abilities"`
+	CreatedAt            time.Time `json:"created_at"`
+	UpdatedAt            time.Time `json:"updated_at"`
+}
+
+type CreateKindredInput struct {
+	Name                 string   `json:"name"`
+	Description          string   `json:"description"`
+	StrengthModifier     int      `json:"strength_modifier"`
+	DexterityModifier    int      `json:"dexterity_modifier"`
+	ConstitutionModifier int      `json:"constitution_modifier"`
+	IntelligenceModifier int      `json:"intelligence_modifier"`
+	WisdomModifier       int      `json:"wisdom_modifier"`
+	CharismaModifier     int      `json:"charisma_modifier"`
+	Languages            []string `json:"languages"`
+	// AllowedClasses limits the classes open to the kindred; empty allows all
+	AllowedClasses   []string `json:"allowed_classes"`
+	SpecialAbilities []string `json:"special_abilities"`
+}
+
+type UpdateKindredInput = CreateKindredInput
+
+// MaxKindredModifier bounds each attribute modifier of a kindred
+const MaxKindredModifier = 3
+
+func (i *CreateKindredInput) Validate() error {
+	if i.Name == "" {
+		return NewValidationError("name", "Name cannot be empty")
+	}
+	modifiers := map[string]int{
+		"strength_modifier":     i.StrengthModifier,
+		"dexterity_modifier":    i.DexterityModifier,
+		"constitution_modifier": i.ConstitutionModifier,
+		"intelligence_modifier": i.IntelligenceModifier,
+		"wisdom_modifier":       i.WisdomModifier,
+		"charisma_modifier":     i.CharismaModifier,
+	}
+	for field, modifier := range modifiers {
+		if modifier < -MaxKindredModifier || modifier > MaxKindredModifier {
+			return NewValidationError(field, "Modifier must be between -3 and 3")
+		}
+	}
+	return nil
+}
+
+// AllowsClass reports whether a character of this kindred may take the class
+func (k *Kindred) AllowsClass(class string) bool {
+	if len(k.AllowedClasses) == 0 {
+		return true
+	}
+	for _, allowed := range k.AllowedClasses {
+		if allowed == class {
+			return true
+		}
+	}
+	return false
+}
+
+// Modifier returns the kindred's modifier for an attribute by its lowercase name
+func (k *Kindred) Modifier(attribute string) int {
+	switch attribute {
+	case "strength":
+		return k.StrengthModifier
+	case "dexterity":
+		return k.DexterityModifier
+	case "constitution":
+		return k.ConstitutionModifier
+	case "intelligence":
+		return k.IntelligenceModifier
+	case "wisdom":
+		return k.WisdomModifier
+	case "charisma":
+		return k.CharismaModifier
+	}
+	return 0
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"mordezzanV4/internal/dice"
+)
+
+// LevelUp records a single level gained by a character and everything that changed
+type LevelUp struct {
+	ID           int64           `json:"id"`
+	CharacterID  int64           `json:"character_id"`
+	FromLevel    int             `json:"from_level"`
+	ToLevel      int             `json:"to_level"`
+	HitDice      string          `json:"hit_dice"`
+	HPRoll       *dice.Result    `json:"hp_roll,omitempty"`
+	Seed         int64           `json:"seed,omitempty"`
+	HPModifier   int             `json:"hp_modifier"`
+	HPGained     int             `json:"hp_gained"`
+	FixedHP      bool            `json:"fixed_hp"`
+	Changes      []StatChange    `json:"changes"`
+	NewAbilities []*ClassAbility `json:"new_abilities"`
+	CreatedAt    time.Time       `json:"created_at"`
+
+	// Offered choices, not stored with the record
+	LearnableSpells []*Spell `json:"learnable_spells,omitempty"`
+	CanLevelAgain   bool     `json:"can_level_again"`
+}
+
+// StatChange describes a single character value before and after a change
+type StatChange struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// LevelUpStatus describes whether a character has enough experience to level up
+type LevelUpStatus struct {
+	CharacterID      int64 `json:"character_id"`
+	CurrentLevel     int   `json:"current_level"`
+	ExperiencePoints int   `json:"experience_points"`
+	NextLevel        int   `json:"next_level,omitempty"`
+	NextLevelXP      int   `json:"next_level_experience,omitempty"`
+	Eligible         bool  `json:"eligible"`
+	MaxLevel         bool  `json:"max_level"`
+}
@@ -4,6 +4,9 @@ import (
 	"time"
 )
 
+// MagicItemItemType is the inventory item type of a carried rod, wand or staff
+const MagicItemItemType = "magic_item"
+
 type MagicItem struct {
 	ID          int64     `json:"id"`
 	Name        string    `json:"name"`
@@ -0,0 +1,149 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Monster is a bestiary entry using the AS&SH stat block. HitDice keeps the
+// written form, such as "3+1" or "½"; HitDiceLevel is its whole number of
+// dice and is used to search by HD.
+type Monster struct {
+	ID               int64     `json:"id"`
+	Name             string    `json:"name"`
+	Description      string    `json:"description"`
+	HitDice          string    `json:"hit_dice"`
+	HitDiceLevel     int       `json:"hit_dice_level"`
+	ArmorClass       int       `json:"armor_class"`
+	Movement         string    `json:"movement"`
+	Attacks          string    `json:"attacks"`
+	Damage           string    `json:"damage"`
+	SavingThrow      int       `json:"saving_throw"`
+	Morale           int       `json:"morale"`
+	XPValue          int       `json:"xp_value"`
+	TreasureClass    string    `json:"treasure_class"`
+	SpecialAbilities string    `json:"special_abilities"`
+	Terrain          string    `json:"terrain"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+type CreateMonsterInput struct {
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	HitDice          string `json:"hit_dice"`
+	ArmorClass       int    `json:"armor_class"`
+	Movement         string `json:"movement"`
+	Attacks          string `json:"attacks"`
+	Damage           string `json:"damage"`
+	SavingThrow      int    `json:"saving_throw"`
+	Morale           int    `json:"morale"`
+	XPValue          int    `json:"xp_value"`
+	TreasureClass    string `json:"treasure_class"`
+	SpecialAbilities string `json:"special_abilities"`
+	Terrain          string `json:"terrain"`
+}
+
+type UpdateMonsterInput = CreateMonsterInput
+
+// MonsterFilter narrows a bestiary search. Zero values match everything.
+type MonsterFilter struct {
+	Name    string
+	Terrain string
+	MinHD   int
+	MaxHD   int
+}
+
+// MaxMonsterHitDice bounds the hit dice a monster can have
+const MaxMonsterHitDice = 100
+
+func (i *CreateMonsterInput) Validate() error {
+	if i.Name == "" {
+		return NewValidationError("name", "Name cannot be empty")
+	}
+	if _, err := HitDiceLevel(i.HitDice); err != nil {
+		return NewValidationError("hit_dice", err.Error())
+	}
+	if i.ArmorClass < -10 || i.ArmorClass > 10 {
+		return NewValidationError("armor_class", "Armor class must be between -10 and 10")
+	}
+	if i.SavingThrow < 1 || i.SavingThrow > 20 {
+		return NewValidationError("saving_throw", "Saving throw must be between 1 and 20")
+	}
+	if i.Morale < 2 || i.Morale > 12 {
+		return NewValidationError("morale", "Morale must be between 2 and 12")
+	}
+	if i.XPValue < 0 {
+		return NewValidationError("xp_value", "XP value cannot be negative")
+	}
+	return nil
+}
+
+// Validate checks if the filter is valid
+func (f *MonsterFilter) Validate() error {
+	if f.MinHD < 0 {
+		return NewValidationError("min_hd", "Minimum HD cannot be negative")
+	}
+	if f.MaxHD < 0 {
+		return NewValidationError("max_hd", "Maximum HD cannot be negative")
+	}
+	if f.MaxHD != 0 && f.MaxHD < f.MinHD {
+		return NewValidationError("max_hd", "Maximum HD cannot be less than minimum HD")
+	}
+	return nil
+}
+
+// HitDiceLevel returns the whole number of dice in a written hit dice value:
+// 3 for "3+1" or "3-1" and 0 for fractional dice such as "½" or "1/2"
+func HitDiceLevel(hitDice string) (int, error) {
+	dice, _, err := parseHitDice(hitDice)
+	return dice, err
+}
+
+// HitPointsExpression returns the dice rolled for one monster's hit points.
+// Each hit die is a d8 with any written bonus added; fractional dice roll a d4.
+func (m *Monster) HitPointsExpression() string {
+	dice, bonus, err := parseHitDice(m.HitDice)
+	if err != nil || dice == 0 {
+		return "1d4"
+	}
+	switch {
+	case bonus > 0:
+		return fmt.Sprintf("%dd8+%d", dice, bonus)
+	case bonus < 0:
+		return fmt.Sprintf("%dd8%d", dice, bonus)
+	}
+	return fmt.Sprintf("%dd8", dice)
+}
+
+// parseHitDice splits hit dice such as "4+2" into the number of dice and the
+// hit point adjustment. Fractional dice are reported as zero dice.
+func parseHitDice(hitDice string) (int, int, error) {
+	hitDice = strings.TrimSpace(hitDice)
+	switch hitDice {
+	case "":
+		return 0, 0, fmt.Errorf("Hit dice cannot be empty")
+	case "½", "1/2", "1/4", "1/3":
+		return 0, 0, nil
+	}
+
+	base, adjust := hitDice, ""
+	if idx := strings.IndexAny(hitDice, "+-"); idx > 0 {
+		base, adjust = hitDice[:idx], hitDice[idx:]
+	}
+
+	dice, err := strconv.Atoi(base)
+	if err != nil || dice < 1 || dice > MaxMonsterHitDice {
+		return 0, 0, fmt.Errorf("Hit dice must be a number of dice such as 3 or 3+1")
+	}
+	bonus := 0
+	if adjust != "" {
+		bonus, err = strconv.Atoi(adjust)
+		if err != nil {
+			return 0, 0, fmt.Errorf("Hit dice must be a number of dice such as 3 or 3+1")
+		}
+	}
+	return dice, bonus, nil
+}
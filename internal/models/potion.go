@@ -2,30 +2,46 @@ package models
 
 import (
 	"time"
+
+	"mordezzanV4/internal/dice"
 )
 
-type Potion struct {
-	ID          int64     `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	Uses        int       `json:"uses"`
-	Weight      int       `json:"weight"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-}
+// PotionItemType is the inventory item type of a carried potion
+const PotionItemType = "potion"
 
-type CreatePotionInput struct {
+type Potion struct {
+	ID          int64  `json:"id"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
 	Uses        int    `json:"uses"`
 	Weight      int    `json:"weight"`
+	// Drinking the potion sets EffectAttribute to EffectScore for the number
+	// of turns rolled on EffectDuration
+	EffectAttribute string    `json:"effect_attribute,omitempty"`
+	EffectScore     *int      `json:"effect_score,omitempty"`
+	EffectDuration  string    `json:"effect_duration,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+type CreatePotionInput struct {
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	Uses            int    `json:"uses"`
+	Weight          int    `json:"weight"`
+	EffectAttribute string `json:"effect_attribute,omitempty"`
+	EffectScore     *int   `json:"effect_score,omitempty"`
+	EffectDuration  string `json:"effect_duration,omitempty"`
 }
 
 type UpdatePotionInput struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Uses        int    `json:"uses"`
-	Weight      int    `json:"weight"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	Uses            int    `json:"uses"`
+	Weight          int    `json:"weight"`
+	EffectAttribute string `json:"effect_attribute,omitempty"`
+	EffectScore     *int   `json:"effect_score,omitempty"`
+	EffectDuration  string `json:"effect_duration,omitempty"`
 }
 
 func (i *CreatePotionInput) Validate() error {
@@ -41,7 +57,7 @@ func (i *CreatePotionInput) Validate() error {
 	if i.Weight <= 0 {
 		return NewValidationError("weight", "Weight must be positive")
 	}
-	return nil
+	return validatePotionEffect(i.EffectAttribute, i.EffectScore, i.EffectDuration)
 }
 
 func (i *UpdatePotionInput) Validate() error {
@@ -57,5 +73,23 @@ func (i *UpdatePotionInput) Validate() error {
 	if i.Weight <= 0 {
 		return NewValidationError("weight", "Weight must be positive")
 	}
+	return validatePotionEffect(i.EffectAttribute, i.EffectScore, i.EffectDuration)
+}
+
+// validatePotionEffect checks that a potion's effect is either absent or
+// fully described
+func validatePotionEffect(attribute string, score *int, duration string) error {
+	if attribute == "" && score == nil && duration == "" {
+		return nil
+	}
+	if !IsValidEffectAttribute(attribute) {
+		return NewValidationError("effect_attribute", "Effect attribute must be one of strength, dexterity, constitution, intelligence, wisdom or charisma")
+	}
+	if score == nil || *score < 3 || *score > 18 {
+		return NewValidationError("effect_score", "Effect score must be between 3 and 18")
+	}
+	if _, err := dice.Parse(duration); err != nil {
+		return NewValidationError("effect_duration", "Effect duration must be a dice expression in turns, e.g. 1d6+6")
+	}
 	return nil
 }
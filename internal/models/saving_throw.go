@@ -0,0 +1,37 @@
+package models
+
+import "fmt"
+
+// MaxItemSaveBonus bounds the saving throw bonus of a ring or piece of
+// equipment, such as a ring of protection +3
+const MaxItemSaveBonus = 5
+
+// SavingThrowInput describes a saving throw a character is called on to make
+type SavingThrowInput struct {
+	Category string `json:"category"`
+	// Modifiers are situational adjustments given by the referee, such as a
+	// penalty against a powerful caster
+	Modifiers []RollModifier `json:"modifiers,omitempty"`
+}
+
+// SavingThrowResult is the outcome of a saving throw with each modifier that
+// went into it. The save succeeds when Total meets or beats Target.
+type SavingThrowResult struct {
+	CharacterID   int64          `json:"character_id"`
+	Category      string         `json:"category"`
+	Target        int            `json:"target"`
+	Roll          int            `json:"roll"`
+	Modifiers     []RollModifier `json:"modifiers"`
+	TotalModifier int            `json:"total_modifier"`
+	Total         int            `json:"total"`
+	Success       bool           `json:"success"`
+}
+
+// Validate checks if the input is valid
+func (i *SavingThrowInput) Validate() error {
+	if !IsValidSaveCategory(i.Category) {
+		return NewValidationError("category", fmt.Sprintf("Category must be one of %s, %s, %s, %s or %s",
+			SaveDeath, SaveTransformation, SaveDevice, SaveSorcery, SaveAvoidance))
+	}
+	return validateSituationalModifiers(i.Modifiers)
+}
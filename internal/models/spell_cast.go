@@ -0,0 +1,85 @@
+package models
+
+import (
+	"time"
+)
+
+// Rest and recovery rules for prepared spells. After a full night's rest an
+// arcane caster studies one turn per spell level to recover expended spells;
+// a divine caster prays for an hour to recover all of them.
+const (
+	RestHoursRequired               = 8
+	ArcaneStudyMinutesPerSpellLevel = 10
+	DivinePrayerMinutes             = 60
+)
+
+// SpellCast is a logged casting of a prepared spell
+type SpellCast struct {
+	ID              int64     `json:"id"`
+	CharacterID     int64     `json:"character_id"`
+	PreparedSpellID *int64    `json:"prepared_spell_id,omitempty"`
+	SpellID         int64     `json:"spell_id"`
+	SpellName       string    `json:"spell_name"`
+	SpellLevel      int       `json:"spell_level"`
+	SpellClass      string    `json:"spell_class"`
+	Target          string    `json:"target,omitempty"`
+	Round           *int      `json:"round,omitempty"`
+	Notes           string    `json:"notes,omitempty"`
+	CastAt          time.Time `json:"cast_at"`
+}
+
+// CastSpellInput names the prepared slot to expend. Either PreparedSpellID
+// picks a slot directly, or SpellID expends the lowest unexpended slot
+// holding that spell.
+type CastSpellInput struct {
+	CharacterID     int64  `json:"character_id"`
+	PreparedSpellID int64  `json:"prepared_spell_id,omitempty"`
+	SpellID         int64  `json:"spell_id,omitempty"`
+	Target          string `json:"target,omitempty"`
+	Round           *int   `json:"round,omitempty"`
+	Notes           string `json:"notes,omitempty"`
+}
+
+// RestInput describes a rest taken to recover expended spells.
+// RecoveryMinutes is the time spent studying or praying afterwards.
+type RestInput struct {
+	HoursRested     int `json:"hours_rested"`
+	RecoveryMinutes int `json:"recovery_minutes"`
+}
+
+// RestResult reports which expended spells a rest restored
+type RestResult struct {
+	Restored                []PreparedSpell `json:"restored"`
+	StillExpended           int             `json:"still_expended"`
+	RecoveryMinutesRequired int             `json:"recovery_minutes_required"`
+	RecoveryMinutesUsed     int             `json:"recovery_minutes_used"`
+	TurningUsesRestored     int             `json:"turning_uses_restored,omitempty"`
+}
+
+// Validate checks if the input is valid
+func (i *CastSpellInput) Validate() error {
+	if i.CharacterID <= 0 {
+		return NewValidationError("character_id", "Character ID must be positive")
+	}
+	if i.PreparedSpellID <= 0 && i.SpellID <= 0 {
+		return NewValidationError("prepared_spell_id", "Either prepared_spell_id or spell_id is required")
+	}
+	if i.Round != nil && *i.Round < 1 {
+		return NewValidationError("round", "Round must be positive")
+	}
+	if len(i.Target) > 200 {
+		return NewValidationError("target", "Target cannot exceed 200 characters")
+	}
+	return nil
+}
+
+// Validate checks if the input is valid
+func (i *RestInput) Validate() error {
+	if i.HoursRested < RestHoursRequired {
+		return NewValidationError("hours_rested", "Spells are only recovered after 8 hours of rest")
+	}
+	if i.RecoveryMinutes < 0 {
+		return NewValidationError("recovery_minutes", "Recovery minutes cannot be negative")
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sources a character can learn a new spell from
+const (
+	SpellSourceScroll    = "scroll"
+	SpellSourceSpellbook = "spellbook"
+)
+
+// SpellScrollItemType is the inventory item type of a carried spell scroll
+const SpellScrollItemType = "spell_scroll"
+
+// SpellLearningAttempt records one roll to learn a spell from a scroll or book
+type SpellLearningAttempt struct {
+	ID             int64     `json:"id"`
+	CharacterID    int64     `json:"character_id"`
+	SpellID        int64     `json:"spell_id"`
+	SpellName      string    `json:"spell_name"`
+	SpellLevel     int       `json:"spell_level"`
+	SpellClass     string    `json:"spell_class"`
+	SourceType     string    `json:"source_type"`
+	SourceID       int64     `json:"source_id"`
+	CharacterLevel int       `json:"character_level"`
+	Roll           int       `json:"roll"`
+	Chance         int       `json:"chance"`
+	Success        bool      `json:"success"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// LearnSpellInput names the source to learn a spell from. For a scroll the
+// source is the inventory item holding it; for a spellbook it is the book's
+// id and the spell to copy must be given. Arcane casters also name one of
+// their own spellbooks to scribe the new spell into.
+type LearnSpellInput struct {
+	SourceType  string `json:"source_type"`
+	SourceID    int64  `json:"source_id"`
+	SpellID     int64  `json:"spell_id,omitempty"`
+	SpellbookID int64  `json:"spellbook_id,omitempty"`
+}
+
+// LearnSpellResult is the outcome of an attempt to learn a spell
+type LearnSpellResult struct {
+	Attempt        *SpellLearningAttempt `json:"attempt"`
+	ScrollConsumed bool                  `json:"scroll_consumed"`
+	Spellbook      *Spellbook            `json:"spellbook,omitempty"`
+}
+
+// Validate checks if the input is valid
+func (i *LearnSpellInput) Validate() error {
+	switch i.SourceType {
+	case SpellSourceScroll, SpellSourceSpellbook:
+	default:
+		return NewValidationError("source_type", "Source type must be scroll or spellbook")
+	}
+	if i.SourceID <= 0 {
+		return NewValidationError("source_id", "Source ID must be positive")
+	}
+	if i.SourceType == SpellSourceSpellbook && i.SpellID <= 0 {
+		return NewValidationError("spell_id", "Spell ID is required when learning from a spellbook")
+	}
+	if i.SpellID < 0 {
+		return NewValidationError("spell_id", "Spell ID cannot be negative")
+	}
+	if i.SpellbookID < 0 {
+		return NewValidationError("spellbook_id", "Spellbook ID cannot be negative")
+	}
+	return nil
+}
+
+// ChanceToLearnSpell returns the percentage chance the character has of
+// understanding a new spell: Intelligence governs arcane magic and Wisdom
+// divine. Zero means the character cannot learn new spells at all.
+func (c *Character) ChanceToLearnSpell(arcane bool) int {
+	chance := c.ClericChance
+	if arcane {
+		chance = c.MagiciansChance
+	}
+	value, err := strconv.Atoi(strings.TrimSuffix(chance, "%"))
+	if err != nil {
+		return 0
+	}
+	return value
+}
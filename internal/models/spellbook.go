@@ -0,0 +1,134 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// SpellbookItemType is the inventory item type of a carried spellbook
+const SpellbookItemType = "spellbook"
+
+// Spellbook sizes. A travelling book trades pages for weight so a caster can
+// carry their most-used spells while the full library stays at home.
+const (
+	StandardSpellbookPages  = 100
+	StandardSpellbookWeight = 5.0
+	StandardSpellbookValue  = 100
+
+	TravellingSpellbookPages  = 40
+	TravellingSpellbookWeight = 1.0
+	TravellingSpellbookValue  = 50
+
+	// SpellbookPagesPerSpellLevel is the page cost of scribing one spell level
+	SpellbookPagesPerSpellLevel = 2
+)
+
+// Spellbook is a physical book of arcane formulae carried in a character's inventory
+type Spellbook struct {
+	ID              int64            `json:"id"`
+	InventoryItemID int64            `json:"inventory_item_id"`
+	CharacterID     int64            `json:"character_id"`
+	Name            string           `json:"name"`
+	Description     string           `json:"description,omitempty"`
+	TotalPages      int              `json:"total_pages"`
+	UsedPages       int              `json:"used_pages"`
+	FreePages       int              `json:"free_pages"`
+	Value           int              `json:"value"`
+	Weight          float64          `json:"weight"`
+	IsTravelling    bool             `json:"is_travelling"`
+	Spells          []SpellbookSpell `json:"spells"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
+}
+
+// SpellbookSpell is a spell scribed into a spellbook
+type SpellbookSpell struct {
+	ID          int64     `json:"id"`
+	SpellbookID int64     `json:"spellbook_id"`
+	SpellID     int64     `json:"spell_id"`
+	SpellName   string    `json:"spell_name"`
+	SpellLevel  int       `json:"spell_level"`
+	SpellClass  string    `json:"spell_class"`
+	Pages       int       `json:"pages"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// CreateSpellbookInput describes a new spellbook for a character. Zero
+// pages, value and weight take the defaults for the book's size.
+type CreateSpellbookInput struct {
+	Name         string  `json:"name"`
+	Description  string  `json:"description,omitempty"`
+	IsTravelling bool    `json:"is_travelling"`
+	TotalPages   int     `json:"total_pages,omitempty"`
+	Value        int     `json:"value,omitempty"`
+	Weight       float64 `json:"weight,omitempty"`
+}
+
+// ScribeSpellInput names a known spell to copy into a spellbook
+type ScribeSpellInput struct {
+	SpellID int64 `json:"spell_id"`
+}
+
+// SpellbookPageCost returns the pages a spell of the given level fills
+func SpellbookPageCost(spellLevel int) int {
+	return spellLevel * SpellbookPagesPerSpellLevel
+}
+
+// ApplyDefaults fills in the size-dependent defaults
+func (i *CreateSpellbookInput) ApplyDefaults() {
+	if i.IsTravelling {
+		if i.Name == "" {
+			i.Name = "Travelling Spellbook"
+		}
+		if i.TotalPages == 0 {
+			i.TotalPages = TravellingSpellbookPages
+		}
+		if i.Value == 0 {
+			i.Value = TravellingSpellbookValue
+		}
+		if i.Weight == 0 {
+			i.Weight = TravellingSpellbookWeight
+		}
+		return
+	}
+	if i.Name == "" {
+		i.Name = "Spellbook"
+	}
+	if i.TotalPages == 0 {
+		i.TotalPages = StandardSpellbookPages
+	}
+	if i.Value == 0 {
+		i.Value = StandardSpellbookValue
+	}
+	if i.Weight == 0 {
+		i.Weight = StandardSpellbookWeight
+	}
+}
+
+// Validate checks if the input is valid
+func (i *CreateSpellbookInput) Validate() error {
+	if strings.TrimSpace(i.Name) == "" {
+		return NewValidationError("name", "Name cannot be empty")
+	}
+	if i.TotalPages <= 0 {
+		return NewValidationError("total_pages", "Total pages must be positive")
+	}
+	if i.IsTravelling && i.TotalPages > TravellingSpellbookPages {
+		return NewValidationError("total_pages", "A travelling spellbook holds at most 40 pages")
+	}
+	if i.Value < 0 {
+		return NewValidationError("value", "Value cannot be negative")
+	}
+	if i.Weight < 0 {
+		return NewValidationError("weight", "Weight cannot be negative")
+	}
+	return nil
+}
+
+// Validate checks if the input is valid
+func (i *ScribeSpellInput) Validate() error {
+	if i.SpellID <= 0 {
+		return NewValidationError("spell_id", "Spell ID must be positive")
+	}
+	return nil
+}
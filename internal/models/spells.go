@@ -60,15 +60,17 @@ type KnownSpell struct {
 
 // PreparedSpell represents a spell that a character has prepared
 type PreparedSpell struct {
-	ID          int64     `json:"id"`
-	CharacterID int64     `json:"character_id"`
-	SpellID     int64     `json:"spell_id"`
-	SpellName   string    `json:"spell_name"`
-	SpellLevel  int       `json:"spell_level"`
-	SpellClass  string    `json:"spell_class"`
-	SlotIndex   int       `json:"slot_index"` // Which slot is this spell prepared in
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          int64      `json:"id"`
+	CharacterID int64      `json:"character_id"`
+	SpellID     int64      `json:"spell_id"`
+	SpellName   string     `json:"spell_name"`
+	SpellLevel  int        `json:"spell_level"`
+	SpellClass  string     `json:"spell_class"`
+	SlotIndex   int        `json:"slot_index"` // Which slot is this spell prepared in
+	Expended    bool       `json:"expended"`   // Cast since the last rest
+	ExpendedAt  *time.Time `json:"expended_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
 }
 
 // CharacterSpellsInfo contains all spell-related information for a character
@@ -78,7 +80,8 @@ type CharacterSpellsInfo struct {
 	SpellSlots             map[string]int              `json:"spell_slots"`
 	MaxKnownSpells         map[string]int              `json:"max_known_spells"`
 	AvailablePreparedSlots map[string]int              `json:"available_prepared_slots"`
-	BonusSpells            map[string]map[string]int   `json:"bonus_spells"` // Class -> Level -> Count
+	RemainingCastings      map[string]int              `json:"remaining_castings"` // Unexpended prepared spells by level
+	BonusSpells            map[string]map[string]int   `json:"bonus_spells"`       // Class -> Level -> Count
 	ClassSpellLimits       map[string]map[string][]int `json:"class_spell_limits"`
 }
 
@@ -98,10 +101,13 @@ type PrepareSpellInput struct {
 	SpellClass  string `json:"spell_class"`
 }
 
-// UnprepareSpellInput represents the data needed to unprepare a spell
+// UnprepareSpellInput represents the data needed to unprepare a spell.
+// SlotIndex picks one of several slots holding the spell; zero means the
+// most recently filled one.
 type UnprepareSpellInput struct {
 	CharacterID int64 `json:"character_id"`
 	SpellID     int64 `json:"spell_id"`
+	SlotIndex   int   `json:"slot_index,omitempty"`
 }
 
 // Validate checks if the input is valid
@@ -235,6 +241,16 @@ func (s *Spell) GetLevel(class string) int {
 	}
 }
 
+// IsSpellList reports whether spells are given levels on the named class's
+// list, as returned by GetLevel
+func IsSpellList(class string) bool {
+	switch class {
+	case "Magician", "Cryomancer", "Illusionist", "Necromancer", "Pyromancer", "Witch", "Cleric", "Druid":
+		return true
+	}
+	return false
+}
+
 // GetClassLevels returns a formatted string of all classes and levels
 func (s *Spell) GetClassLevels() string {
 	levels := ""
@@ -0,0 +1,147 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ThiefSkillDie is the die rolled against a thief skill's x-in-12 chance
+const ThiefSkillDie = 12
+
+// MaxThiefSkillChance caps a skill after modifiers; a roll of 12 always fails
+const MaxThiefSkillChance = 11
+
+// ThiefSkillCheck records a roll against one of a character's thief skills
+type ThiefSkillCheck struct {
+	ID          int64          `json:"id"`
+	CharacterID int64          `json:"character_id"`
+	SkillName   string         `json:"skill_name"`
+	BaseChance  int            `json:"base_chance"`
+	Modifiers   []RollModifier `json:"modifiers"`
+	Chance      int            `json:"chance"`
+	Roll        int            `json:"roll"`
+	Success     bool           `json:"success"`
+	Summary     string         `json:"summary"`
+	Notes       string         `json:"notes,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
+// ThiefSkillCheckSummary describes an outcome for the session log, such as
+// "Climb failed"
+func ThiefSkillCheckSummary(skill string, success bool) string {
+	if success {
+		return skill + " succeeded"
+	}
+	return skill + " failed"
+}
+
+// ThiefSkillCheckInput names the skill to roll. Modifiers are situational
+// adjustments in twelfths given by the referee, such as a bonus for a rope.
+type ThiefSkillCheckInput struct {
+	Skill     string         `json:"skill"`
+	Modifiers []RollModifier `json:"modifiers,omitempty"`
+	Notes     string         `json:"notes,omitempty"`
+}
+
+// Validate checks if the input is valid
+func (i *ThiefSkillCheckInput) Validate() error {
+	if i.Skill == "" {
+		return NewValidationError("skill", "Skill cannot be empty")
+	}
+	if len(i.Notes) > 500 {
+		return NewValidationError("notes", "Notes cannot exceed 500 characters")
+	}
+	return validateSituationalModifiers(i.Modifiers)
+}
+
+// ParseSkillChance reads an x-in-12 chance such as "3:12". Skills a
+// character cannot use yet are listed as "N/A" and report false.
+func ParseSkillChance(chance string) (int, bool) {
+	return parseChanceIn(chance, ThiefSkillDie)
+}
+
+// parseChanceIn reads an x-in-N chance written "x:N" for the given die
+func parseChanceIn(chance string, die int) (int, bool) {
+	parts := strings.SplitN(chance, ":", 2)
+	if len(parts) != 2 || parts[1] != strconv.Itoa(die) {
+		return 0, false
+	}
+	value, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
+
+// ThiefSkillChance applies modifiers to a skill's base chance, keeping the
+// result between 0 and MaxThiefSkillChance
+func ThiefSkillChance(base int, modifiers []RollModifier) int {
+	chance := base
+	for _, modifier := range modifiers {
+		chance += modifier.Value
+	}
+	return min(max(chance, 0), MaxThiefSkillChance)
+}
+
+// FormatSkillChance writes a chance in the x-in-12 form
+func FormatSkillChance(chance int) string {
+	return fmt.Sprintf("%d:%d", chance, ThiefSkillDie)
+}
+
+// IsAgilitySkill reports whether a skill relies on dexterity, so that armour
+// and encumbrance hinder it
+func IsAgilitySkill(skill *ThiefSkillWithChance) bool {
+	return skill.Attribute == "DX"
+}
+
+// ArmorSkillPenalty is the penalty to agility skills for wearing armour of
+// the given weight class
+func ArmorSkillPenalty(weightClass string) int {
+	switch weightClass {
+	case "Medium":
+		return -2
+	case "Heavy":
+		return -4
+	}
+	return 0
+}
+
+// EncumbranceSkillPenalty is the penalty to agility skills for the weight
+// the character carries
+func EncumbranceSkillPenalty(status EncumbranceStatus) int {
+	switch {
+	case status.Overloaded:
+		return -3
+	case status.HeavyEncumbered:
+		return -2
+	case status.Encumbered:
+		return -1
+	}
+	return 0
+}
+
+// ThiefSkillItemAliases maps items named for their effect rather than the
+// skill they aid
+var ThiefSkillItemAliases = map[string]string{
+	"elvenkind boots":    "Move Silently",
+	"boots of elvenkind": "Move Silently",
+	"elvenkind cloak":    "Hide",
+	"cloak of elvenkind": "Hide",
+}
+
+// ItemAidsThiefSkill reports whether an item is named for the skill, either
+// directly ("Gloves of Pick Pockets +1") or through a known alias
+func ItemAidsThiefSkill(itemName, skill string) bool {
+	name := strings.ToLower(itemName)
+	if strings.Contains(name, strings.ToLower(skill)) {
+		return true
+	}
+	for alias, aided := range ThiefSkillItemAliases {
+		if aided == skill && strings.Contains(name, alias) {
+			return true
+		}
+	}
+	return false
+}
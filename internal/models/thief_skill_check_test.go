@@ -0,0 +1,127 @@
+package models_test
+
+import (
+	"testing"
+
+	"mordezzanV4/internal/models"
+)
+
+func TestParseSkillChance(t *testing.T) {
+	tests := []struct {
+		chance string
+		want   int
+		ok     bool
+	}{
+		{"3:12", 3, true},
+		{"11:12", 11, true},
+		{"0:12", 0, true},
+		{"x:12", 0, false},
+		{"N/A", 0, false},
+		{"3:6", 0, false},
+		{"3", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.chance, func(t *testing.T) {
+			got, ok := models.ParseSkillChance(tt.chance)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("ParseSkillChance(%q) = %d, %v, want %d, %v", tt.chance, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestThiefSkillChance(t *testing.T) {
+	tests := []struct {
+		name      string
+		base      int
+		modifiers []int
+		want      int
+	}{
+		{"no modifiers", 5, nil, 5},
+		{"bonus and penalty", 5, []int{2, -1}, 6},
+		{"capped so a 12 always fails", 10, []int{1, 2}, models.MaxThiefSkillChance},
+		{"base at the cap", models.MaxThiefSkillChance, []int{1}, models.MaxThiefSkillChance},
+		{"never below 0", 2, []int{-4, -2}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modifiers := []models.RollModifier{}
+			for _, value := range tt.modifiers {
+				modifiers = append(modifiers, models.RollModifier{Source: "Referee", Value: value})
+			}
+			if got := models.ThiefSkillChance(tt.base, modifiers); got != tt.want {
+				t.Errorf("ThiefSkillChance(%d, %v) = %d, want %d", tt.base, tt.modifiers, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArmorSkillPenalty(t *testing.T) {
+	tests := []struct {
+		weightClass string
+		want        int
+	}{
+		{"Light", 0},
+		{"Medium", -2},
+		{"Heavy", -4},
+		{"", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.weightClass, func(t *testing.T) {
+			if got := models.ArmorSkillPenalty(tt.weightClass); got != tt.want {
+				t.Errorf("ArmorSkillPenalty(%q) = %d, want %d", tt.weightClass, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncumbranceSkillPenalty(t *testing.T) {
+	tests := []struct {
+		name   string
+		status models.EncumbranceStatus
+		want   int
+	}{
+		{"unencumbered", models.EncumbranceStatus{}, 0},
+		{"encumbered", models.EncumbranceStatus{Encumbered: true}, -1},
+		{"heavily encumbered", models.EncumbranceStatus{Encumbered: true, HeavyEncumbered: true}, -2},
+		{"overloaded", models.EncumbranceStatus{Encumbered: true, HeavyEncumbered: true, Overloaded: true}, -3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := models.EncumbranceSkillPenalty(tt.status); got != tt.want {
+				t.Errorf("EncumbranceSkillPenalty() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestItemAidsThiefSkill(t *testing.T) {
+	tests := []struct {
+		itemName string
+		skill    string
+		want     bool
+	}{
+		{"Gloves of Pick Pockets +1", "Pick Pockets", true},
+		{"gloves of pick pockets", "Pick Pockets", true},
+		{"Boots of Elvenkind", "Move Silently", true},
+		{"Elvenkind Boots", "Move Silently", true},
+		{"Cloak of Elvenkind", "Hide", true},
+		{"Cloak of Elvenkind", "Move Silently", false},
+		{"Boots of Elvenkind", "Hide", false},
+		{"Rope of Climbing", "Climb", true},
+		{"Leather Armor", "Climb", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.itemName+"/"+tt.skill, func(t *testing.T) {
+			if got := models.ItemAidsThiefSkill(tt.itemName, tt.skill); got != tt.want {
+				t.Errorf("ItemAidsThiefSkill(%q, %q) = %v, want %v", tt.itemName, tt.skill, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Outcomes of an attempt to turn or command undead
+const (
+	TurnUndeadNoEffect  = "no_effect"
+	TurnUndeadFailed    = "failed"
+	TurnUndeadTurned    = "turned"
+	TurnUndeadDestroyed = "destroyed"
+	TurnUndeadCommanded = "commanded"
+)
+
+// Turning matrix cells that need no roll
+const (
+	TurningEntryNone      = "-"
+	TurningEntryTurned    = "T"
+	TurningEntryDestroyed = "D"
+)
+
+// MaxUndeadType is the most powerful undead type on the turning matrix;
+// undead of this many hit dice or more are all of this type
+const MaxUndeadType = 13
+
+// TurnUndeadDie is the die rolled against the chance-in-twelve of success
+const TurnUndeadDie = 12
+
+// TurnUndeadAffectedDice is rolled for how many undead an attempt affects
+const TurnUndeadAffectedDice = "2d6"
+
+// TurnUndeadUsesPerDay is how many times a day a character may attempt to
+// turn or command undead; a night's rest restores them
+const TurnUndeadUsesPerDay = 3
+
+// TurningEntry is a cell of the turning matrix: a chance-in-twelve of
+// success, or an automatic turn or destruction
+type TurningEntry struct {
+	Chance    int  `json:"chance,omitempty"`
+	Automatic bool `json:"automatic,omitempty"`
+	Destroy   bool `json:"destroy,omitempty"`
+}
+
+// String returns the entry as printed on the matrix
+func (e TurningEntry) String() string {
+	switch {
+	case e.Destroy:
+		return TurningEntryDestroyed
+	case e.Automatic:
+		return TurningEntryTurned
+	case e.Chance > 0:
+		return fmt.Sprintf("%d:12", e.Chance)
+	}
+	return TurningEntryNone
+}
+
+// TurningMatrix cross-references turning ability with undead type. Undead of
+// the turner's own ability stand a 7:12 chance; each type weaker adds three
+// in twelve until they are turned outright, then destroyed, and each type
+// stronger takes three away.
+func TurningMatrix(turningAbility, undeadType int) TurningEntry {
+	switch diff := turningAbility - undeadType; {
+	case diff >= 4:
+		return TurningEntry{Automatic: true, Destroy: true}
+	case diff >= 2:
+		return TurningEntry{Automatic: true}
+	case diff >= -2:
+		return TurningEntry{Chance: 7 + 3*diff}
+	}
+	return TurningEntry{}
+}
+
+// UndeadTypeForHitDice returns the matrix type of undead with the given
+// whole hit dice; fractional dice count as type 1
+func UndeadTypeForHitDice(hitDice int) int {
+	return min(max(hitDice, 1), MaxUndeadType)
+}
+
+// CanCommandUndead reports whether the class may compel undead to serve
+// instead of turning them
+func CanCommandUndead(class string) bool {
+	switch class {
+	case "Cleric", "Priest", "Shaman", "Necromancer":
+		return true
+	}
+	return false
+}
+
+// AlwaysCommandsUndead reports whether the class only ever commands undead
+func AlwaysCommandsUndead(class string) bool {
+	return class == "Necromancer"
+}
+
+// TurnUndeadAttempt records a character's attempt to turn or command undead
+type TurnUndeadAttempt struct {
+	ID             int64     `json:"id"`
+	CharacterID    int64     `json:"character_id"`
+	UndeadType     int       `json:"undead_type"`
+	TurningAbility int       `json:"turning_ability"`
+	Command        bool      `json:"command"`
+	Entry          string    `json:"entry"`
+	Roll           int       `json:"roll,omitempty"`
+	Chance         int       `json:"chance,omitempty"`
+	Result         string    `json:"result"`
+	Affected       int       `json:"affected"`
+	Recovered      bool      `json:"recovered"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// TurnUndeadInput describes the undead faced, by matrix type or hit dice.
+// Count is how many are present and caps those affected; zero if unknown.
+// Command compels the undead to serve instead of turning them.
+type TurnUndeadInput struct {
+	UndeadType int    `json:"undead_type,omitempty"`
+	HitDice    string `json:"hit_dice,omitempty"`
+	Count      int    `json:"count,omitempty"`
+	Command    bool   `json:"command"`
+}
+
+// TurnUndeadResult is the outcome of an attempt and the uses left today
+type TurnUndeadResult struct {
+	Attempt          *TurnUndeadAttempt `json:"attempt"`
+	CharismaModifier int                `json:"charisma_modifier,omitempty"`
+	Turned           int                `json:"turned"`
+	Destroyed        int                `json:"destroyed"`
+	Commanded        int                `json:"commanded"`
+	UsesRemaining    int                `json:"uses_remaining"`
+}
+
+// TurnUndeadStatus is a character's turning ability, uses left today and
+// past attempts
+type TurnUndeadStatus struct {
+	CharacterID    int64                `json:"character_id"`
+	TurningAbility int                  `json:"turning_ability"`
+	UsesPerDay     int                  `json:"uses_per_day"`
+	UsesRemaining  int                  `json:"uses_remaining"`
+	Attempts       []*TurnUndeadAttempt `json:"attempts"`
+}
+
+// Validate checks if the input is valid
+func (i *TurnUndeadInput) Validate() error {
+	if (i.UndeadType == 0) == (i.HitDice == "") {
+		return NewValidationError("undead_type", "Give either the undead type or its hit dice")
+	}
+	if i.UndeadType != 0 && (i.UndeadType < 1 || i.UndeadType > MaxUndeadType) {
+		return NewValidationError("undead_type", fmt.Sprintf("Undead type must be between 1 and %d", MaxUndeadType))
+	}
+	if i.HitDice != "" {
+		if _, err := HitDiceLevel(i.HitDice); err != nil {
+			return NewValidationError("hit_dice", err.Error())
+		}
+	}
+	if i.Count < 0 {
+		return NewValidationError("count", "Count cannot be negative")
+	}
+	return nil
+}
+
+// ResolvedUndeadType returns the matrix type of the undead described
+func (i *TurnUndeadInput) ResolvedUndeadType() int {
+	if i.UndeadType != 0 {
+		return i.UndeadType
+	}
+	hitDice, _ := HitDiceLevel(i.HitDice)
+	return UndeadTypeForHitDice(hitDice)
+}
@@ -0,0 +1,57 @@
+package models_test
+
+import (
+	"fmt"
+	"testing"
+
+	"mordezzanV4/internal/models"
+)
+
+func TestTurningMatrix(t *testing.T) {
+	tests := []struct {
+		ability, undeadType int
+		want                models.TurningEntry
+		printed             string
+	}{
+		{1, 1, models.TurningEntry{Chance: 7}, "7:12"},
+		{1, 2, models.TurningEntry{Chance: 4}, "4:12"},
+		{1, 3, models.TurningEntry{Chance: 1}, "1:12"},
+		{1, 4, models.TurningEntry{}, "-"},
+		{5, 4, models.TurningEntry{Chance: 10}, "10:12"},
+		{5, 3, models.TurningEntry{Automatic: true}, "T"},
+		{5, 2, models.TurningEntry{Automatic: true}, "T"},
+		{5, 1, models.TurningEntry{Automatic: true, Destroy: true}, "D"},
+		{13, 13, models.TurningEntry{Chance: 7}, "7:12"},
+		{2, 13, models.TurningEntry{}, "-"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("ability %d vs type %d", tt.ability, tt.undeadType), func(t *testing.T) {
+			got := models.TurningMatrix(tt.ability, tt.undeadType)
+			if got != tt.want {
+				t.Errorf("TurningMatrix() = %+v, want %+v", got, tt.want)
+			}
+			if got.String() != tt.printed {
+				t.Errorf("String() = %q, want %q", got.String(), tt.printed)
+			}
+		})
+	}
+}
+
+func TestUndeadTypeForHitDice(t *testing.T) {
+	tests := []struct {
+		hitDice, want int
+	}{
+		{0, 1},
+		{1, 1},
+		{6, 6},
+		{13, models.MaxUndeadType},
+		{20, models.MaxUndeadType},
+	}
+
+	for _, tt := range tests {
+		if got := models.UndeadTypeForHitDice(tt.hitDice); got != tt.want {
+			t.Errorf("UndeadTypeForHitDice(%d) = %d, want %d", tt.hitDice, got, tt.want)
+		}
+	}
+}
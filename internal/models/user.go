@@ -7,15 +7,38 @@ import (
 	"time"
 )
 
+// User roles, from least to most privileged
+const (
+	RolePlayer     = "player"
+	RoleGameMaster = "game_master"
+	RoleAdmin      = "admin"
+)
+
 type User struct {
 	ID           int64     `json:"id"`
 	Username     string    `json:"username"`
 	Email        string    `json:"email"`
+	Role         string    `json:"role"`
 	PasswordHash string    `json:"-"` // Never expose in JSON responses
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
 }
 
+// IsAdmin reports whether the user may manage users and the shared catalogs
+func (u *User) IsAdmin() bool {
+	return u.Role == RoleAdmin
+}
+
+// IsGameMaster reports whether the user may run games; admins are also game masters
+func (u *User) IsGameMaster() bool {
+	return u.Role == RoleGameMaster || u.Role == RoleAdmin
+}
+
+// IsValidRole checks that a role is one of the known roles
+func IsValidRole(role string) bool {
+	return role == RolePlayer || role == RoleGameMaster || role == RoleAdmin
+}
+
 func (u *User) Validate() error {
 	if len(strings.TrimSpace(u.Username)) < 3 {
 		return apperrors.NewValidationError("username", "username must be at least 3 characters long")
@@ -71,3 +94,14 @@ func (i *UpdateUserInput) Validate() error {
 	}
 	return nil
 }
+
+type UpdateUserRoleInput struct {
+	Role string `json:"role"`
+}
+
+func (i *UpdateUserRoleInput) Validate() error {
+	if !IsValidRole(i.Role) {
+		return apperrors.NewValidationError("role", "Role must be 'player', 'game_master' or 'admin'")
+	}
+	return nil
+}
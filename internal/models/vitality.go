@@ -0,0 +1,161 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Vitality states a character passes through as their hit points fall. A
+// character at 0 is unconscious; below 0 they are dying and lose a hit point
+// each round until their wounds are bound; at MinHitPoints they are dead.
+const (
+	VitalityHealthy     = "healthy"
+	VitalityUnconscious = "unconscious"
+	VitalityDying       = "dying"
+	VitalityDead        = "dead"
+)
+
+// DyingLossPerRound is the hit points a dying character loses each round
+const DyingLossPerRound = 1
+
+// ResurrectionHitPoints is the hit points a character returns to life with
+const ResurrectionHitPoints = 1
+
+// ResurrectionConstitutionLoss is the constitution a character permanently
+// loses each time they are returned to life
+const ResurrectionConstitutionLoss = 1
+
+// ResurrectionDie is the die rolled against a character's trauma survival
+const ResurrectionDie = 100
+
+// VitalityFor returns the vitality state for a character's current hit
+// points. Once stabilized, a character below 0 stays unconscious rather than
+// dying.
+func VitalityFor(hitPoints int, stabilized bool) string {
+	switch {
+	case hitPoints <= MinHitPoints:
+		return VitalityDead
+	case hitPoints < 0 && !stabilized:
+		return VitalityDying
+	case hitPoints <= 0:
+		return VitalityUnconscious
+	}
+	return VitalityHealthy
+}
+
+// UpdateVitality sets the character's vitality from their hit points
+func (c *Character) UpdateVitality() {
+	c.Vitality = VitalityFor(c.CurrentHitPoints, c.Stabilized)
+}
+
+// IsDead reports whether the character has fallen to MinHitPoints
+func (c *Character) IsDead() bool {
+	return c.CurrentHitPoints <= MinHitPoints
+}
+
+// IsRetired reports whether the character has been retired from play
+func (c *Character) IsRetired() bool {
+	return c.RetiredAt != nil
+}
+
+// IsReadOnly reports whether the character can no longer be changed: the
+// dead until they are resurrected, and the retired
+func (c *Character) IsReadOnly() bool {
+	return c.IsDead() || c.IsRetired()
+}
+
+// ReadOnlyReason explains why a read-only character cannot be changed
+func (c *Character) ReadOnlyReason() string {
+	if c.IsRetired() {
+		return fmt.Sprintf("%s is retired and can no longer be changed", c.Name)
+	}
+	return fmt.Sprintf("%s is dead and cannot be changed until resurrected", c.Name)
+}
+
+// Bleed takes the hit points a dying character loses over the given rounds,
+// stopping at death, and returns how many were lost
+func (c *Character) Bleed(rounds int) int {
+	if VitalityFor(c.CurrentHitPoints, c.Stabilized) != VitalityDying || rounds <= 0 {
+		return 0
+	}
+	loss := min(rounds*DyingLossPerRound, c.CurrentHitPoints-MinHitPoints)
+	c.CurrentHitPoints -= loss
+	c.UpdateVitality()
+	return loss
+}
+
+// TraumaSurvivalChance returns the character's trauma survival as a
+// percentage. Derived stats must be calculated first.
+func (c *Character) TraumaSurvivalChance() (int, error) {
+	percent, ok := parsePercent(c.TraumaSurvival)
+	if !ok {
+		return 0, fmt.Errorf("unexpected format for trauma survival: %s", c.TraumaSurvival)
+	}
+	return percent, nil
+}
+
+// parsePercent reads a percentage written such as "75%"
+func parsePercent(value string) (int, bool) {
+	var percent int
+	if _, err := fmt.Sscanf(value, "%d%%", &percent); err != nil {
+		return 0, false
+	}
+	return percent, true
+}
+
+// VitalityStatus is how close to death a character is and their chance of
+// surviving a return to life
+type VitalityStatus struct {
+	CharacterID      int64      `json:"character_id"`
+	Vitality         string     `json:"vitality"`
+	CurrentHitPoints int        `json:"current_hit_points"`
+	MaxHitPoints     int        `json:"max_hit_points"`
+	Stabilized       bool       `json:"stabilized"`
+	TraumaSurvival   string     `json:"trauma_survival"`
+	ReadOnly         bool       `json:"read_only"`
+	RetiredAt        *time.Time `json:"retired_at,omitempty"`
+}
+
+// ResurrectInput names the means by which a dead character is returned to
+// life, such as a raise dead spell. It is recorded as the source of the
+// constitution they lose.
+type ResurrectInput struct {
+	Source string `json:"source,omitempty"`
+}
+
+// Validate checks if the input is valid
+func (i *ResurrectInput) Validate() error {
+	if len(i.Source) > 200 {
+		return NewValidationError("source", "Source cannot exceed 200 characters")
+	}
+	return nil
+}
+
+// ResolvedSource returns the source to record, defaulting to "Resurrection"
+func (i *ResurrectInput) ResolvedSource() string {
+	if i.Source != "" {
+		return i.Source
+	}
+	return "Resurrection"
+}
+
+// ResurrectionResult is the trauma survival roll for a return to life and,
+// when it succeeded, the constitution it cost
+type ResurrectionResult struct {
+	CharacterID      int64      `json:"character_id"`
+	Chance           int        `json:"chance"`
+	Roll             int        `json:"roll"`
+	Success          bool       `json:"success"`
+	ConstitutionLost int        `json:"constitution_lost,omitempty"`
+	Summary          string     `json:"summary"`
+	Character        *Character `json:"character"`
+}
+
+// ResurrectionSummary describes an outcome, such as "Thorin survives the
+// trauma of resurrection"
+func ResurrectionSummary(name string, success bool) string {
+	if success {
+		return fmt.Sprintf("%s survives the trauma of resurrection", name)
+	}
+	return fmt.Sprintf("%s does not survive the trauma of resurrection", name)
+}
@@ -0,0 +1,120 @@
+package models_test
+
+import (
+	"testing"
+
+	"mordezzanV4/internal/models"
+)
+
+func TestVitalityFor(t *testing.T) {
+	tests := []struct {
+		name       string
+		hitPoints  int
+		stabilized bool
+		want       string
+	}{
+		{"above 0", 5, false, models.VitalityHealthy},
+		{"at 1", 1, false, models.VitalityHealthy},
+		{"at 0", 0, false, models.VitalityUnconscious},
+		{"stabilized at 0", 0, true, models.VitalityUnconscious},
+		{"below 0", -1, false, models.VitalityDying},
+		{"stabilized below 0", -9, true, models.VitalityUnconscious},
+		{"at the minimum", models.MinHitPoints, false, models.VitalityDead},
+		{"stabilized at the minimum", models.MinHitPoints, true, models.VitalityDead},
+		{"past the minimum", models.MinHitPoints - 5, false, models.VitalityDead},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := models.VitalityFor(tt.hitPoints, tt.stabilized); got != tt.want {
+				t.Errorf("VitalityFor(%d, %v) = %q, want %q", tt.hitPoints, tt.stabilized, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBleed(t *testing.T) {
+	tests := []struct {
+		name       string
+		hitPoints  int
+		stabilized bool
+		rounds     int
+		loss       int
+		after      int
+		vitality   string
+	}{
+		{"dying loses a point a round", -2, false, 3, 3, -5, models.VitalityDying},
+		{"bleeding stops at death", -8, false, 5, 2, models.MinHitPoints, models.VitalityDead},
+		{"stabilized does not bleed", -4, true, 3, 0, -4, models.VitalityUnconscious},
+		{"unconscious does not bleed", 0, false, 3, 0, 0, models.VitalityUnconscious},
+		{"healthy does not bleed", 6, false, 3, 0, 6, models.VitalityHealthy},
+		{"dead does not bleed", models.MinHitPoints, false, 3, 0, models.MinHitPoints, models.VitalityDead},
+		{"no rounds", -3, false, 0, 0, -3, models.VitalityDying},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := &models.Character{CurrentHitPoints: tt.hitPoints, Stabilized: tt.stabilized}
+			character.UpdateVitality()
+			if got := character.Bleed(tt.rounds); got != tt.loss {
+				t.Errorf("Bleed(%d) = %d, want %d", tt.rounds, got, tt.loss)
+			}
+			if character.CurrentHitPoints != tt.after {
+				t.Errorf("CurrentHitPoints = %d, want %d", character.CurrentHitPoints, tt.after)
+			}
+			if character.Vitality != tt.vitality {
+				t.Errorf("Vitality = %q, want %q", character.Vitality, tt.vitality)
+			}
+		})
+	}
+}
+
+func TestModifyHitPoints(t *testing.T) {
+	tests := []struct {
+		name       string
+		hitPoints  int
+		temporary  int
+		stabilized bool
+		delta      int
+		temp       bool
+		after      int
+		tempAfter  int
+		stabAfter  bool
+		vitality   string
+	}{
+		{"damage to 0 knocks out", 4, 0, false, -4, false, 0, 0, false, models.VitalityUnconscious},
+		{"damage below 0 starts dying", 4, 0, false, -6, false, -2, 0, false, models.VitalityDying},
+		{"damage stops at the minimum", 4, 0, false, -30, false, models.MinHitPoints, 0, false, models.VitalityDead},
+		{"temporary hit points absorb damage first", 4, 3, false, -5, false, 2, 0, false, models.VitalityHealthy},
+		{"damage absorbed entirely keeps stabilization", -3, 5, true, -2, false, -3, 3, true, models.VitalityUnconscious},
+		{"damage to the stabilized reopens wounds", -3, 0, true, -1, false, -4, 0, false, models.VitalityDying},
+		{"healing the dying to 0 leaves them unconscious", -3, 0, false, 3, false, 0, 0, false, models.VitalityUnconscious},
+		{"healing above 0 revives and clears stabilization", -3, 0, true, 5, false, 2, 0, false, models.VitalityHealthy},
+		{"healing stops at maximum", 8, 0, false, 5, false, 10, 0, false, models.VitalityHealthy},
+		{"temporary hit points do not heal", -3, 0, false, 4, true, -3, 4, false, models.VitalityDying},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := &models.Character{
+				MaxHitPoints:       10,
+				CurrentHitPoints:   tt.hitPoints,
+				TemporaryHitPoints: tt.temporary,
+				Stabilized:         tt.stabilized,
+			}
+			character.ModifyHitPoints(tt.delta, tt.temp)
+			if character.CurrentHitPoints != tt.after {
+				t.Errorf("CurrentHitPoints = %d, want %d", character.CurrentHitPoints, tt.after)
+			}
+			if character.TemporaryHitPoints != tt.tempAfter {
+				t.Errorf("TemporaryHitPoints = %d, want %d", character.TemporaryHitPoints, tt.tempAfter)
+			}
+			if character.Stabilized != tt.stabAfter {
+				t.Errorf("Stabilized = %v, want %v", character.Stabilized, tt.stabAfter)
+			}
+			if character.Vitality != tt.vitality {
+				t.Errorf("Vitality = %q, want %q", character.Vitality, tt.vitality)
+			}
+		})
+	}
+}
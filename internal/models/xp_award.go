@@ -0,0 +1,99 @@
+package models
+
+import (
+	"time"
+)
+
+// XPAward is a single entry in a character's experience ledger
+type XPAward struct {
+	ID              int64     `json:"id"`
+	CharacterID     int64     `json:"character_id"`
+	Amount          int       `json:"amount"`
+	BonusPercent    int       `json:"bonus_percent"`
+	BonusAmount     int       `json:"bonus_amount"`
+	Total           int       `json:"total"`
+	Source          string    `json:"source"`
+	Session         string    `json:"session,omitempty"`
+	Notes           string    `json:"notes,omitempty"`
+	AwardedBy       *int64    `json:"awarded_by,omitempty"`
+	ReversesAwardID *int64    `json:"reverses_award_id,omitempty"`
+	Reversed        bool      `json:"reversed"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// CreateXPAwardInput is used for awarding experience to a character
+type CreateXPAwardInput struct {
+	Amount  int    `json:"amount"`
+	Source  string `json:"source"`
+	Session string `json:"session,omitempty"`
+	Notes   string `json:"notes,omitempty"`
+	// NoBonus skips the prime attribute bonus, e.g. for GM corrections
+	NoBonus bool `json:"no_bonus,omitempty"`
+}
+
+// XPLedger summarises a character's experience awards
+type XPLedger struct {
+	CharacterID      int64      `json:"character_id"`
+	ExperiencePoints int        `json:"experience_points"`
+	PrimeAttributes  []string   `json:"prime_attributes"`
+	BonusPercent     int        `json:"bonus_percent"`
+	Awards           []*XPAward `json:"awards"`
+}
+
+// Validate ensures that the XP award input is valid
+func (i *CreateXPAwardInput) Validate() error {
+	if i.Amount == 0 {
+		return NewValidationError("amount", "Amount cannot be zero")
+	}
+	if i.Source == "" {
+		return NewValidationError("source", "Source cannot be empty")
+	}
+	if len(i.Source) > 200 {
+		return NewValidationError("source", "Source cannot exceed 200 characters")
+	}
+	if i.Amount < 0 && !i.NoBonus {
+		return NewValidationError("amount", "Negative awards must set no_bonus")
+	}
+	return nil
+}
+
+// PrimeAttributeXPBonus returns the experience bonus percentage for a character
+// with the given prime attributes: +5% when every one is at least 16, +10% when
+// every one is at least 17. Classes with no prime attributes earn no bonus.
+func (c *Character) PrimeAttributeXPBonus(primes []string) int {
+	if len(primes) == 0 {
+		return 0
+	}
+
+	lowest := 18
+	for _, attribute := range primes {
+		lowest = min(lowest, c.AttributeScore(attribute))
+	}
+
+	switch {
+	case lowest >= 17:
+		return 10
+	case lowest >= 16:
+		return 5
+	}
+	return 0
+}
+
+// AttributeScore returns the score of an attribute by its lowercase name
+func (c *Character) AttributeScore(attribute string) int {
+	switch attribute {
+	case "strength":
+		return c.Strength
+	case "dexterity":
+		return c.Dexterity
+	case "constitution":
+		return c.Constitution
+	case "intelligence":
+		return c.Intelligence
+	case "wisdom":
+		return c.Wisdom
+	case "charisma":
+		return c.Charisma
+	}
+	return 0
+}
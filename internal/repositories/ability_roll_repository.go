@@ -0,0 +1,180 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"mordezzanV4/internal/dice"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	sqlcdb "mordezzanV4/internal/repositories/db/sqlc"
+)
+
+type AbilityRollRepository interface {
+	GetAbilityRoll(ctx context.Context, id int64) (*models.AbilityRoll, error)
+	GetAbilityRollsByUser(ctx context.Context, userID int64) ([]*models.AbilityRoll, error)
+	GetAbilityRollByCharacter(ctx context.Context, characterID int64) (*models.AbilityRoll, error)
+	CreateAbilityRoll(ctx context.Context, roll *models.AbilityRoll) (int64, error)
+	ClaimAbilityRoll(ctx context.Context, id int64) (bool, error)
+	ReleaseAbilityRoll(ctx context.Context, id int64) error
+	SetAbilityRollCharacter(ctx context.Context, id, characterID int64) error
+	CountUnusedDiceAbilityRolls(ctx context.Context, userID int64) (int, error)
+}
+
+type SQLCAbilityRollRepository struct {
+	db *sql.DB
+	q  *sqlcdb.Queries
+}
+
+func NewSQLCAbilityRollRepository(db *sql.DB) *SQLCAbilityRollRepository {
+	return &SQLCAbilityRollRepository{
+		db: db,
+		q:  sqlcdb.New(db),
+	}
+}
+
+func (r *SQLCAbilityRollRepository) GetAbilityRoll(ctx context.Context, id int64) (*models.AbilityRoll, error) {
+	roll, err := r.q.GetAbilityRoll(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("ability roll", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	return mapDbAbilityRollToModel(roll)
+}
+
+func (r *SQLCAbilityRollRepository) GetAbilityRollsByUser(ctx context.Context, userID int64) ([]*models.AbilityRoll, error) {
+	rolls, err := r.q.GetAbilityRollsByUser(ctx, userID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := make([]*models.AbilityRoll, len(rolls))
+	for i, roll := range rolls {
+		result[i], err = mapDbAbilityRollToModel(roll)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (r *SQLCAbilityRollRepository) GetAbilityRollByCharacter(ctx context.Context, characterID int64) (*models.AbilityRoll, error) {
+	roll, err := r.q.GetAbilityRollByCharacter(ctx, sql.NullInt64{Int64: characterID, Valid: true})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("ability roll for character", characterID)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	return mapDbAbilityRollToModel(roll)
+}
+
+func (r *SQLCAbilityRollRepository) CreateAbilityRoll(ctx context.Context, roll *models.AbilityRoll) (int64, error) {
+	scores, err := json.Marshal(roll.Scores)
+	if err != nil {
+		return 0, apperrors.NewInternalError(err)
+	}
+	rolls, err := json.Marshal(roll.Rolls)
+	if err != nil {
+		return 0, apperrors.NewInternalError(err)
+	}
+
+	result, err := r.q.CreateAbilityRoll(ctx, sqlcdb.CreateAbilityRollParams{
+		UserID:    roll.UserID,
+		Method:    roll.Method,
+		Scores:    string(scores),
+		Rolls:     string(rolls),
+		Seed:      roll.Seed,
+		Nonce:     roll.Nonce,
+		Signature: roll.Signature,
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return id, nil
+}
+
+// ClaimAbilityRoll marks a roll as used and reports whether it was still unused
+func (r *SQLCAbilityRollRepository) ClaimAbilityRoll(ctx context.Context, id int64) (bool, error) {
+	result, err := r.q.ClaimAbilityRoll(ctx, id)
+	if err != nil {
+		return false, apperrors.NewDatabaseError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, apperrors.NewDatabaseError(err)
+	}
+	return rowsAffected == 1, nil
+}
+
+func (r *SQLCAbilityRollRepository) ReleaseAbilityRoll(ctx context.Context, id int64) error {
+	if err := r.q.ReleaseAbilityRoll(ctx, id); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *SQLCAbilityRollRepository) SetAbilityRollCharacter(ctx context.Context, id, characterID int64) error {
+	err := r.q.SetAbilityRollCharacter(ctx, sqlcdb.SetAbilityRollCharacterParams{
+		CharacterID: sql.NullInt64{Int64: characterID, Valid: true},
+		ID:          id,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+// CountUnusedDiceAbilityRolls counts the user's rolled, not point-bought,
+// scores that no character has been created from
+func (r *SQLCAbilityRollRepository) CountUnusedDiceAbilityRolls(ctx context.Context, userID int64) (int, error) {
+	count, err := r.q.CountUnusedDiceAbilityRolls(ctx, userID)
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return int(count), nil
+}
+
+func mapDbAbilityRollToModel(roll sqlcdb.AbilityRoll) (*models.AbilityRoll, error) {
+	result := &models.AbilityRoll{
+		ID:        roll.ID,
+		UserID:    roll.UserID,
+		Method:    roll.Method,
+		Seed:      roll.Seed,
+		Nonce:     roll.Nonce,
+		Signature: roll.Signature,
+		CreatedAt: roll.CreatedAt,
+	}
+
+	if err := json.Unmarshal([]byte(roll.Scores), &result.Scores); err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	var rolls []*dice.Result
+	if err := json.Unmarshal([]byte(roll.Rolls), &rolls); err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	result.Rolls = rolls
+
+	if roll.CharacterID.Valid {
+		characterID := roll.CharacterID.Int64
+		result.CharacterID = &characterID
+	}
+	if roll.UsedAt.Valid {
+		usedAt := roll.UsedAt.Time
+		result.UsedAt = &usedAt
+	}
+
+	return result, nil
+}
@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	sqlcdb "mordezzanV4/internal/repositories/db/sqlc"
+)
+
+type ActiveEffectRepository interface {
+	GetActiveEffect(ctx context.Context, characterID, id int64) (*models.ActiveEffect, error)
+	GetActiveEffects(ctx context.Context, characterID int64) ([]*models.ActiveEffect, error)
+	// AdvanceTime counts rounds off each of the character's effects and
+	// removes those that run out
+	AdvanceTime(ctx context.Context, characterID int64, rounds int) error
+	RemoveActiveEffect(ctx context.Context, characterID, id int64) error
+}
+
+type SQLCActiveEffectRepository struct {
+	db *sql.DB
+	q  *sqlcdb.Queries
+}
+
+func NewSQLCActiveEffectRepository(db *sql.DB) *SQLCActiveEffectRepository {
+	return &SQLCActiveEffectRepository{
+		db: db,
+		q:  sqlcdb.New(db),
+	}
+}
+
+func (r *SQLCActiveEffectRepository) GetActiveEffect(ctx context.Context, characterID, id int64) (*models.ActiveEffect, error) {
+	effect, err := r.q.GetActiveEffect(ctx, sqlcdb.GetActiveEffectParams{
+		ID:          id,
+		CharacterID: characterID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("active effect", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	result := mapDbActiveEffectToModel(effect)
+	return &result, nil
+}
+
+func (r *SQLCActiveEffectRepository) GetActiveEffects(ctx context.Context, characterID int64) ([]*models.ActiveEffect, error) {
+	effects, err := r.q.GetActiveEffectsByCharacter(ctx, characterID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := make([]*models.ActiveEffect, len(effects))
+	for i, effect := range effects {
+		mapped := mapDbActiveEffectToModel(effect)
+		result[i] = &mapped
+	}
+	return result, nil
+}
+
+func (r *SQLCActiveEffectRepository) AdvanceTime(ctx context.Context, characterID int64, rounds int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	err = qtx.AdvanceActiveEffects(ctx, sqlcdb.AdvanceActiveEffectsParams{
+		RemainingRounds: int64(rounds),
+		CharacterID:     characterID,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	if err := qtx.DeleteExpiredActiveEffects(ctx, characterID); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *SQLCActiveEffectRepository) RemoveActiveEffect(ctx context.Context, characterID, id int64) error {
+	if _, err := r.GetActiveEffect(ctx, characterID, id); err != nil {
+		return err
+	}
+	err := r.q.DeleteActiveEffect(ctx, sqlcdb.DeleteActiveEffectParams{
+		ID:          id,
+		CharacterID: characterID,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+// createActiveEffect starts an effect within the caller's transaction
+func createActiveEffect(ctx context.Context, qtx *sqlcdb.Queries, effect *models.ActiveEffect) (int64, error) {
+	result, err := qtx.CreateActiveEffect(ctx, sqlcdb.CreateActiveEffectParams{
+		CharacterID:     effect.CharacterID,
+		SourceType:      effect.SourceType,
+		SourceID:        effect.SourceID,
+		Name:            effect.Name,
+		Attribute:       sql.NullString{String: effect.Attribute, Valid: effect.Attribute != ""},
+		Score:           nullInt64FromPtr(effect.Score),
+		DurationRounds:  int64(effect.DurationRounds),
+		RemainingRounds: int64(effect.RemainingRounds),
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return id, nil
+}
+
+func mapDbActiveEffectToModel(effect sqlcdb.ActiveEffect) models.ActiveEffect {
+	return models.ActiveEffect{
+		ID:              effect.ID,
+		CharacterID:     effect.CharacterID,
+		SourceType:      effect.SourceType,
+		SourceID:        effect.SourceID,
+		Name:            effect.Name,
+		Attribute:       effect.Attribute.String,
+		Score:           intPtrFromNullInt64(effect.Score),
+		DurationRounds:  int(effect.DurationRounds),
+		RemainingRounds: int(effect.RemainingRounds),
+		CreatedAt:       effect.CreatedAt,
+	}
+}
@@ -0,0 +1,127 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	sqlcdb "mordezzanV4/internal/repositories/db/sqlc"
+)
+
+type AttributeAdjustmentRepository interface {
+	AddAdjustment(ctx context.Context, characterID int64, input *models.AddAttributeAdjustmentInput) (int64, error)
+	GetAdjustment(ctx context.Context, characterID, id int64) (*models.AttributeAdjustment, error)
+	GetAdjustments(ctx context.Context, characterID int64) ([]*models.AttributeAdjustment, error)
+	RemoveAdjustment(ctx context.Context, characterID, id int64) error
+	// RestoreAdjustments removes the character's adjustments of a kind, to the
+	// named attribute or to all of them when attribute is empty, returning how
+	// many were removed
+	RestoreAdjustments(ctx context.Context, characterID int64, kind, attribute string) (int, error)
+}
+
+type SQLCAttributeAdjustmentRepository struct {
+	db *sql.DB
+	q  *sqlcdb.Queries
+}
+
+func NewSQLCAttributeAdjustmentRepository(db *sql.DB) *SQLCAttributeAdjustmentRepository {
+	return &SQLCAttributeAdjustmentRepository{
+		db: db,
+		q:  sqlcdb.New(db),
+	}
+}
+
+func (r *SQLCAttributeAdjustmentRepository) AddAdjustment(ctx context.Context, characterID int64, input *models.AddAttributeAdjustmentInput) (int64, error) {
+	result, err := r.q.CreateAttributeAdjustment(ctx, sqlcdb.CreateAttributeAdjustmentParams{
+		CharacterID: characterID,
+		Attribute:   input.Attribute,
+		Kind:        input.Kind,
+		Amount:      int64(input.Amount),
+		Score:       nullInt64FromPtr(input.Score),
+		Source:      input.Source,
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return id, nil
+}
+
+func (r *SQLCAttributeAdjustmentRepository) GetAdjustment(ctx context.Context, characterID, id int64) (*models.AttributeAdjustment, error) {
+	adjustment, err := r.q.GetAttributeAdjustment(ctx, sqlcdb.GetAttributeAdjustmentParams{
+		ID:          id,
+		CharacterID: characterID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("attribute adjustment", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	result := mapDbAttributeAdjustmentToModel(adjustment)
+	return &result, nil
+}
+
+func (r *SQLCAttributeAdjustmentRepository) GetAdjustments(ctx context.Context, characterID int64) ([]*models.AttributeAdjustment, error) {
+	adjustments, err := r.q.GetAttributeAdjustmentsByCharacter(ctx, characterID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	result := make([]*models.AttributeAdjustment, len(adjustments))
+	for i, adjustment := range adjustments {
+		mapped := mapDbAttributeAdjustmentToModel(adjustment)
+		result[i] = &mapped
+	}
+	return result, nil
+}
+
+func (r *SQLCAttributeAdjustmentRepository) RemoveAdjustment(ctx context.Context, characterID, id int64) error {
+	if _, err := r.GetAdjustment(ctx, characterID, id); err != nil {
+		return err
+	}
+	err := r.q.DeleteAttributeAdjustment(ctx, sqlcdb.DeleteAttributeAdjustmentParams{
+		ID:          id,
+		CharacterID: characterID,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *SQLCAttributeAdjustmentRepository) RestoreAdjustments(ctx context.Context, characterID int64, kind, attribute string) (int, error) {
+	if attribute == "" {
+		attribute = "%"
+	}
+	result, err := r.q.RestoreAttributeAdjustments(ctx, sqlcdb.RestoreAttributeAdjustmentsParams{
+		CharacterID: characterID,
+		Kind:        kind,
+		Attribute:   attribute,
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	restored, err := result.RowsAffected()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return int(restored), nil
+}
+
+func mapDbAttributeAdjustmentToModel(adjustment sqlcdb.AttributeAdjustment) models.AttributeAdjustment {
+	return models.AttributeAdjustment{
+		ID:          adjustment.ID,
+		CharacterID: adjustment.CharacterID,
+		Attribute:   adjustment.Attribute,
+		Kind:        adjustment.Kind,
+		Amount:      int(adjustment.Amount),
+		Score:       intPtrFromNullInt64(adjustment.Score),
+		Source:      adjustment.Source,
+		CreatedAt:   adjustment.CreatedAt,
+	}
+}
@@ -0,0 +1,105 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	sqlcdb "mordezzanV4/internal/repositories/db/sqlc"
+)
+
+type CharacterGrantRepository interface {
+	GetCharacterGrant(ctx context.Context, characterID, userID int64) (*models.CharacterGrant, error)
+	GetCharacterGrants(ctx context.Context, characterID int64) ([]*models.CharacterGrant, error)
+	GetCharacterGrantsByUser(ctx context.Context, userID int64) ([]*models.CharacterGrant, error)
+	SaveCharacterGrant(ctx context.Context, input *models.CreateCharacterGrantInput) error
+	DeleteCharacterGrant(ctx context.Context, characterID, userID int64) error
+}
+
+type SQLCCharacterGrantRepository struct {
+	db *sql.DB
+	q  *sqlcdb.Queries
+}
+
+func NewSQLCCharacterGrantRepository(db *sql.DB) *SQLCCharacterGrantRepository {
+	return &SQLCCharacterGrantRepository{
+		db: db,
+		q:  sqlcdb.New(db),
+	}
+}
+
+func (r *SQLCCharacterGrantRepository) GetCharacterGrant(ctx context.Context, characterID, userID int64) (*models.CharacterGrant, error) {
+	grant, err := r.q.GetCharacterGrant(ctx, sqlcdb.GetCharacterGrantParams{
+		CharacterID: characterID,
+		UserID:      userID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("character grant for user", userID)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	return mapDbCharacterGrantToModel(grant), nil
+}
+
+func (r *SQLCCharacterGrantRepository) GetCharacterGrants(ctx context.Context, characterID int64) ([]*models.CharacterGrant, error) {
+	grants, err := r.q.GetCharacterGrants(ctx, characterID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := make([]*models.CharacterGrant, len(grants))
+	for i, grant := range grants {
+		result[i] = mapDbCharacterGrantToModel(grant)
+	}
+	return result, nil
+}
+
+func (r *SQLCCharacterGrantRepository) GetCharacterGrantsByUser(ctx context.Context, userID int64) ([]*models.CharacterGrant, error) {
+	grants, err := r.q.GetCharacterGrantsByUser(ctx, userID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := make([]*models.CharacterGrant, len(grants))
+	for i, grant := range grants {
+		result[i] = mapDbCharacterGrantToModel(grant)
+	}
+	return result, nil
+}
+
+func (r *SQLCCharacterGrantRepository) SaveCharacterGrant(ctx context.Context, input *models.CreateCharacterGrantInput) error {
+	err := r.q.UpsertCharacterGrant(ctx, sqlcdb.UpsertCharacterGrantParams{
+		CharacterID: input.CharacterID,
+		UserID:      input.UserID,
+		GrantType:   input.GrantType,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *SQLCCharacterGrantRepository) DeleteCharacterGrant(ctx context.Context, characterID, userID int64) error {
+	err := r.q.DeleteCharacterGrant(ctx, sqlcdb.DeleteCharacterGrantParams{
+		CharacterID: characterID,
+		UserID:      userID,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func mapDbCharacterGrantToModel(grant sqlcdb.CharacterGrant) *models.CharacterGrant {
+	return &models.CharacterGrant{
+		ID:          grant.ID,
+		CharacterID: grant.CharacterID,
+		UserID:      grant.UserID,
+		GrantType:   grant.GrantType,
+		CreatedAt:   grant.CreatedAt,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	apperrors "mordezzanV4/internal/errors"
 	"mordezzanV4/internal/models"
 	sqlcdb "mordezzanV4/internal/repositories/db/sqlc"
+	"time"
 )
 
 type CharacterRepository interface {
@@ -15,6 +16,8 @@ type CharacterRepository interface {
 	ListCharacters(ctx context.Context) ([]*models.Character, error)
 	CreateCharacter(ctx context.Context, input *models.CreateCharacterInput) (int64, error)
 	UpdateCharacter(ctx context.Context, id int64, input *models.UpdateCharacterInput) error
+	UpdateHitPoints(ctx context.Context, character *models.Character) error
+	RetireCharacter(ctx context.Context, id int64) error
 	DeleteCharacter(ctx context.Context, id int64) error
 }
 
@@ -40,6 +43,30 @@ func (r *SQLCCharacterRepository) GetCharacter(ctx context.Context, id int64) (*
 	}
 
 	character := mapDbCharacterRowToModel(dbCharacter)
+
+	// Active effects, conditions and attribute adjustments change the derived
+	// stats, so load them first
+	effects, err := r.q.GetActiveEffectsByCharacter(ctx, id)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	for _, effect := range effects {
+		character.ActiveEffects = append(character.ActiveEffects, mapDbActiveEffectToModel(effect))
+	}
+	conditions, err := r.q.GetCharacterConditions(ctx, id)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	for _, condition := range conditions {
+		character.Conditions = append(character.Conditions, mapDbCharacterConditionToModel(condition))
+	}
+	adjustments, err := r.q.GetAttributeAdjustmentsByCharacter(ctx, id)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	for _, adjustment := range adjustments {
+		character.AttributeAdjustments = append(character.AttributeAdjustments, mapDbAttributeAdjustmentToModel(adjustment))
+	}
 	character.CalculateDerivedStats()
 
 	return character, nil
@@ -78,6 +105,7 @@ func (r *SQLCCharacterRepository) CreateCharacter(ctx context.Context, input *mo
 		UserID:             input.UserID,
 		Name:               input.Name,
 		Class:              input.Class,
+		Kindred:            input.Kindred,
 		Level:              int64(input.Level),
 		Strength:           int64(input.Strength),
 		Dexterity:          int64(input.Dexterity),
@@ -106,6 +134,7 @@ func (r *SQLCCharacterRepository) UpdateCharacter(ctx context.Context, id int64,
 	_, err := r.q.UpdateCharacter(ctx, sqlcdb.UpdateCharacterParams{
 		Name:               input.Name,
 		Class:              input.Class,
+		Kindred:            input.Kindred,
 		Level:              int64(input.Level),
 		Strength:           int64(input.Strength),
 		Dexterity:          int64(input.Dexterity),
@@ -129,6 +158,30 @@ func (r *SQLCCharacterRepository) UpdateCharacter(ctx context.Context, id int64,
 	return nil
 }
 
+// UpdateHitPoints writes back the character's current and temporary hit
+// points and whether they have been stabilized
+func (r *SQLCCharacterRepository) UpdateHitPoints(ctx context.Context, character *models.Character) error {
+	err := r.q.UpdateCharacterHitPoints(ctx, sqlcdb.UpdateCharacterHitPointsParams{
+		CurrentHitPoints:   int64(character.CurrentHitPoints),
+		TemporaryHitPoints: int64(character.TemporaryHitPoints),
+		Stabilized:         character.Stabilized,
+		ID:                 character.ID,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+// RetireCharacter takes the character out of play; retiring a character
+// twice keeps the original date
+func (r *SQLCCharacterRepository) RetireCharacter(ctx context.Context, id int64) error {
+	if err := r.q.RetireCharacter(ctx, id); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
 func (r *SQLCCharacterRepository) DeleteCharacter(ctx context.Context, id int64) error {
 	_, err := r.q.DeleteCharacter(ctx, id)
 	if err != nil {
@@ -148,6 +201,7 @@ func mapDbCharacterToModel(character sqlcdb.Character) *models.Character {
 		UserID:             character.UserID,
 		Name:               character.Name,
 		Class:              character.Class,
+		Kindred:            character.Kindred,
 		Level:              int(character.Level),
 		Strength:           int(character.Strength),
 		Dexterity:          int(character.Dexterity),
@@ -159,6 +213,9 @@ func mapDbCharacterToModel(character sqlcdb.Character) *models.Character {
 		CurrentHitPoints:   int(character.CurrentHitPoints),
 		TemporaryHitPoints: int(character.TemporaryHitPoints),
 		ExperiencePoints:   int(character.ExperiencePoints),
+		Vitality:           models.VitalityFor(int(character.CurrentHitPoints), character.Stabilized),
+		Stabilized:         character.Stabilized,
+		RetiredAt:          timePtrFromNullTime(character.RetiredAt),
 		CreatedAt:          character.CreatedAt,
 		UpdatedAt:          character.UpdatedAt,
 	}
@@ -171,6 +228,7 @@ func mapDbCharacterRowToModel(character sqlcdb.GetCharacterRow) *models.Characte
 		UserID:             character.UserID,
 		Name:               character.Name,
 		Class:              character.Class,
+		Kindred:            character.Kindred,
 		Level:              int(character.Level),
 		Strength:           int(character.Strength),
 		Dexterity:          int(character.Dexterity),
@@ -182,6 +240,9 @@ func mapDbCharacterRowToModel(character sqlcdb.GetCharacterRow) *models.Characte
 		CurrentHitPoints:   int(character.CurrentHitPoints),
 		TemporaryHitPoints: int(character.TemporaryHitPoints),
 		ExperiencePoints:   int(character.ExperiencePoints),
+		Vitality:           models.VitalityFor(int(character.CurrentHitPoints), character.Stabilized),
+		Stabilized:         character.Stabilized,
+		RetiredAt:          timePtrFromNullTime(character.RetiredAt),
 		CreatedAt:          character.CreatedAt,
 		UpdatedAt:          character.UpdatedAt,
 	}
@@ -194,6 +255,7 @@ func mapDbCharactersByUserRowToModel(character sqlcdb.GetCharactersByUserRow) *m
 		UserID:             character.UserID,
 		Name:               character.Name,
 		Class:              character.Class,
+		Kindred:            character.Kindred,
 		Level:              int(character.Level),
 		Strength:           int(character.Strength),
 		Dexterity:          int(character.Dexterity),
@@ -205,6 +267,9 @@ func mapDbCharactersByUserRowToModel(character sqlcdb.GetCharactersByUserRow) *m
 		CurrentHitPoints:   int(character.CurrentHitPoints),
 		TemporaryHitPoints: int(character.TemporaryHitPoints),
 		ExperiencePoints:   int(character.ExperiencePoints),
+		Vitality:           models.VitalityFor(int(character.CurrentHitPoints), character.Stabilized),
+		Stabilized:         character.Stabilized,
+		RetiredAt:          timePtrFromNullTime(character.RetiredAt),
 		CreatedAt:          character.CreatedAt,
 		UpdatedAt:          character.UpdatedAt,
 	}
@@ -217,6 +282,7 @@ func mapDbListCharactersRowToModel(character sqlcdb.ListCharactersRow) *models.C
 		UserID:             character.UserID,
 		Name:               character.Name,
 		Class:              character.Class,
+		Kindred:            character.Kindred,
 		Level:              int(character.Level),
 		Strength:           int(character.Strength),
 		Dexterity:          int(character.Dexterity),
@@ -228,7 +294,17 @@ func mapDbListCharactersRowToModel(character sqlcdb.ListCharactersRow) *models.C
 		CurrentHitPoints:   int(character.CurrentHitPoints),
 		TemporaryHitPoints: int(character.TemporaryHitPoints),
 		ExperiencePoints:   int(character.ExperiencePoints),
+		Vitality:           models.VitalityFor(int(character.CurrentHitPoints), character.Stabilized),
+		Stabilized:         character.Stabilized,
+		RetiredAt:          timePtrFromNullTime(character.RetiredAt),
 		CreatedAt:          character.CreatedAt,
 		UpdatedAt:          character.UpdatedAt,
 	}
 }
+
+func timePtrFromNullTime(value sql.NullTime) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Time
+}
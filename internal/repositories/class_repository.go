@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	apperrors "mordezzanV4/internal/errors"
@@ -17,41 +18,14 @@ type ClassRepository interface {
 	GetCharacterClassInfo(ctx context.Context, characterID int64) (*models.Character, error)
 	GetClassAbilities(ctx context.Context, className string) ([]*models.ClassAbility, error)
 	GetClassAbilitiesByLevel(ctx context.Context, className string, level int) ([]*models.ClassAbility, error)
-	GetClericTurningAbility(ctx context.Context, level int) (int, error)
-	GetPaladinTurningAbility(ctx context.Context, level int) (int, error)
-	GetNecromancerTurningAbility(ctx context.Context, level int) (int, error)
-	GetMonkACBonus(ctx context.Context, level int) (int, error)
-	GetMonkEmptyHandDamage(ctx context.Context, level int) (string, error)
-	GetBerserkerNaturalAC(ctx context.Context, level int) (int, error)
-	GetSpecialClassSpellSlots(ctx context.Context, className string, level int) (map[string]int, error)
-	GetRunegraverRunesPerDay(ctx context.Context, level int) (map[string]int, error)
-
-	// New methods for each class's abilities
-	GetBarbarianAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetBerserkerAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetBardAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetCataphractAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetClericAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetCryomancerAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetDruidAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetFighterAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetHuntsmanAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetIllusionistAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetLegerdemainistAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetMagicianAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetMonkAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetNecromancerAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetPaladinAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetPriestAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetPurloinerAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetPyromancerAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetRangerAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetRunegraverAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetScoutAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetShamanAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetThiefAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetWarlockAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
-	GetWitchAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error)
+	GetClassRules(ctx context.Context, className string) (*models.ClassRules, error)
+	ListClassRules(ctx context.Context) ([]*models.ClassRules, error)
+	GetTurningAbility(ctx context.Context, className string, level int) (int, error)
+	GetMartialArts(ctx context.Context, className string, level int) (int, string, error)
+	GetBackstabMultiplier(ctx context.Context, className string, level int) (int, error)
+	GetNaturalAC(ctx context.Context, className string, level int) (int, error)
+	GetExtraSpellSlots(ctx context.Context, className string, level int) (map[string]int, error)
+	GetRunesPerDay(ctx context.Context, className string, level int) (map[string]int, error)
 }
 
 // SQLCClassRepository implements ClassRepository using SQLC
@@ -203,147 +177,127 @@ func (r *SQLCClassRepository) GetNextLevelData(ctx context.Context, className st
 	return mapDbClassDataToModel(data), nil
 }
 
-// GetClassAbilities method using the class-specific methods
+// GetClassAbilities returns every ability of a class regardless of level
 func (r *SQLCClassRepository) GetClassAbilities(ctx context.Context, className string) ([]*models.ClassAbility, error) {
-	// Just call the ByLevel version with a very high level to get all abilities
-	// This assumes that no class has abilities with an extremely high level
-	return r.GetClassAbilitiesByLevel(ctx, className, 99) // Using 99 as a high level to get all abilities
+	abilities, err := r.q.GetClassAbilities(ctx, className)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*models.ClassAbility, len(abilities))
+	for i, a := range abilities {
+		result[i] = &models.ClassAbility{
+			ID:          a.ID,
+			Name:        a.Name,
+			Description: a.Description,
+			MinLevel:    int(a.MinLevel),
+		}
+	}
+	return result, nil
 }
 
-// Updated GetClassAbilitiesByLevel method to use the class-specific methods
+// GetClassAbilitiesByLevel returns the abilities a class has gained by the given level
 func (r *SQLCClassRepository) GetClassAbilitiesByLevel(ctx context.Context, className string, level int) ([]*models.ClassAbility, error) {
-	// Use the class-specific abilities methods instead of the generic one
-	switch className {
-	case "Barbarian":
-		return r.GetBarbarianAbilities(ctx, level)
-	case "Berserker":
-		return r.GetBerserkerAbilities(ctx, level)
-	case "Bard":
-		return r.GetBardAbilities(ctx, level)
-	case "Cataphract":
-		return r.GetCataphractAbilities(ctx, level)
-	case "Cleric":
-		return r.GetClericAbilities(ctx, level)
-	case "Cryomancer":
-		return r.GetCryomancerAbilities(ctx, level)
-	case "Druid":
-		return r.GetDruidAbilities(ctx, level)
-	case "Fighter":
-		return r.GetFighterAbilities(ctx, level)
-	case "Huntsman":
-		return r.GetHuntsmanAbilities(ctx, level)
-	case "Illusionist":
-		return r.GetIllusionistAbilities(ctx, level)
-	case "Legerdemainist":
-		return r.GetLegerdemainistAbilities(ctx, level)
-	case "Magician":
-		return r.GetMagicianAbilities(ctx, level)
-	case "Monk":
-		return r.GetMonkAbilities(ctx, level)
-	case "Necromancer":
-		return r.GetNecromancerAbilities(ctx, level)
-	case "Paladin":
-		return r.GetPaladinAbilities(ctx, level)
-	case "Priest":
-		return r.GetPriestAbilities(ctx, level)
-	case "Purloiner":
-		return r.GetPurloinerAbilities(ctx, level)
-	case "Pyromancer":
-		return r.GetPyromancerAbilities(ctx, level)
-	case "Ranger":
-		return r.GetRangerAbilities(ctx, level)
-	case "Runegraver":
-		return r.GetRunegraverAbilities(ctx, level)
-	case "Scout":
-		return r.GetScoutAbilities(ctx, level)
-	case "Shaman":
-		return r.GetShamanAbilities(ctx, level)
-	case "Thief":
-		return r.GetThiefAbilities(ctx, level)
-	case "Warlock":
-		return r.GetWarlockAbilities(ctx, level)
-	case "Witch":
-		return r.GetWitchAbilities(ctx, level)
-	default:
-		// Fallback to the old method (keeping it for backward compatibility)
-		abilities, err := r.q.GetClassAbilitiesByLevel(ctx, sqlcdb.GetClassAbilitiesByLevelParams{
-			ClassName: className,
-			MinLevel:  int64(level),
-		})
-		if err != nil {
-			return nil, err
-		}
-		result := make([]*models.ClassAbility, len(abilities))
-		for i, a := range abilities {
-			result[i] = &models.ClassAbility{
-				ID:          a.ID,
-				Name:        a.Name,
-				Description: a.Description,
-				MinLevel:    int(a.MinLevel),
-			}
+	abilities, err := r.q.GetClassAbilitiesByLevel(ctx, sqlcdb.GetClassAbilitiesByLevelParams{
+		ClassName: className,
+		MinLevel:  int64(level),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*models.ClassAbility, len(abilities))
+	for i, a := range abilities {
+		result[i] = &models.ClassAbility{
+			ID:          a.ID,
+			Name:        a.Name,
+			Description: a.Description,
+			MinLevel:    int(a.MinLevel),
 		}
-		return result, nil
 	}
+	return result, nil
 }
 
-func (r *SQLCClassRepository) GetClericTurningAbility(ctx context.Context, level int) (int, error) {
-	ability, err := r.q.GetClericTurningAbility(ctx, int64(level))
+// GetClassRules returns the declarative rules for a class
+func (r *SQLCClassRepository) GetClassRules(ctx context.Context, className string) (*models.ClassRules, error) {
+	rules, err := r.q.GetClassRules(ctx, className)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return 0, nil // Return 0 if no turning ability found
+			return nil, apperrors.NewNotFound("class rules", className)
 		}
-		return 0, err
+		return nil, apperrors.NewDatabaseError(err)
 	}
-	return int(ability), nil
+	return mapDbClassRulesToModel(rules)
 }
 
-func (r *SQLCClassRepository) GetPaladinTurningAbility(ctx context.Context, level int) (int, error) {
-	ability, err := r.q.GetPaladinTurningAbility(ctx, int64(level))
+func (r *SQLCClassRepository) ListClassRules(ctx context.Context) ([]*models.ClassRules, error) {
+	rules, err := r.q.ListClassRules(ctx)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return 0, nil // Return 0 if no turning ability found
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := make([]*models.ClassRules, len(rules))
+	for i, rule := range rules {
+		result[i], err = mapDbClassRulesToModel(rule)
+		if err != nil {
+			return nil, err
 		}
-		return 0, err
 	}
-	return int(ability), nil
+	return result, nil
 }
 
-func (r *SQLCClassRepository) GetNecromancerTurningAbility(ctx context.Context, level int) (int, error) {
-	ability, err := r.q.GetNecromancerTurningAbility(ctx, int64(level))
+// GetTurningAbility returns the class's turning ability at a level, or 0 if it cannot turn undead
+func (r *SQLCClassRepository) GetTurningAbility(ctx context.Context, className string, level int) (int, error) {
+	ability, err := r.q.GetClassTurningAbility(ctx, sqlcdb.GetClassTurningAbilityParams{
+		ClassName: className,
+		Level:     int64(level),
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return 0, nil // Return 0 if no turning ability found
+			return 0, nil
 		}
 		return 0, err
 	}
 	return int(ability), nil
 }
 
-func (r *SQLCClassRepository) GetMonkACBonus(ctx context.Context, level int) (int, error) {
-	bonus, err := r.q.GetMonkACBonus(ctx, int64(level))
+// GetMartialArts returns the AC bonus and empty hand damage of a class with
+// the martial_arts hook at a level
+func (r *SQLCClassRepository) GetMartialArts(ctx context.Context, className string, level int) (int, string, error) {
+	row, err := r.q.GetClassMartialArts(ctx, sqlcdb.GetClassMartialArtsParams{
+		ClassName: className,
+		Level:     int64(level),
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return 0, nil
+			return 0, "1d4", nil // Default damage
 		}
-		return 0, err
+		return 0, "", err
 	}
-	return int(bonus), nil
+	return int(row.AcBonus), row.EmptyHandDamage, nil
 }
 
-func (r *SQLCClassRepository) GetMonkEmptyHandDamage(ctx context.Context, level int) (string, error) {
-	damage, err := r.q.GetMonkEmptyHandDamage(ctx, int64(level))
+// GetBackstabMultiplier returns how many times a backstab by the class at a
+// level rolls the weapon's damage dice, or 0 if the class cannot backstab
+func (r *SQLCClassRepository) GetBackstabMultiplier(ctx context.Context, className string, level int) (int, error) {
+	multiplier, err := r.q.GetClassBackstabMultiplier(ctx, sqlcdb.GetClassBackstabMultiplierParams{
+		ClassName: className,
+		MinLevel:  int64(level),
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return "1d4", nil // Default damage
+			return 0, nil
 		}
-		return "", err
+		return 0, err
 	}
-	return damage, nil
+	return int(multiplier), nil
 }
 
-func (r *SQLCClassRepository) GetBerserkerNaturalAC(ctx context.Context, level int) (int, error) {
-	ac, err := r.q.GetBerserkerNaturalAC(ctx, sqlcdb.GetBerserkerNaturalACParams{
-		ClassName: "Berserker",
+// GetNaturalAC returns the natural AC of a class with the natural_ac hook at
+// a level, or 0 if it has none
+func (r *SQLCClassRepository) GetNaturalAC(ctx context.Context, className string, level int) (int, error) {
+	ac, err := r.q.GetClassNaturalAC(ctx, sqlcdb.GetClassNaturalACParams{
+		ClassName: className,
 		Level:     int64(level),
 	})
 	if err != nil {
@@ -355,45 +309,31 @@ func (r *SQLCClassRepository) GetBerserkerNaturalAC(ctx context.Context, level i
 	return int(ac), nil
 }
 
-func (r *SQLCClassRepository) GetSpecialClassSpellSlots(ctx context.Context, className string, level int) (map[string]int, error) {
-	switch className {
-	case "Ranger":
-		return r.getRangerSpellSlots(ctx, level)
-	case "Shaman":
-		return r.getShamanSpellSlots(ctx, level)
-	case "Bard":
-		return r.getBardSpellSlots(ctx, level)
-	default:
-		return nil, nil
+// GetExtraSpellSlots returns spell slots a class gains outside its class_data row,
+// keyed by spell list and level (e.g. "druid_level1")
+func (r *SQLCClassRepository) GetExtraSpellSlots(ctx context.Context, className string, level int) (map[string]int, error) {
+	slots, err := r.q.GetClassExtraSpellSlots(ctx, sqlcdb.GetClassExtraSpellSlotsParams{
+		ClassName: className,
+		Level:     int64(level),
+	})
+	if err != nil {
+		return nil, err
 	}
-}
 
-func (r *SQLCClassRepository) getRangerSpellSlots(ctx context.Context, level int) (map[string]int, error) {
-	// Rangers use both druid and magician spells, implement this based on your game rules
-	// This is a placeholder implementation
-	if level < 7 {
-		return map[string]int{}, nil // No spells before level 7
+	result := make(map[string]int, len(slots))
+	for _, slot := range slots {
+		result[slot.SlotKey] = int(slot.Slots)
 	}
-
-	// Implement ranger spell slot logic here
-	return map[string]int{
-		"druid_level1":    1,
-		"magician_level1": 1,
-	}, nil
-}
-
-func (r *SQLCClassRepository) getShamanSpellSlots(ctx context.Context, level int) (map[string]int, error) {
-	// Implement based on shaman_divine_spells and shaman_arcane_spells tables
-	return map[string]int{}, nil
-}
-
-func (r *SQLCClassRepository) getBardSpellSlots(ctx context.Context, level int) (map[string]int, error) {
-	// Implement based on bard_druid_spells and bard_illusionist_spells tables
-	return map[string]int{}, nil
+	return result, nil
 }
 
-func (r *SQLCClassRepository) GetRunegraverRunesPerDay(ctx context.Context, level int) (map[string]int, error) {
-	runes, err := r.q.GetRunesPerDay(ctx, int64(level))
+// GetRunesPerDay returns how many runes of each level a class with the
+// runes_per_day hook may use each day at a level
+func (r *SQLCClassRepository) GetRunesPerDay(ctx context.Context, className string, level int) (map[string]int, error) {
+	runes, err := r.q.GetClassRunesPerDay(ctx, sqlcdb.GetClassRunesPerDayParams{
+		ClassName: className,
+		Level:     int64(level),
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return map[string]int{}, nil
@@ -453,6 +393,33 @@ func mapDbClassDataToModel(data sqlcdb.ClassDatum) *models.ClassData {
 	}
 }
 
+func mapDbClassRulesToModel(rules sqlcdb.ClassRule) (*models.ClassRules, error) {
+	result := &models.ClassRules{
+		ClassName:               rules.ClassName,
+		DeathSaveBonus:          int(rules.DeathSaveBonus),
+		TransformationSaveBonus: int(rules.TransformationSaveBonus),
+		DeviceSaveBonus:         int(rules.DeviceSaveBonus),
+		SorcerySaveBonus:        int(rules.SorcerySaveBonus),
+		AvoidanceSaveBonus:      int(rules.AvoidanceSaveBonus),
+		MaxArmor:                rules.MaxArmor,
+		ShieldsAllowed:          rules.ShieldsAllowed,
+		CasterType:              rules.CasterType,
+		CastingClass:            rules.CastingClass,
+		CastingAttribute:        rules.CastingAttribute,
+	}
+	if rules.SurpriseChance.Valid {
+		chance := int(rules.SurpriseChance.Int64)
+		result.SurpriseChance = &chance
+	}
+	if err := json.Unmarshal([]byte(rules.Hooks), &result.Hooks); err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	if err := json.Unmarshal([]byte(rules.PrimeAttributes), &result.PrimeAttributes); err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	return result, nil
+}
+
 // Helper Functions
 func getNullInt64Value(n sql.NullInt64) int64 {
 	if n.Valid {
@@ -473,453 +440,3 @@ func getLevelRangeForLevel(level int) string {
 	}
 	return "1-12" // Fallback
 }
-
-// GetBarbarianAbilities gets all barbarian abilities for the specified level
-func (r *SQLCClassRepository) GetBarbarianAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetBarbarianAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetBerserkerAbilities gets all berserker abilities for the specified level
-func (r *SQLCClassRepository) GetBerserkerAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetBerserkerAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetBardAbilities gets all bard abilities for the specified level
-func (r *SQLCClassRepository) GetBardAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetBardAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetCataphractAbilities gets all cataphract abilities for the specified level
-func (r *SQLCClassRepository) GetCataphractAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetCataphractAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetClericAbilities gets all cleric abilities for the specified level
-func (r *SQLCClassRepository) GetClericAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetClericAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetCryomancerAbilities gets all cryomancer abilities for the specified level
-func (r *SQLCClassRepository) GetCryomancerAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetCryomancerAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetDruidAbilities gets all druid abilities for the specified level
-func (r *SQLCClassRepository) GetDruidAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetDruidAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetFighterAbilities gets all fighter abilities for the specified level
-func (r *SQLCClassRepository) GetFighterAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetFighterAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetHuntsmanAbilities gets all huntsman abilities for the specified level
-func (r *SQLCClassRepository) GetHuntsmanAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetHuntsmanAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetIllusionistAbilities gets all illusionist abilities for the specified level
-func (r *SQLCClassRepository) GetIllusionistAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetIllusionistAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetLegerdemainistAbilities gets all legerdemainist abilities for the specified level
-func (r *SQLCClassRepository) GetLegerdemainistAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetLegerdemainistAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetMagicianAbilities gets all magician abilities for the specified level
-func (r *SQLCClassRepository) GetMagicianAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetMagicianAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetMonkAbilities gets all monk abilities for the specified level
-func (r *SQLCClassRepository) GetMonkAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetMonkAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetNecromancerAbilities gets all necromancer abilities for the specified level
-func (r *SQLCClassRepository) GetNecromancerAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetNecromancerAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetPaladinAbilities gets all paladin abilities for the specified level
-func (r *SQLCClassRepository) GetPaladinAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetPaladinAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetPriestAbilities gets all priest abilities for the specified level
-func (r *SQLCClassRepository) GetPriestAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetPriestAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetPurloinerAbilities gets all purloiner abilities for the specified level
-func (r *SQLCClassRepository) GetPurloinerAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetPurloinerAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetPyromancerAbilities gets all pyromancer abilities for the specified level
-func (r *SQLCClassRepository) GetPyromancerAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetPyromancerAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetRangerAbilities gets all ranger abilities for the specified level
-func (r *SQLCClassRepository) GetRangerAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetRangerAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetRunegraverAbilities gets all runegraver abilities for the specified level
-func (r *SQLCClassRepository) GetRunegraverAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetRunegraverAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetScoutAbilities gets all scout abilities for the specified level
-func (r *SQLCClassRepository) GetScoutAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetScoutAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetShamanAbilities gets all shaman abilities for the specified level
-func (r *SQLCClassRepository) GetShamanAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetShamanAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetThiefAbilities gets all thief abilities for the specified level
-func (r *SQLCClassRepository) GetThiefAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetThiefAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetWarlockAbilities gets all warlock abilities for the specified level
-func (r *SQLCClassRepository) GetWarlockAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetWarlockAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
-
-// GetWitchAbilities gets all witch abilities for the specified level
-func (r *SQLCClassRepository) GetWitchAbilities(ctx context.Context, level int) ([]*models.ClassAbility, error) {
-	abilities, err := r.q.GetWitchAbilities(ctx, int64(level))
-	if err != nil {
-		return nil, err
-	}
-	result := make([]*models.ClassAbility, len(abilities))
-	for i, a := range abilities {
-		result[i] = &models.ClassAbility{
-			ID:          a.ID,
-			Name:        a.Name,
-			Description: a.Description,
-			MinLevel:    int(a.MinLevel),
-		}
-	}
-	return result, nil
-}
@@ -0,0 +1,250 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	sqlcdb "mordezzanV4/internal/repositories/db/sqlc"
+)
+
+type ConditionRepository interface {
+	GetCondition(ctx context.Context, id int64) (*models.Condition, error)
+	GetConditionByName(ctx context.Context, name string) (*models.Condition, error)
+	ListConditions(ctx context.Context) ([]*models.Condition, error)
+	CreateCondition(ctx context.Context, input *models.CreateConditionInput) (int64, error)
+	UpdateCondition(ctx context.Context, id int64, input *models.UpdateConditionInput) error
+	DeleteCondition(ctx context.Context, id int64) error
+
+	GetCharacterCondition(ctx context.Context, characterID, id int64) (*models.CharacterCondition, error)
+	GetCharacterConditions(ctx context.Context, characterID int64) ([]*models.CharacterCondition, error)
+	// AddCharacterCondition puts a condition on the character for the given
+	// number of rounds, or until removed when rounds is zero
+	AddCharacterCondition(ctx context.Context, characterID, conditionID int64, source string, rounds int) (int64, error)
+	RemoveCharacterCondition(ctx context.Context, characterID, id int64) error
+	// AdvanceConditions counts rounds off each of the character's timed
+	// conditions and removes those that run out
+	AdvanceConditions(ctx context.Context, characterID int64, rounds int) error
+}
+
+type SQLCConditionRepository struct {
+	db *sql.DB
+	q  *sqlcdb.Queries
+}
+
+func NewSQLCConditionRepository(db *sql.DB) *SQLCConditionRepository {
+	return &SQLCConditionRepository{
+		db: db,
+		q:  sqlcdb.New(db),
+	}
+}
+
+func (r *SQLCConditionRepository) GetCondition(ctx context.Context, id int64) (*models.Condition, error) {
+	condition, err := r.q.GetCondition(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("condition", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	return mapDbConditionToModel(condition), nil
+}
+
+func (r *SQLCConditionRepository) GetConditionByName(ctx context.Context, name string) (*models.Condition, error) {
+	condition, err := r.q.GetConditionByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("condition", name)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	return mapDbConditionToModel(condition), nil
+}
+
+func (r *SQLCConditionRepository) ListConditions(ctx context.Context) ([]*models.Condition, error) {
+	conditions, err := r.q.ListConditions(ctx)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	result := make([]*models.Condition, len(conditions))
+	for i, condition := range conditions {
+		result[i] = mapDbConditionToModel(condition)
+	}
+	return result, nil
+}
+
+func (r *SQLCConditionRepository) CreateCondition(ctx context.Context, input *models.CreateConditionInput) (int64, error) {
+	result, err := r.q.CreateCondition(ctx, sqlcdb.CreateConditionParams{
+		Name:             input.Name,
+		Description:      input.Description,
+		ToHitModifier:    int64(input.ToHitModifier),
+		AcBonus:          int64(input.ACBonus),
+		SaveModifier:     int64(input.SaveModifier),
+		MovementModifier: int64(input.MovementModifier),
+		CheckModifier:    int64(input.CheckModifier),
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return id, nil
+}
+
+func (r *SQLCConditionRepository) UpdateCondition(ctx context.Context, id int64, input *models.UpdateConditionInput) error {
+	_, err := r.GetCondition(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.q.UpdateCondition(ctx, sqlcdb.UpdateConditionParams{
+		Name:             input.Name,
+		Description:      input.Description,
+		ToHitModifier:    int64(input.ToHitModifier),
+		AcBonus:          int64(input.ACBonus),
+		SaveModifier:     int64(input.SaveModifier),
+		MovementModifier: int64(input.MovementModifier),
+		CheckModifier:    int64(input.CheckModifier),
+		ID:               id,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *SQLCConditionRepository) DeleteCondition(ctx context.Context, id int64) error {
+	_, err := r.GetCondition(ctx, id)
+	if err != nil {
+		return err
+	}
+	_, err = r.q.DeleteCondition(ctx, id)
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *SQLCConditionRepository) GetCharacterCondition(ctx context.Context, characterID, id int64) (*models.CharacterCondition, error) {
+	row, err := r.q.GetCharacterCondition(ctx, sqlcdb.GetCharacterConditionParams{
+		ID:          id,
+		CharacterID: characterID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("character condition", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	condition := mapDbCharacterConditionToModel(sqlcdb.GetCharacterConditionsRow(row))
+	return &condition, nil
+}
+
+func (r *SQLCConditionRepository) GetCharacterConditions(ctx context.Context, characterID int64) ([]*models.CharacterCondition, error) {
+	rows, err := r.q.GetCharacterConditions(ctx, characterID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	result := make([]*models.CharacterCondition, len(rows))
+	for i, row := range rows {
+		condition := mapDbCharacterConditionToModel(row)
+		result[i] = &condition
+	}
+	return result, nil
+}
+
+func (r *SQLCConditionRepository) AddCharacterCondition(ctx context.Context, characterID, conditionID int64, source string, rounds int) (int64, error) {
+	duration := sql.NullInt64{Int64: int64(rounds), Valid: rounds > 0}
+	result, err := r.q.AddCharacterCondition(ctx, sqlcdb.AddCharacterConditionParams{
+		CharacterID:     characterID,
+		ConditionID:     conditionID,
+		Source:          source,
+		DurationRounds:  duration,
+		RemainingRounds: duration,
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return id, nil
+}
+
+func (r *SQLCConditionRepository) RemoveCharacterCondition(ctx context.Context, characterID, id int64) error {
+	if _, err := r.GetCharacterCondition(ctx, characterID, id); err != nil {
+		return err
+	}
+	err := r.q.DeleteCharacterCondition(ctx, sqlcdb.DeleteCharacterConditionParams{
+		ID:          id,
+		CharacterID: characterID,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *SQLCConditionRepository) AdvanceConditions(ctx context.Context, characterID int64, rounds int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	err = qtx.AdvanceCharacterConditions(ctx, sqlcdb.AdvanceCharacterConditionsParams{
+		RemainingRounds: sql.NullInt64{Int64: int64(rounds), Valid: true},
+		CharacterID:     characterID,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	if err := qtx.DeleteExpiredCharacterConditions(ctx, characterID); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func mapDbConditionToModel(condition sqlcdb.Condition) *models.Condition {
+	return &models.Condition{
+		ID:               condition.ID,
+		Name:             condition.Name,
+		Description:      condition.Description,
+		ToHitModifier:    int(condition.ToHitModifier),
+		ACBonus:          int(condition.AcBonus),
+		SaveModifier:     int(condition.SaveModifier),
+		MovementModifier: int(condition.MovementModifier),
+		CheckModifier:    int(condition.CheckModifier),
+		CreatedAt:        condition.CreatedAt,
+		UpdatedAt:        condition.UpdatedAt,
+	}
+}
+
+func mapDbCharacterConditionToModel(row sqlcdb.GetCharacterConditionsRow) models.CharacterCondition {
+	return models.CharacterCondition{
+		ID:               row.ID,
+		CharacterID:      row.CharacterID,
+		ConditionID:      row.ConditionID,
+		Name:             row.Name,
+		ToHitModifier:    int(row.ToHitModifier),
+		ACBonus:          int(row.AcBonus),
+		SaveModifier:     int(row.SaveModifier),
+		MovementModifier: int(row.MovementModifier),
+		CheckModifier:    int(row.CheckModifier),
+		Source:           row.Source,
+		DurationRounds:   intPtrFromNullInt64(row.DurationRounds),
+		RemainingRounds:  intPtrFromNullInt64(row.RemainingRounds),
+		CreatedAt:        row.CreatedAt,
+	}
+}
@@ -0,0 +1,496 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	sqlcdb "mordezzanV4/internal/repositories/db/sqlc"
+)
+
+type ContentPackRepository interface {
+	GetContentPack(ctx context.Context, id int64) (*models.InstalledContentPack, error)
+	GetContentPackByNamespace(ctx context.Context, namespace string) (*models.InstalledContentPack, error)
+	ListContentPacks(ctx context.Context) ([]*models.InstalledContentPack, error)
+	InstallContentPack(ctx context.Context, pack *models.ContentPack, installedBy int64) (int64, error)
+	UninstallContentPack(ctx context.Context, id int64) error
+	CountEntryReferences(ctx context.Context, entry models.ContentPackEntry) (int64, error)
+}
+
+type SQLCContentPackRepository struct {
+	db *sql.DB
+	q  *sqlcdb.Queries
+}
+
+func NewSQLCContentPackRepository(db *sql.DB) *SQLCContentPackRepository {
+	return &SQLCContentPackRepository{
+		db: db,
+		q:  sqlcdb.New(db),
+	}
+}
+
+func (r *SQLCContentPackRepository) GetContentPack(ctx context.Context, id int64) (*models.InstalledContentPack, error) {
+	pack, err := r.q.GetContentPack(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("content pack", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	return r.withEntries(ctx, pack)
+}
+
+func (r *SQLCContentPackRepository) GetContentPackByNamespace(ctx context.Context, namespace string) (*models.InstalledContentPack, error) {
+	pack, err := r.q.GetContentPackByNamespace(ctx, namespace)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("content pack", namespace)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	return r.withEntries(ctx, pack)
+}
+
+func (r *SQLCContentPackRepository) ListContentPacks(ctx context.Context) ([]*models.InstalledContentPack, error) {
+	packs, err := r.q.ListContentPacks(ctx)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := make([]*models.InstalledContentPack, len(packs))
+	for i, pack := range packs {
+		result[i], err = r.withEntries(ctx, pack)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// InstallContentPack writes the pack and every entry in it under the pack's
+// namespace in a single transaction
+func (r *SQLCContentPackRepository) InstallContentPack(ctx context.Context, pack *models.ContentPack, installedBy int64) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	result, err := qtx.CreateContentPack(ctx, sqlcdb.CreateContentPackParams{
+		Namespace:   pack.Namespace,
+		Name:        pack.Name,
+		Version:     pack.Version,
+		Author:      pack.Author,
+		Description: pack.Description,
+		InstalledBy: sql.NullInt64{Int64: installedBy, Valid: installedBy != 0},
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	packID, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+
+	record := func(entryType string, entryID int64, name string) error {
+		return qtx.CreateContentPackEntry(ctx, sqlcdb.CreateContentPackEntryParams{
+			PackID:    packID,
+			EntryType: entryType,
+			EntryID:   entryID,
+			EntryName: name,
+		})
+	}
+
+	for _, class := range pack.Classes {
+		if err := installClass(ctx, qtx, pack, class, record); err != nil {
+			return 0, err
+		}
+	}
+
+	for _, spell := range pack.Spells {
+		name := pack.QualifiedName(spell.Name)
+		result, err := qtx.CreateSpell(ctx, sqlcdb.CreateSpellParams{
+			Name:         name,
+			MagLevel:     int64(spell.MagLevel),
+			CryLevel:     int64(spell.CryLevel),
+			IllLevel:     int64(spell.IllLevel),
+			NecLevel:     int64(spell.NecLevel),
+			PyrLevel:     int64(spell.PyrLevel),
+			WchLevel:     int64(spell.WchLevel),
+			ClrLevel:     int64(spell.ClrLevel),
+			DrdLevel:     int64(spell.DrdLevel),
+			Range:        spell.Range,
+			Duration:     spell.Duration,
+			AreaOfEffect: sql.NullString{String: spell.AreaOfEffect, Valid: spell.AreaOfEffect != ""},
+			Components:   sql.NullString{String: spell.Components, Valid: spell.Components != ""},
+			Description:  spell.Description,
+		})
+		if err := recordInsert(result, err, models.ContentPackEntrySpell, name, record); err != nil {
+			return 0, err
+		}
+	}
+
+	for _, weapon := range pack.Weapons {
+		name := pack.QualifiedName(weapon.Name)
+		result, err := qtx.CreateWeapon(ctx, sqlcdb.CreateWeaponParams{
+			Name:            name,
+			Category:        weapon.Category,
+			WeaponClass:     int64(weapon.WeaponClass),
+			Cost:            weapon.Cost,
+			Weight:          int64(weapon.Weight),
+			RangeShort:      nullInt64FromPtr(weapon.RangeShort),
+			RangeMedium:     nullInt64FromPtr(weapon.RangeMedium),
+			RangeLong:       nullInt64FromPtr(weapon.RangeLong),
+			RateOfFire:      sql.NullString{String: weapon.RateOfFire, Valid: weapon.RateOfFire != ""},
+			Damage:          weapon.Damage,
+			DamageTwoHanded: sql.NullString{String: weapon.DamageTwoHanded, Valid: weapon.DamageTwoHanded != ""},
+			Properties:      sql.NullString{String: weapon.Properties, Valid: weapon.Properties != ""},
+		})
+		if err := recordInsert(result, err, models.ContentPackEntryWeapon, name, record); err != nil {
+			return 0, err
+		}
+	}
+
+	for _, armor := range pack.Armors {
+		name := pack.QualifiedName(armor.Name)
+		result, err := qtx.CreateArmor(ctx, sqlcdb.CreateArmorParams{
+			Name:            name,
+			ArmorType:       armor.ArmorType,
+			Ac:              int64(armor.AC),
+			Cost:            armor.Cost,
+			DamageReduction: int64(armor.DamageReduction),
+			Weight:          int64(armor.Weight),
+			WeightClass:     armor.WeightClass,
+			MovementRate:    int64(armor.MovementRate),
+		})
+		if err := recordInsert(result, err, models.ContentPackEntryArmor, name, record); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return packID, nil
+}
+
+func installClass(ctx context.Context, qtx *sqlcdb.Queries, pack *models.ContentPack, class models.ContentPackClass,
+	record func(entryType string, entryID int64, name string) error) error {
+	className := pack.QualifiedName(class.Name)
+
+	for _, level := range class.Levels {
+		slots := make([]sql.NullInt64, 6)
+		for i, count := range level.SpellSlots {
+			slots[i] = sql.NullInt64{Int64: int64(count), Valid: true}
+		}
+		err := qtx.CreateClassLevel(ctx, sqlcdb.CreateClassLevelParams{
+			ClassName:        className,
+			Level:            int64(level.Level),
+			ExperiencePoints: int64(level.ExperiencePoints),
+			HitDice:          level.HitDice,
+			SavingThrow:      int64(level.SavingThrow),
+			FightingAbility:  int64(level.FightingAbility),
+			CastingAbility:   sql.NullInt64{Int64: int64(level.CastingAbility), Valid: true},
+			SpellSlotsLevel1: slots[0],
+			SpellSlotsLevel2: slots[1],
+			SpellSlotsLevel3: slots[2],
+			SpellSlotsLevel4: slots[3],
+			SpellSlotsLevel5: slots[4],
+			SpellSlotsLevel6: slots[5],
+		})
+		if err != nil {
+			return apperrors.NewDatabaseError(err)
+		}
+		if err := installClassHookLevel(ctx, qtx, className, class, level); err != nil {
+			return err
+		}
+	}
+
+	rules := models.DefaultClassRules(className)
+	if class.MaxArmor != "" {
+		rules.MaxArmor = class.MaxArmor
+	}
+	if class.ShieldsAllowed != nil {
+		rules.ShieldsAllowed = *class.ShieldsAllowed
+	}
+	if class.Hooks != nil {
+		rules.Hooks = class.Hooks
+	}
+	if class.PrimeAttributes != nil {
+		rules.PrimeAttributes = class.PrimeAttributes
+	}
+	hooks, err := json.Marshal(rules.Hooks)
+	if err != nil {
+		return apperrors.NewInternalError(err)
+	}
+	primeAttributes, err := json.Marshal(rules.PrimeAttributes)
+	if err != nil {
+		return apperrors.NewInternalError(err)
+	}
+	var surpriseChance sql.NullInt64
+	if class.SurpriseChance != nil {
+		surpriseChance = sql.NullInt64{Int64: int64(*class.SurpriseChance), Valid: true}
+	}
+	err = qtx.CreateClassRules(ctx, sqlcdb.CreateClassRulesParams{
+		ClassName:               className,
+		DeathSaveBonus:          int64(class.DeathSaveBonus),
+		TransformationSaveBonus: int64(class.TransformationSaveBonus),
+		DeviceSaveBonus:         int64(class.DeviceSaveBonus),
+		SorcerySaveBonus:        int64(class.SorcerySaveBonus),
+		AvoidanceSaveBonus:      int64(class.AvoidanceSaveBonus),
+		SurpriseChance:          surpriseChance,
+		MaxArmor:                rules.MaxArmor,
+		ShieldsAllowed:          rules.ShieldsAllowed,
+		Hooks:                   string(hooks),
+		PrimeAttributes:         string(primeAttributes),
+		CasterType:              class.CasterType,
+		CastingClass:            class.CastingClass,
+		CastingAttribute:        class.CastingAttribute,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	for _, backstab := range class.BackstabMultipliers {
+		err := qtx.CreateClassBackstabMultiplier(ctx, sqlcdb.CreateClassBackstabMultiplierParams{
+			ClassName:  className,
+			MinLevel:   int64(backstab.MinLevel),
+			Multiplier: int64(backstab.Multiplier),
+		})
+		if err != nil {
+			return apperrors.NewDatabaseError(err)
+		}
+	}
+	if err := record(models.ContentPackEntryClass, 0, className); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+
+	for _, ability := range class.Abilities {
+		name := pack.QualifiedName(ability.Name)
+		result, err := qtx.CreateAbility(ctx, sqlcdb.CreateAbilityParams{
+			Name:        name,
+			Description: ability.Description,
+		})
+		if err != nil {
+			return apperrors.NewDatabaseError(err)
+		}
+		abilityID, err := result.LastInsertId()
+		if err != nil {
+			return apperrors.NewDatabaseError(err)
+		}
+		err = qtx.CreateClassAbilityMapping(ctx, sqlcdb.CreateClassAbilityMappingParams{
+			ClassName: className,
+			AbilityID: abilityID,
+			MinLevel:  int64(ability.MinLevel),
+		})
+		if err != nil {
+			return apperrors.NewDatabaseError(err)
+		}
+		if err := record(models.ContentPackEntryAbility, abilityID, name); err != nil {
+			return apperrors.NewDatabaseError(err)
+		}
+	}
+	return nil
+}
+
+// installClassHookLevel stores a level's natural AC, martial arts and runes
+// for the hooks the class opts into
+func installClassHookLevel(ctx context.Context, qtx *sqlcdb.Queries, className string, class models.ContentPackClass,
+	level models.ContentPackClassLevel) error {
+	rules := models.ClassRules{Hooks: class.Hooks}
+	if rules.HasHook(models.ClassHookNaturalAC) {
+		err := qtx.CreateClassNaturalAC(ctx, sqlcdb.CreateClassNaturalACParams{
+			ClassName: className,
+			Level:     int64(level.Level),
+			NaturalAc: int64(level.NaturalAC),
+		})
+		if err != nil {
+			return apperrors.NewDatabaseError(err)
+		}
+	}
+	if rules.HasHook(models.ClassHookMartialArts) {
+		err := qtx.CreateClassMartialArts(ctx, sqlcdb.CreateClassMartialArtsParams{
+			ClassName:       className,
+			Level:           int64(level.Level),
+			AcBonus:         int64(level.ACBonus),
+			EmptyHandDamage: level.EmptyHandDamage,
+		})
+		if err != nil {
+			return apperrors.NewDatabaseError(err)
+		}
+	}
+	if rules.HasHook(models.ClassHookRunesPerDay) {
+		runes := make([]sql.NullInt64, 6)
+		for i, count := range level.RunesPerDay {
+			runes[i] = sql.NullInt64{Int64: int64(count), Valid: true}
+		}
+		err := qtx.CreateClassRunesPerDay(ctx, sqlcdb.CreateClassRunesPerDayParams{
+			ClassName: className,
+			Level:     int64(level.Level),
+			Level1:    runes[0],
+			Level2:    runes[1],
+			Level3:    runes[2],
+			Level4:    runes[3],
+			Level5:    runes[4],
+			Level6:    runes[5],
+		})
+		if err != nil {
+			return apperrors.NewDatabaseError(err)
+		}
+	}
+	return nil
+}
+
+// recordInsert records the row created by a catalog insert as a pack entry
+func recordInsert(result sql.Result, err error, entryType, name string,
+	record func(entryType string, entryID int64, name string) error) error {
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	if err := record(entryType, id, name); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+// UninstallContentPack removes the pack and every row it installed
+func (r *SQLCContentPackRepository) UninstallContentPack(ctx context.Context, id int64) error {
+	pack, err := r.GetContentPack(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	for _, entry := range pack.Entries {
+		switch entry.Type {
+		case models.ContentPackEntryClass:
+			if err := qtx.DeleteClassAbilityMappings(ctx, entry.Name); err != nil {
+				return apperrors.NewDatabaseError(err)
+			}
+			if err := qtx.DeleteClassRules(ctx, entry.Name); err != nil {
+				return apperrors.NewDatabaseError(err)
+			}
+			if err := qtx.DeleteClassBackstabMultipliers(ctx, entry.Name); err != nil {
+				return apperrors.NewDatabaseError(err)
+			}
+			if err := qtx.DeleteClassNaturalAC(ctx, entry.Name); err != nil {
+				return apperrors.NewDatabaseError(err)
+			}
+			if err := qtx.DeleteClassMartialArts(ctx, entry.Name); err != nil {
+				return apperrors.NewDatabaseError(err)
+			}
+			if err := qtx.DeleteClassRunesPerDay(ctx, entry.Name); err != nil {
+				return apperrors.NewDatabaseError(err)
+			}
+			err = qtx.DeleteClass(ctx, entry.Name)
+		case models.ContentPackEntryAbility:
+			err = qtx.DeleteAbility(ctx, entry.ID)
+		case models.ContentPackEntrySpell:
+			_, err = qtx.DeleteSpell(ctx, entry.ID)
+		case models.ContentPackEntryWeapon:
+			_, err = qtx.DeleteWeapon(ctx, entry.ID)
+		case models.ContentPackEntryArmor:
+			_, err = qtx.DeleteArmor(ctx, entry.ID)
+		}
+		if err != nil {
+			return apperrors.NewDatabaseError(err)
+		}
+	}
+
+	if err := qtx.DeleteContentPackEntries(ctx, id); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	if _, err := qtx.DeleteContentPack(ctx, id); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+// CountEntryReferences counts the characters, inventory items and spell
+// records that still use an installed entry
+func (r *SQLCContentPackRepository) CountEntryReferences(ctx context.Context, entry models.ContentPackEntry) (int64, error) {
+	var (
+		count int64
+		err   error
+	)
+	switch entry.Type {
+	case models.ContentPackEntryClass:
+		count, err = r.q.CountCharactersByClass(ctx, entry.Name)
+	case models.ContentPackEntrySpell:
+		count, err = r.q.CountSpellReferences(ctx, entry.ID)
+	case models.ContentPackEntryWeapon, models.ContentPackEntryArmor:
+		count, err = r.q.CountInventoryItemsByItem(ctx, sqlcdb.CountInventoryItemsByItemParams{
+			ItemType: entry.Type,
+			ItemID:   entry.ID,
+		})
+	}
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return count, nil
+}
+
+func (r *SQLCContentPackRepository) withEntries(ctx context.Context, pack sqlcdb.ContentPack) (*models.InstalledContentPack, error) {
+	entries, err := r.q.ListContentPackEntries(ctx, pack.ID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := mapDbContentPackToModel(pack)
+	result.Entries = make([]models.ContentPackEntry, len(entries))
+	for i, entry := range entries {
+		result.Entries[i] = models.ContentPackEntry{
+			Type: entry.EntryType,
+			ID:   entry.EntryID,
+			Name: entry.EntryName,
+		}
+	}
+	return result, nil
+}
+
+func nullInt64FromPtr(value *int) sql.NullInt64 {
+	if value == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(*value), Valid: true}
+}
+
+func intPtrFromNullInt64(value sql.NullInt64) *int {
+	if !value.Valid {
+		return nil
+	}
+	v := int(value.Int64)
+	return &v
+}
+
+func mapDbContentPackToModel(pack sqlcdb.ContentPack) *models.InstalledContentPack {
+	return &models.InstalledContentPack{
+		ID:          pack.ID,
+		Namespace:   pack.Namespace,
+		Name:        pack.Name,
+		Version:     pack.Version,
+		Author:      pack.Author,
+		Description: pack.Description,
+		InstalledBy: pack.InstalledBy.Int64,
+		CreatedAt:   pack.CreatedAt,
+	}
+}
@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+CREATE TABLE character_grants (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    character_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    grant_type TEXT NOT NULL CHECK (grant_type IN ('share', 'gm')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    UNIQUE(character_id, user_id)
+);
+
+CREATE INDEX idx_character_grants_user_id ON character_grants(user_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP INDEX IF EXISTS idx_character_grants_user_id;
+DROP TABLE character_grants;
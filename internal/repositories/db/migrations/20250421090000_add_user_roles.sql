@@ -0,0 +1,13 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+ALTER TABLE users ADD COLUMN role TEXT NOT NULL DEFAULT 'player' CHECK (role IN ('player', 'game_master', 'admin'));
+
+-- The first registered account administers the site
+UPDATE users SET role = 'admin' WHERE id = (SELECT MIN(id) FROM users);
+
+CREATE INDEX idx_users_role ON users(role);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP INDEX IF EXISTS idx_users_role;
+ALTER TABLE users DROP COLUMN role;
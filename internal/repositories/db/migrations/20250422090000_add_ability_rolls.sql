@@ -0,0 +1,26 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+CREATE TABLE ability_rolls (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    method TEXT NOT NULL CHECK (method IN ('3d6_in_order', '4d6_drop_lowest', '3d6_best_of_twelve', 'point_buy')),
+    scores TEXT NOT NULL,
+    rolls TEXT NOT NULL,
+    seed INTEGER NOT NULL,
+    nonce TEXT NOT NULL UNIQUE,
+    signature TEXT NOT NULL,
+    character_id INTEGER,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_ability_rolls_user_id ON ability_rolls(user_id);
+CREATE INDEX idx_ability_rolls_character_id ON ability_rolls(character_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP INDEX IF EXISTS idx_ability_rolls_character_id;
+DROP INDEX IF EXISTS idx_ability_rolls_user_id;
+DROP TABLE ability_rolls;
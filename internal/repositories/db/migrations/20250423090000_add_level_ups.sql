@@ -0,0 +1,24 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+CREATE TABLE level_ups (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    character_id INTEGER NOT NULL,
+    from_level INTEGER NOT NULL,
+    to_level INTEGER NOT NULL,
+    hit_dice TEXT NOT NULL,
+    hp_roll TEXT,
+    seed INTEGER NOT NULL DEFAULT 0,
+    hp_modifier INTEGER NOT NULL DEFAULT 0,
+    hp_gained INTEGER NOT NULL,
+    fixed_hp BOOLEAN NOT NULL DEFAULT 0,
+    details TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_level_ups_character_id ON level_ups(character_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP INDEX IF EXISTS idx_level_ups_character_id;
+DROP TABLE level_ups;
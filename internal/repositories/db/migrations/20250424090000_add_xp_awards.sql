@@ -0,0 +1,34 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+CREATE TABLE xp_awards (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    character_id INTEGER NOT NULL,
+    amount INTEGER NOT NULL,
+    bonus_percent INTEGER NOT NULL DEFAULT 0,
+    bonus_amount INTEGER NOT NULL DEFAULT 0,
+    total INTEGER NOT NULL,
+    source TEXT NOT NULL,
+    session TEXT NOT NULL DEFAULT '',
+    notes TEXT NOT NULL DEFAULT '',
+    awarded_by INTEGER,
+    reverses_award_id INTEGER,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE,
+    FOREIGN KEY (awarded_by) REFERENCES users(id) ON DELETE SET NULL,
+    FOREIGN KEY (reverses_award_id) REFERENCES xp_awards(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_xp_awards_character_id ON xp_awards(character_id);
+CREATE UNIQUE INDEX idx_xp_awards_reverses_award_id ON xp_awards(reverses_award_id);
+
+-- Existing experience becomes the opening balance of each character's ledger
+INSERT INTO xp_awards (character_id, amount, total, source)
+SELECT id, experience_points, experience_points, 'Opening balance'
+FROM characters
+WHERE experience_points > 0;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP INDEX IF EXISTS idx_xp_awards_reverses_award_id;
+DROP INDEX IF EXISTS idx_xp_awards_character_id;
+DROP TABLE xp_awards;
@@ -0,0 +1,69 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+CREATE TABLE kindreds (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    strength_modifier INTEGER NOT NULL DEFAULT 0,
+    dexterity_modifier INTEGER NOT NULL DEFAULT 0,
+    constitution_modifier INTEGER NOT NULL DEFAULT 0,
+    intelligence_modifier INTEGER NOT NULL DEFAULT 0,
+    wisdom_modifier INTEGER NOT NULL DEFAULT 0,
+    charisma_modifier INTEGER NOT NULL DEFAULT 0,
+    languages TEXT NOT NULL DEFAULT '[]',       -- JSON array of language names
+    allowed_classes TEXT NOT NULL DEFAULT '[]', -- JSON array; empty allows every class
+    special_abilities TEXT NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO kindreds (name, description, strength_modifier, dexterity_modifier, constitution_modifier,
+                      intelligence_modifier, wisdom_modifier, charisma_modifier,
+                      languages, allowed_classes, special_abilities) VALUES
+('Common', 'Folk of mixed blood found throughout Hyperborea and its colonies.',
+    0, 0, 0, 0, 0, 0,
+    '["Common"]', '[]', '[]'),
+('Amazon', 'Fierce warrior women of the Amazon Rivers, skilled riders and archers.',
+    0, 1, 0, 0, 0, -1,
+    '["Common", "Amazon"]', '[]', '["Expert horsewomen", "Trained in the bow from childhood"]'),
+('Atlantean', 'Tall, bronze-skinned survivors of sunken Atlantis, heirs to ancient lore.',
+    0, 0, -1, 1, 0, 0,
+    '["Common", "Atlantean"]', '[]', '["Tireless swimmers"]'),
+('Esquimaux', 'Hardy hunters of the frozen north who thrive where others freeze.',
+    0, 0, 1, 0, 0, -1,
+    '["Common", "Esquimaux"]', '[]', '["Resistant to natural cold", "Expert ice fishers and trackers"]'),
+('Hyperborean', 'Long-lived, pale and aloof descendants of the first rulers of Hyperborea.',
+    0, 0, 1, 0, 0, -1,
+    '["Common", "Hyperborean"]', '[]', '["Lifespan of several centuries"]'),
+('Ixian', 'Dark-haired, olive-skinned sorcerous folk of Ix, feared for their pacts with dark powers.',
+    0, 0, 0, 1, -1, 0,
+    '["Common", "Ixian"]', '[]', '["Steeped in the lore of sorcery"]'),
+('Kelt', 'Fair, tattooed clansmen of the Kelt isles, given to song and battle fury.',
+    0, 0, 0, 0, -1, 1,
+    '["Common", "Keltic"]', '[]', '["Keen sense of superstition and omens"]'),
+('Kimmerian', 'Dour, black-haired mountain folk, inured to hardship and cold.',
+    1, 0, 0, -1, 0, 0,
+    '["Common", "Kimmerian"]', '[]', '["Expert climbers"]'),
+('Kimmeri-Kelt', 'Hybrids of Kimmerian and Keltic blood, common in the borderlands.',
+    0, 0, 0, 0, 0, 0,
+    '["Common", "Kimmerian", "Keltic"]', '[]', '[]'),
+('Lemurian', 'Dark, stocky islanders of lost Lemuria, thought wise in the ways of the sea.',
+    0, 0, -1, 0, 1, 0,
+    '["Common", "Lemurian"]', '[]', '["Expert sailors and navigators"]'),
+('Oon', 'Hairless, identical clones bred by sorcerers as soldiers and thralls.',
+    1, 0, 1, -2, 0, -2,
+    '["Common"]', '["Fighter", "Barbarian", "Berserker"]', '["Fearless in battle"]'),
+('Pict', 'Short, swarthy woodland tribesmen, masters of ambush and poison.',
+    0, 1, 0, 0, 0, -1,
+    '["Common", "Pictish"]', '[]', '["Skilled in woodland stealth"]'),
+('Viking', 'Tall, fair seafarers and raiders of the northern isles.',
+    1, -1, 0, 0, 0, 0,
+    '["Common", "Viking"]', '[]', '["Expert sailors", "Endure icy seas without harm"]');
+
+-- Characters created before kindreds were tracked have none
+ALTER TABLE characters ADD COLUMN kindred TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+ALTER TABLE characters DROP COLUMN kindred;
+DROP TABLE IF EXISTS kindreds;
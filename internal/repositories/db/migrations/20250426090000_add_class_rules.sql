@@ -0,0 +1,149 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- Per-class rules that used to be hardcoded in ClassService. Hooks name
+-- behaviour implemented in code (e.g. "agile", "run") that a class opts into.
+CREATE TABLE class_rules (
+    class_name TEXT PRIMARY KEY,
+    death_save_bonus INTEGER NOT NULL DEFAULT 0,
+    transformation_save_bonus INTEGER NOT NULL DEFAULT 0,
+    device_save_bonus INTEGER NOT NULL DEFAULT 0,
+    sorcery_save_bonus INTEGER NOT NULL DEFAULT 0,
+    avoidance_save_bonus INTEGER NOT NULL DEFAULT 0,
+    surprise_chance INTEGER,                          -- NULL keeps the default chance
+    max_armor TEXT NOT NULL DEFAULT 'Heavy' CHECK (max_armor IN ('None', 'Light', 'Medium', 'Heavy')),
+    shields_allowed BOOLEAN NOT NULL DEFAULT 1,
+    hooks TEXT NOT NULL DEFAULT '[]'                  -- JSON array of hook names
+);
+
+INSERT INTO class_rules (class_name, death_save_bonus, transformation_save_bonus, device_save_bonus,
+                         sorcery_save_bonus, avoidance_save_bonus, surprise_chance, max_armor,
+                         shields_allowed, hooks) VALUES
+('Fighter', 2, 2, 0, 0, 0, NULL, 'Heavy', 1, '["agile"]'),
+('Barbarian', 2, 2, 2, 2, 2, 1, 'Heavy', 1, '["extra_strength_feat", "agile", "run"]'),
+('Berserker', 2, 2, 0, 0, 0, NULL, 'Heavy', 1, '["natural_ac"]'),
+('Cataphract', 2, 2, 0, 0, 0, NULL, 'Heavy', 1, '[]'),
+('Huntsman', 2, 0, 0, 0, 2, NULL, 'Medium', 1, '[]'),
+('Paladin', 2, 2, 0, 0, 0, NULL, 'Heavy', 1, '[]'),
+('Ranger', 2, 0, 0, 0, 2, NULL, 'Medium', 1, '[]'),
+('Warlock', 0, 0, 2, 2, 0, NULL, 'Medium', 1, '[]'),
+('Magician', 0, 0, 2, 2, 0, NULL, 'None', 0, '[]'),
+('Cryomancer', 0, 0, 2, 2, 0, NULL, 'None', 0, '[]'),
+('Illusionist', 0, 0, 2, 2, 0, NULL, 'None', 0, '[]'),
+('Necromancer', 0, 0, 2, 2, 0, NULL, 'None', 0, '[]'),
+('Pyromancer', 0, 0, 2, 2, 0, NULL, 'None', 0, '[]'),
+('Witch', 0, 0, 2, 2, 0, NULL, 'None', 0, '[]'),
+('Cleric', 0, 2, 0, 2, 0, NULL, 'Heavy', 1, '[]'),
+('Druid', 0, 2, 0, 2, 0, NULL, 'Medium', 1, '[]'),
+('Monk', 2, 0, 0, 0, 2, NULL, 'None', 0, '["martial_arts"]'),
+('Priest', 0, 2, 0, 2, 0, NULL, 'None', 0, '[]'),
+('Runegraver', 2, 0, 2, 0, 0, NULL, 'Heavy', 1, '["runes_per_day"]'),
+('Shaman', 0, 2, 0, 2, 0, NULL, 'Light', 0, '[]'),
+('Thief', 0, 0, 2, 0, 2, NULL, 'Light', 1, '["agile", "extra_dexterity_feat"]'),
+('Assassin', 2, 0, 0, 0, 2, NULL, 'Light', 1, '[]'),
+('Bard', 0, 0, 2, 0, 2, NULL, 'Light', 1, '[]'),
+('Legerdemainist', 0, 0, 2, 0, 2, NULL, 'Light', 0, '[]'),
+('Purloiner', 0, 0, 2, 0, 2, NULL, 'Medium', 1, '[]'),
+('Scout', 2, 0, 0, 0, 2, NULL, 'Light', 1, '[]');
+
+-- Spell slots granted outside the class_data table, keyed like the character's spell_slots map
+CREATE TABLE class_extra_spell_slots (
+    class_name TEXT NOT NULL,
+    level INTEGER NOT NULL,
+    slot_key TEXT NOT NULL,
+    slots INTEGER NOT NULL,
+    PRIMARY KEY (class_name, level, slot_key)
+);
+
+INSERT INTO class_extra_spell_slots (class_name, level, slot_key, slots) VALUES
+('Ranger', 7, 'druid_level1', 1),
+('Ranger', 7, 'magician_level1', 1),
+('Ranger', 8, 'druid_level1', 1),
+('Ranger', 8, 'magician_level1', 1),
+('Ranger', 9, 'druid_level1', 1),
+('Ranger', 9, 'magician_level1', 1),
+('Ranger', 10, 'druid_level1', 1),
+('Ranger', 10, 'magician_level1', 1),
+('Ranger', 11, 'druid_level1', 1),
+('Ranger', 11, 'magician_level1', 1),
+('Ranger', 12, 'druid_level1', 1),
+('Ranger', 12, 'magician_level1', 1);
+
+-- Every class's abilities in one place. New classes can use the generic
+-- abilities/class_ability_mapping tables without any schema change.
+CREATE VIEW class_abilities AS
+SELECT 'Assassin' AS class_name, id, name, description, min_level FROM assassin_abilities
+UNION ALL
+SELECT 'Barbarian', id, name, description, min_level FROM barbarian_abilities
+UNION ALL
+SELECT 'Bard', id, name, description, min_level FROM bard_abilities
+UNION ALL
+SELECT 'Berserker', id, name, description, min_level FROM berserker_abilities
+UNION ALL
+SELECT 'Cataphract', id, name, description, min_level FROM cataphract_abilities
+UNION ALL
+SELECT 'Cleric', id, name, description, min_level FROM cleric_abilities
+UNION ALL
+SELECT 'Cryomancer', id, name, description, min_level FROM cryomancer_abilities
+UNION ALL
+SELECT 'Druid', id, name, description, min_level FROM druid_abilities
+UNION ALL
+SELECT 'Fighter', id, name, description, min_level FROM fighter_abilities
+UNION ALL
+SELECT 'Huntsman', id, name, description, min_level FROM huntsman_abilities
+UNION ALL
+SELECT 'Illusionist', id, name, description, min_level FROM illusionist_abilities
+UNION ALL
+SELECT 'Legerdemainist', id, name, description, min_level FROM legerdemainist_abilities
+UNION ALL
+SELECT 'Magician', id, name, description, min_level FROM magician_abilities
+UNION ALL
+SELECT 'Monk', id, name, description, min_level FROM monk_abilities
+UNION ALL
+SELECT 'Necromancer', id, name, description, min_level FROM necromancer_abilities
+UNION ALL
+SELECT 'Paladin', id, name, description, min_level FROM paladin_abilities
+UNION ALL
+SELECT 'Priest', id, name, description, min_level FROM priest_abilities
+UNION ALL
+SELECT 'Purloiner', id, name, description, min_level FROM purloiner_abilities
+UNION ALL
+SELECT 'Pyromancer', id, name, description, min_level FROM pyromancer_abilities
+UNION ALL
+SELECT 'Ranger', id, name, description, min_level FROM ranger_abilities
+UNION ALL
+SELECT 'Runegraver', id, name, description, min_level FROM runegraver_abilities
+UNION ALL
+SELECT 'Scout', id, name, description, min_level FROM scout_abilities
+UNION ALL
+SELECT 'Shaman', id, name, description, min_level FROM shaman_abilities
+UNION ALL
+SELECT 'Thief', id, name, description, min_level FROM thief_abilities
+UNION ALL
+SELECT 'Warlock', id, name, description, min_level FROM warlock_abilities
+UNION ALL
+SELECT 'Witch', id, name, description, min_level FROM witch_abilities
+UNION ALL
+SELECT cam.class_name, a.id, a.name, a.description, cam.min_level
+FROM abilities a
+JOIN class_ability_mapping cam ON a.id = cam.ability_id;
+
+CREATE VIEW class_turning_ability AS
+SELECT 'Cleric' AS class_name, level, turning_ability FROM cleric_turning_ability
+UNION ALL
+SELECT 'Necromancer', level, turning_ability FROM necromancer_turning_ability
+UNION ALL
+SELECT 'Paladin', level, turning_ability FROM paladin_turning_ability
+UNION ALL
+SELECT 'Priest', level, turning_ability FROM priest_turning_ability
+UNION ALL
+SELECT 'Purloiner', level, turning_ability FROM purloiner_turning_ability
+UNION ALL
+SELECT 'Shaman', level, turning_ability FROM shaman_turning_ability;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP VIEW IF EXISTS class_turning_ability;
+DROP VIEW IF EXISTS class_abilities;
+DROP TABLE IF EXISTS class_extra_spell_slots;
+DROP TABLE IF EXISTS class_rules;
@@ -0,0 +1,32 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+CREATE TABLE content_packs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    namespace TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    version TEXT NOT NULL DEFAULT '',
+    author TEXT NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    installed_by INTEGER,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (installed_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- Every row a pack installs, so uninstalling removes exactly what it added.
+-- entry_id is the catalog row id; classes are keyed by entry_name alone.
+CREATE TABLE content_pack_entries (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    pack_id INTEGER NOT NULL,
+    entry_type TEXT NOT NULL CHECK (entry_type IN ('class', 'ability', 'spell', 'weapon', 'armor')),
+    entry_id INTEGER NOT NULL DEFAULT 0,
+    entry_name TEXT NOT NULL,
+    FOREIGN KEY (pack_id) REFERENCES content_packs(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_content_pack_entries_pack_id ON content_pack_entries(pack_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP INDEX IF EXISTS idx_content_pack_entries_pack_id;
+DROP TABLE content_pack_entries;
+DROP TABLE content_packs;
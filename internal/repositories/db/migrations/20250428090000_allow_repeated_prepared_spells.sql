@@ -0,0 +1,63 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- A caster may memorize the same spell in several slots, so each prepared
+-- row is one slot instance keyed by its slot_index within a spell level.
+CREATE TABLE prepared_spells_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    character_id INTEGER NOT NULL,
+    spell_id INTEGER NOT NULL,
+    spell_name TEXT NOT NULL,
+    spell_level INTEGER NOT NULL,
+    spell_class TEXT NOT NULL,
+    slot_index INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE,
+    FOREIGN KEY (spell_id) REFERENCES spells(id),
+    UNIQUE(character_id, spell_class, spell_level, slot_index)
+);
+
+-- Renumber existing slots so they are unique within each level and class
+INSERT INTO prepared_spells_new (id, character_id, spell_id, spell_name, spell_level, spell_class,
+                                 slot_index, created_at, updated_at)
+SELECT id, character_id, spell_id, spell_name, spell_level, spell_class,
+       ROW_NUMBER() OVER (PARTITION BY character_id, spell_class, spell_level ORDER BY slot_index, id),
+       created_at, updated_at
+FROM prepared_spells;
+
+DROP TABLE prepared_spells;
+ALTER TABLE prepared_spells_new RENAME TO prepared_spells;
+
+CREATE INDEX IF NOT EXISTS idx_prepared_spells_character ON prepared_spells (character_id);
+CREATE INDEX IF NOT EXISTS idx_prepared_spells_spell ON prepared_spells (spell_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+CREATE TABLE prepared_spells_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    character_id INTEGER NOT NULL,
+    spell_id INTEGER NOT NULL,
+    spell_name TEXT NOT NULL,
+    spell_level INTEGER NOT NULL,
+    spell_class TEXT NOT NULL,
+    slot_index INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE,
+    FOREIGN KEY (spell_id) REFERENCES spells(id),
+    UNIQUE(character_id, spell_id)
+);
+
+-- Keep only the first prepared copy of each spell
+INSERT INTO prepared_spells_old (id, character_id, spell_id, spell_name, spell_level, spell_class,
+                                 slot_index, created_at, updated_at)
+SELECT id, character_id, spell_id, spell_name, spell_level, spell_class, slot_index, created_at, updated_at
+FROM prepared_spells
+WHERE id IN (SELECT MIN(id) FROM prepared_spells GROUP BY character_id, spell_id);
+
+DROP TABLE prepared_spells;
+ALTER TABLE prepared_spells_old RENAME TO prepared_spells;
+
+CREATE INDEX IF NOT EXISTS idx_prepared_spells_character ON prepared_spells (character_id);
+CREATE INDEX IF NOT EXISTS idx_prepared_spells_spell ON prepared_spells (spell_id);
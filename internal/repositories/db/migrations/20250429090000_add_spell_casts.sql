@@ -0,0 +1,30 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- A prepared slot is expended when cast and restored by resting
+ALTER TABLE prepared_spells ADD COLUMN expended_at TIMESTAMP;
+
+CREATE TABLE spell_casts (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    character_id INTEGER NOT NULL,
+    prepared_spell_id INTEGER,
+    spell_id INTEGER NOT NULL,
+    spell_name TEXT NOT NULL,
+    spell_level INTEGER NOT NULL,
+    spell_class TEXT NOT NULL,
+    target TEXT NOT NULL DEFAULT '',
+    round INTEGER,
+    notes TEXT NOT NULL DEFAULT '',
+    cast_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE,
+    FOREIGN KEY (prepared_spell_id) REFERENCES prepared_spells(id) ON DELETE SET NULL,
+    FOREIGN KEY (spell_id) REFERENCES spells(id)
+);
+
+CREATE INDEX idx_spell_casts_character_id ON spell_casts(character_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP INDEX IF EXISTS idx_spell_casts_character_id;
+DROP TABLE IF EXISTS spell_casts;
+ALTER TABLE prepared_spells DROP COLUMN expended_at;
@@ -0,0 +1,29 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- Each spellbooks row is one physical book, carried as a 'spellbook'
+-- inventory item. Travelling books are smaller and lighter.
+ALTER TABLE spellbooks ADD COLUMN is_travelling BOOLEAN NOT NULL DEFAULT 0;
+
+-- Spells scribed into a book; used_pages on the book is the sum of pages here
+CREATE TABLE spellbook_spells (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    spellbook_id INTEGER NOT NULL,
+    spell_id INTEGER NOT NULL,
+    spell_name TEXT NOT NULL,
+    spell_level INTEGER NOT NULL,
+    spell_class TEXT NOT NULL,
+    pages INTEGER NOT NULL CHECK (pages > 0),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (spellbook_id) REFERENCES spellbooks(id) ON DELETE CASCADE,
+    FOREIGN KEY (spell_id) REFERENCES spells(id),
+    UNIQUE(spellbook_id, spell_id)
+);
+
+CREATE INDEX idx_spellbook_spells_spell_id ON spellbook_spells(spell_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP INDEX IF EXISTS idx_spellbook_spells_spell_id;
+DROP TABLE IF EXISTS spellbook_spells;
+ALTER TABLE spellbooks DROP COLUMN is_travelling;
@@ -0,0 +1,30 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- Every roll to learn a spell from a scroll or another caster's spellbook.
+-- A failed roll blocks further attempts at that spell until the character
+-- gains a level, so the level it was made at is kept with it.
+CREATE TABLE spell_learning_attempts (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    character_id INTEGER NOT NULL,
+    spell_id INTEGER NOT NULL,
+    spell_name TEXT NOT NULL,
+    spell_level INTEGER NOT NULL,
+    spell_class TEXT NOT NULL,
+    source_type TEXT NOT NULL CHECK (source_type IN ('scroll', 'spellbook')),
+    source_id INTEGER NOT NULL,
+    character_level INTEGER NOT NULL,
+    roll INTEGER NOT NULL,
+    chance INTEGER NOT NULL,
+    success BOOLEAN NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE,
+    FOREIGN KEY (spell_id) REFERENCES spells(id)
+);
+
+CREATE INDEX idx_spell_learning_attempts_character_spell ON spell_learning_attempts(character_id, spell_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP INDEX IF EXISTS idx_spell_learning_attempts_character_spell;
+DROP TABLE IF EXISTS spell_learning_attempts;
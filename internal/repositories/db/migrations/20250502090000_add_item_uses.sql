@@ -0,0 +1,31 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- A log of items characters have used from their inventories. The inventory
+-- item may be gone once its last use is consumed, so the item's type, id and
+-- name are kept with the entry.
+CREATE TABLE item_uses (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    character_id INTEGER NOT NULL,
+    inventory_item_id INTEGER NOT NULL,
+    item_type TEXT NOT NULL,
+    item_id INTEGER NOT NULL,
+    item_name TEXT NOT NULL,
+    method TEXT NOT NULL DEFAULT '',
+    die INTEGER NOT NULL DEFAULT 0,
+    roll INTEGER NOT NULL DEFAULT 0,
+    chance INTEGER NOT NULL DEFAULT 0,
+    success BOOLEAN NOT NULL,
+    mishap BOOLEAN NOT NULL DEFAULT 0,
+    effect TEXT NOT NULL DEFAULT '',
+    target TEXT NOT NULL DEFAULT '',
+    used_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_item_uses_character_id ON item_uses(character_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP INDEX IF EXISTS idx_item_uses_character_id;
+DROP TABLE IF EXISTS item_uses;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- Charges belong to each carried wand, rod or staff rather than to the
+-- catalog entry. NULL marks an item that does not use charges.
+ALTER TABLE inventory_items ADD COLUMN charges INTEGER CHECK (charges IS NULL OR charges >= 0);
+
+-- Items already carried start with the catalog's charges
+UPDATE inventory_items
+SET charges = (SELECT mi.charges FROM magic_items mi WHERE mi.id = inventory_items.item_id)
+WHERE item_type = 'magic_item';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+ALTER TABLE inventory_items DROP COLUMN charges;
@@ -0,0 +1,41 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- A potion may set one of the drinker's attributes for a number of turns
+-- rolled from effect_duration, e.g. giant strength setting strength to 18
+ALTER TABLE potions ADD COLUMN effect_attribute TEXT;
+ALTER TABLE potions ADD COLUMN effect_score INTEGER;
+ALTER TABLE potions ADD COLUMN effect_duration TEXT;
+
+-- Uses left in the open potion of a stack. NULL marks an item without uses.
+ALTER TABLE inventory_items ADD COLUMN uses_remaining INTEGER CHECK (uses_remaining IS NULL OR uses_remaining >= 0);
+
+-- Potions already carried are unopened
+UPDATE inventory_items
+SET uses_remaining = (SELECT p.uses FROM potions p WHERE p.id = inventory_items.item_id)
+WHERE item_type = 'potion';
+
+CREATE TABLE active_effects (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    character_id INTEGER NOT NULL,
+    source_type TEXT NOT NULL CHECK (source_type IN ('potion')),
+    source_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    attribute TEXT,
+    score INTEGER,
+    duration_rounds INTEGER NOT NULL CHECK (duration_rounds > 0),
+    remaining_rounds INTEGER NOT NULL CHECK (remaining_rounds >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_active_effects_character ON active_effects(character_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP INDEX IF EXISTS idx_active_effects_character;
+DROP TABLE IF EXISTS active_effects;
+ALTER TABLE inventory_items DROP COLUMN uses_remaining;
+ALTER TABLE potions DROP COLUMN effect_duration;
+ALTER TABLE potions DROP COLUMN effect_score;
+ALTER TABLE potions DROP COLUMN effect_attribute;
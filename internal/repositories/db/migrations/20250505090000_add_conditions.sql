@@ -0,0 +1,48 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- Catalog of conditions a character can suffer or enjoy. ac_bonus follows the
+-- shield convention: positive values improve (lower) armour class.
+-- movement_modifier is in feet and added to the movement rate.
+CREATE TABLE conditions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    to_hit_modifier INTEGER NOT NULL DEFAULT 0,
+    ac_bonus INTEGER NOT NULL DEFAULT 0,
+    save_modifier INTEGER NOT NULL DEFAULT 0,
+    movement_modifier INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO conditions (name, description, to_hit_modifier, ac_bonus, save_modifier, movement_modifier) VALUES
+('Blessed', 'Under a bless spell: +1 to hit and to saving throws.', 1, 0, 1, 0),
+('Blinded', 'Unable to see: -4 to hit, foes strike as if armour class were 4 worse, and movement is slowed.', -4, -4, 0, -20),
+('Burdened', 'Weighed down by a curse or enchantment: movement is reduced by 10 feet.', 0, 0, 0, -10),
+('Cursed', 'Under a curse: -1 to hit and to saving throws.', -1, 0, -1, 0),
+('Poisoned', 'Weakened by a lingering poison: -2 to hit and to saving throws.', -2, 0, -2, 0),
+('Prone', 'Lying on the ground: -4 to hit, armour class 2 worse, and movement limited to a crawl.', -4, -2, 0, -30),
+('Stunned', 'Reeling and unable to act: foes strike as if armour class were 4 worse and the victim cannot move.', 0, -4, 0, -40);
+
+-- Conditions on a character. remaining_rounds counts down as game time is
+-- advanced; NULL lasts until the condition is removed.
+CREATE TABLE character_conditions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    character_id INTEGER NOT NULL,
+    condition_id INTEGER NOT NULL,
+    source TEXT NOT NULL DEFAULT '',
+    duration_rounds INTEGER CHECK (duration_rounds IS NULL OR duration_rounds > 0),
+    remaining_rounds INTEGER CHECK (remaining_rounds IS NULL OR remaining_rounds >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE,
+    FOREIGN KEY (condition_id) REFERENCES conditions(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_character_conditions_character ON character_conditions(character_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP INDEX IF EXISTS idx_character_conditions_character;
+DROP TABLE IF EXISTS character_conditions;
+DROP TABLE IF EXISTS conditions;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- Classes that can backstab get their damage multiplier from the backstab hook
+UPDATE class_rules SET hooks = json_insert(hooks, '$[#]', 'backstab')
+WHERE class_name IN ('Thief', 'Assassin', 'Legerdemainist', 'Purloiner', 'Scout');
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+UPDATE class_rules SET hooks = '["agile", "extra_dexterity_feat"]' WHERE class_name = 'Thief';
+UPDATE class_rules SET hooks = '[]' WHERE class_name IN ('Assassin', 'Legerdemainist', 'Purloiner', 'Scout');
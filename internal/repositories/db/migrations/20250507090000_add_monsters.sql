@@ -0,0 +1,32 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- Bestiary of monsters using the AS&SH stat block. hit_dice keeps the written
+-- form (e.g. "3+1" or "½"); hit_dice_level is its whole number of dice for
+-- filtering by HD range.
+CREATE TABLE monsters (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    hit_dice TEXT NOT NULL,
+    hit_dice_level INTEGER NOT NULL DEFAULT 0,
+    armor_class INTEGER NOT NULL,
+    movement TEXT NOT NULL DEFAULT '',
+    attacks TEXT NOT NULL DEFAULT '',
+    damage TEXT NOT NULL DEFAULT '',
+    saving_throw INTEGER NOT NULL,
+    morale INTEGER NOT NULL,
+    xp_value INTEGER NOT NULL DEFAULT 0,
+    treasure_class TEXT NOT NULL DEFAULT '',
+    special_abilities TEXT NOT NULL DEFAULT '',
+    terrain TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_monsters_hit_dice_level ON monsters(hit_dice_level);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP INDEX IF EXISTS idx_monsters_hit_dice_level;
+DROP TABLE IF EXISTS monsters;
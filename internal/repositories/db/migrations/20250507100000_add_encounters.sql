@@ -0,0 +1,48 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- Combat encounters run by a user. round is 0 until initiative is rolled;
+-- turn indexes the participant acting in initiative order.
+CREATE TABLE encounters (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    initiative_mode TEXT NOT NULL DEFAULT 'side' CHECK (initiative_mode IN ('side', 'individual')),
+    round INTEGER NOT NULL DEFAULT 0 CHECK (round >= 0),
+    turn INTEGER NOT NULL DEFAULT 0 CHECK (turn >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_encounters_user ON encounters(user_id);
+
+-- Characters and monsters in an encounter. Characters keep their hit points
+-- on the character; monsters track theirs here.
+CREATE TABLE encounter_participants (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    encounter_id INTEGER NOT NULL,
+    side TEXT NOT NULL CHECK (side IN ('party', 'opponents')),
+    character_id INTEGER,
+    monster_id INTEGER,
+    name TEXT NOT NULL,
+    armor_class INTEGER,
+    max_hp INTEGER,
+    current_hp INTEGER,
+    initiative INTEGER,
+    surprised BOOLEAN NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (encounter_id) REFERENCES encounters(id) ON DELETE CASCADE,
+    FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE,
+    FOREIGN KEY (monster_id) REFERENCES monsters(id) ON DELETE SET NULL,
+    CHECK (character_id IS NOT NULL OR max_hp IS NOT NULL)
+);
+
+CREATE INDEX idx_encounter_participants_encounter ON encounter_participants(encounter_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP INDEX IF EXISTS idx_encounter_participants_encounter;
+DROP TABLE IF EXISTS encounter_participants;
+DROP INDEX IF EXISTS idx_encounters_user;
+DROP TABLE IF EXISTS encounters;
@@ -0,0 +1,28 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- Log of attempts to turn or command undead. entry is the turning matrix
+-- cell that applied ("7:12", "T", "D" or "-"). Attempts count against the
+-- character's daily uses until recovered is set by a night's rest.
+CREATE TABLE turn_undead_attempts (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    character_id INTEGER NOT NULL,
+    undead_type INTEGER NOT NULL CHECK (undead_type BETWEEN 1 AND 13),
+    turning_ability INTEGER NOT NULL,
+    command BOOLEAN NOT NULL DEFAULT 0,
+    entry TEXT NOT NULL,
+    roll INTEGER NOT NULL DEFAULT 0,
+    chance INTEGER NOT NULL DEFAULT 0,
+    result TEXT NOT NULL CHECK (result IN ('no_effect', 'failed', 'turned', 'destroyed', 'commanded')),
+    affected INTEGER NOT NULL DEFAULT 0,
+    recovered BOOLEAN NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_turn_undead_attempts_character ON turn_undead_attempts(character_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP INDEX IF EXISTS idx_turn_undead_attempts_character;
+DROP TABLE IF EXISTS turn_undead_attempts;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- Log of thief skill checks for the session log. base_chance is the skill's
+-- x-in-12 chance before modifiers; modifiers holds a JSON array of the
+-- adjustments applied and chance the final target for the d12.
+CREATE TABLE thief_skill_checks (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    character_id INTEGER NOT NULL,
+    skill_name TEXT NOT NULL,
+    base_chance INTEGER NOT NULL,
+    modifiers TEXT NOT NULL DEFAULT '[]',
+    chance INTEGER NOT NULL,
+    roll INTEGER NOT NULL,
+    success BOOLEAN NOT NULL,
+    notes TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_thief_skill_checks_character ON thief_skill_checks(character_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP INDEX IF EXISTS idx_thief_skill_checks_character;
+DROP TABLE IF EXISTS thief_skill_checks;
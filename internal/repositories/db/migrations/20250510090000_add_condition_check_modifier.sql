@@ -0,0 +1,13 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- Adjusts attribute tests, feats and checks. Each point is one in six on a
+-- test, one on a d20 check and five per cent on a feat.
+ALTER TABLE conditions ADD COLUMN check_modifier INTEGER NOT NULL DEFAULT 0;
+
+UPDATE conditions SET check_modifier = -2 WHERE name IN ('Blinded', 'Stunned');
+UPDATE conditions SET check_modifier = -1 WHERE name IN ('Cursed', 'Poisoned');
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+ALTER TABLE conditions DROP COLUMN check_modifier;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- Sourced changes to a character's attributes and level. Damage is temporary
+-- and drain lasts until magically restored; both lower the value by amount.
+-- A bonus raises it by amount or, when score is set, to at least that score,
+-- as a girdle of giant strength does.
+CREATE TABLE attribute_adjustments (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    character_id INTEGER NOT NULL,
+    attribute TEXT NOT NULL CHECK (attribute IN ('strength', 'dexterity', 'constitution', 'intelligence', 'wisdom', 'charisma', 'level')),
+    kind TEXT NOT NULL CHECK (kind IN ('damage', 'drain', 'bonus')),
+    amount INTEGER NOT NULL DEFAULT 0,
+    score INTEGER,
+    source TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_attribute_adjustments_character ON attribute_adjustments(character_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP INDEX IF EXISTS idx_attribute_adjustments_character;
+DROP TABLE IF EXISTS attribute_adjustments;
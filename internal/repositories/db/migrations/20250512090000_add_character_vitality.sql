@@ -0,0 +1,13 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- A character below 0 hit points is dying until their wounds are bound;
+-- stabilized records that they were. Retired characters are kept for the
+-- record but no longer played, which is how the dead are laid to rest.
+ALTER TABLE characters ADD COLUMN stabilized BOOLEAN NOT NULL DEFAULT 0;
+ALTER TABLE characters ADD COLUMN retired_at TIMESTAMP;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+ALTER TABLE characters DROP COLUMN retired_at;
+ALTER TABLE characters DROP COLUMN stabilized;
@@ -0,0 +1,45 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- Prime attributes earn a class its experience bonus; a class with several
+-- only earns it when all of them qualify
+ALTER TABLE class_rules ADD COLUMN prime_attributes TEXT NOT NULL DEFAULT '[]'; -- JSON array of attribute names
+
+UPDATE class_rules SET prime_attributes = '["strength"]' WHERE class_name = 'Fighter';
+UPDATE class_rules SET prime_attributes = '["strength", "constitution"]' WHERE class_name IN ('Barbarian', 'Berserker');
+UPDATE class_rules SET prime_attributes = '["strength", "charisma"]' WHERE class_name IN ('Cataphract', 'Paladin');
+UPDATE class_rules SET prime_attributes = '["strength", "wisdom"]' WHERE class_name IN ('Huntsman', 'Ranger');
+UPDATE class_rules SET prime_attributes = '["strength", "intelligence"]' WHERE class_name = 'Warlock';
+UPDATE class_rules SET prime_attributes = '["intelligence"]' WHERE class_name IN ('Magician', 'Cryomancer', 'Pyromancer');
+UPDATE class_rules SET prime_attributes = '["intelligence", "dexterity"]' WHERE class_name = 'Illusionist';
+UPDATE class_rules SET prime_attributes = '["intelligence", "wisdom"]' WHERE class_name = 'Necromancer';
+UPDATE class_rules SET prime_attributes = '["intelligence", "charisma"]' WHERE class_name = 'Witch';
+UPDATE class_rules SET prime_attributes = '["wisdom"]' WHERE class_name IN ('Cleric', 'Druid', 'Priest');
+UPDATE class_rules SET prime_attributes = '["wisdom", "dexterity"]' WHERE class_name = 'Monk';
+UPDATE class_rules SET prime_attributes = '["wisdom", "strength"]' WHERE class_name = 'Runegraver';
+UPDATE class_rules SET prime_attributes = '["wisdom", "intelligence"]' WHERE class_name = 'Shaman';
+UPDATE class_rules SET prime_attributes = '["dexterity"]' WHERE class_name = 'Thief';
+UPDATE class_rules SET prime_attributes = '["dexterity", "intelligence"]' WHERE class_name IN ('Assassin', 'Legerdemainist');
+UPDATE class_rules SET prime_attributes = '["dexterity", "charisma"]' WHERE class_name = 'Bard';
+UPDATE class_rules SET prime_attributes = '["dexterity", "wisdom"]' WHERE class_name IN ('Purloiner', 'Scout');
+
+-- How many times a backstab rolls the weapon's damage dice, from min_level
+-- until the next row
+CREATE TABLE class_backstab_multipliers (
+    class_name TEXT NOT NULL,
+    min_level INTEGER NOT NULL,
+    multiplier INTEGER NOT NULL,
+    PRIMARY KEY (class_name, min_level)
+);
+
+INSERT INTO class_backstab_multipliers (class_name, min_level, multiplier) VALUES
+('Thief', 1, 2), ('Thief', 5, 3), ('Thief', 9, 4),
+('Assassin', 1, 2), ('Assassin', 5, 3), ('Assassin', 9, 4),
+('Legerdemainist', 1, 2), ('Legerdemainist', 5, 3), ('Legerdemainist', 9, 4),
+('Purloiner', 1, 2), ('Purloiner', 5, 3), ('Purloiner', 9, 4),
+('Scout', 1, 2), ('Scout', 5, 3), ('Scout', 9, 4);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+DROP TABLE IF EXISTS class_backstab_multipliers;
+ALTER TABLE class_rules DROP COLUMN prime_attributes;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- Rings and cloaks of protection add their bonus to every saving throw while
+-- worn. The best bonus among equipped items applies; they do not stack.
+ALTER TABLE rings ADD COLUMN save_bonus INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE equipment ADD COLUMN save_bonus INTEGER NOT NULL DEFAULT 0;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+ALTER TABLE equipment DROP COLUMN save_bonus;
+ALTER TABLE rings DROP COLUMN save_bonus;
@@ -0,0 +1,49 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- A loss lowers the value for good, as the constitution a character gives up
+-- to return from death does; unlike drain, no restoration brings it back.
+CREATE TABLE attribute_adjustments_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    character_id INTEGER NOT NULL,
+    attribute TEXT NOT NULL CHECK (attribute IN ('strength', 'dexterity', 'constitution', 'intelligence', 'wisdom', 'charisma', 'level')),
+    kind TEXT NOT NULL CHECK (kind IN ('damage', 'drain', 'loss', 'bonus')),
+    amount INTEGER NOT NULL DEFAULT 0,
+    score INTEGER,
+    source TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE
+);
+
+INSERT INTO attribute_adjustments_new (id, character_id, attribute, kind, amount, score, source, created_at)
+SELECT id, character_id, attribute, kind, amount, score, source, created_at
+FROM attribute_adjustments;
+
+DROP TABLE attribute_adjustments;
+ALTER TABLE attribute_adjustments_new RENAME TO attribute_adjustments;
+
+CREATE INDEX IF NOT EXISTS idx_attribute_adjustments_character ON attribute_adjustments(character_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+CREATE TABLE attribute_adjustments_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    character_id INTEGER NOT NULL,
+    attribute TEXT NOT NULL CHECK (attribute IN ('strength', 'dexterity', 'constitution', 'intelligence', 'wisdom', 'charisma', 'level')),
+    kind TEXT NOT NULL CHECK (kind IN ('damage', 'drain', 'bonus')),
+    amount INTEGER NOT NULL DEFAULT 0,
+    score INTEGER,
+    source TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (character_id) REFERENCES characters(id) ON DELETE CASCADE
+);
+
+-- Losses are kept as drain, the nearest kind the old table allows
+INSERT INTO attribute_adjustments_old (id, character_id, attribute, kind, amount, score, source, created_at)
+SELECT id, character_id, attribute, CASE kind WHEN 'loss' THEN 'drain' ELSE kind END, amount, score, source, created_at
+FROM attribute_adjustments;
+
+DROP TABLE attribute_adjustments;
+ALTER TABLE attribute_adjustments_old RENAME TO attribute_adjustments;
+
+CREATE INDEX IF NOT EXISTS idx_attribute_adjustments_character ON attribute_adjustments(character_id);
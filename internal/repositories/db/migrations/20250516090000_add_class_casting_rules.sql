@@ -0,0 +1,67 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- How a class casts: arcane or divine, the spell list it learns from and
+-- the attribute that sets how many spells it may know. Classes that cannot
+-- cast leave all three empty.
+ALTER TABLE class_rules ADD COLUMN caster_type TEXT NOT NULL DEFAULT '';
+ALTER TABLE class_rules ADD COLUMN casting_class TEXT NOT NULL DEFAULT '';
+ALTER TABLE class_rules ADD COLUMN casting_attribute TEXT NOT NULL DEFAULT '';
+
+UPDATE class_rules SET caster_type = 'arcane', casting_class = class_name, casting_attribute = 'intelligence'
+WHERE class_name IN ('Magician', 'Illusionist', 'Necromancer', 'Pyromancer', 'Cryomancer', 'Warlock');
+UPDATE class_rules SET caster_type = 'arcane', casting_class = 'Witch', casting_attribute = 'wisdom' WHERE class_name = 'Witch';
+UPDATE class_rules SET caster_type = 'arcane', casting_class = 'Magician' WHERE class_name = 'Bard';
+UPDATE class_rules SET caster_type = 'divine', casting_class = class_name, casting_attribute = 'wisdom'
+WHERE class_name IN ('Cleric', 'Druid', 'Priest');
+UPDATE class_rules SET caster_type = 'divine', casting_class = 'Cleric' WHERE class_name = 'Paladin';
+UPDATE class_rules SET caster_type = 'divine', casting_class = 'Druid' WHERE class_name IN ('Ranger', 'Shaman');
+
+-- Level tables behind the natural_ac, martial_arts and runes_per_day hooks,
+-- keyed by class so any class can opt into them
+ALTER TABLE berserker_natural_ac RENAME TO class_natural_ac;
+ALTER TABLE runes_per_day RENAME TO class_runes_per_day;
+
+CREATE TABLE class_martial_arts (
+    class_name TEXT NOT NULL,
+    level INTEGER NOT NULL,
+    ac_bonus INTEGER NOT NULL DEFAULT 0,
+    empty_hand_damage TEXT NOT NULL,
+    PRIMARY KEY (class_name, level)
+);
+
+INSERT INTO class_martial_arts (class_name, level, ac_bonus, empty_hand_damage)
+SELECT 'Monk', b.level, b.ac_bonus, COALESCE(d.damage, '1d4')
+FROM monk_ac_bonus b
+LEFT JOIN monk_empty_hand_damage d ON d.level = b.level;
+
+DROP TABLE monk_empty_hand_damage;
+DROP TABLE monk_ac_bonus;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+CREATE TABLE IF NOT EXISTS monk_ac_bonus (
+    level INTEGER NOT NULL,
+    ac_bonus INTEGER NOT NULL,
+    PRIMARY KEY (level)
+);
+
+CREATE TABLE IF NOT EXISTS monk_empty_hand_damage (
+    level INTEGER NOT NULL,
+    damage TEXT NOT NULL,
+    PRIMARY KEY (level)
+);
+
+INSERT INTO monk_ac_bonus (level, ac_bonus)
+SELECT level, ac_bonus FROM class_martial_arts WHERE class_name = 'Monk';
+INSERT INTO monk_empty_hand_damage (level, damage)
+SELECT level, empty_hand_damage FROM class_martial_arts WHERE class_name = 'Monk';
+
+DROP TABLE IF EXISTS class_martial_arts;
+
+ALTER TABLE class_runes_per_day RENAME TO runes_per_day;
+ALTER TABLE class_natural_ac RENAME TO berserker_natural_ac;
+
+ALTER TABLE class_rules DROP COLUMN casting_attribute;
+ALTER TABLE class_rules DROP COLUMN casting_class;
+ALTER TABLE class_rules DROP COLUMN caster_type;
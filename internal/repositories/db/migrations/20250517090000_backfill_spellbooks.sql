@@ -0,0 +1,73 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied
+
+-- Arcane spells can only be prepared from a carried spellbook, so characters
+-- who learned arcane spells before spellbooks were carried get a standard
+-- book holding every arcane spell they know. The book grows past 100 pages
+-- when needed so no known spell is left out.
+CREATE TABLE spellbook_backfill (
+    character_id INTEGER PRIMARY KEY,
+    spellbook_id INTEGER,
+    pages INTEGER NOT NULL
+);
+
+INSERT INTO spellbook_backfill (character_id, pages)
+SELECT ks.character_id, SUM(ks.spell_level * 2)
+FROM known_spells ks
+JOIN class_rules cr ON cr.class_name = ks.spell_class AND cr.caster_type = 'arcane'
+WHERE ks.spell_level > 0
+  AND NOT EXISTS (
+    SELECT 1 FROM inventory_items ii
+    JOIN inventories i ON i.id = ii.inventory_id
+    WHERE i.character_id = ks.character_id AND ii.item_type = 'spellbook'
+  )
+GROUP BY ks.character_id;
+
+-- The description carries the character until the book has an inventory item
+INSERT INTO spellbooks (name, description, total_pages, used_pages, value, weight, is_travelling)
+SELECT 'Spellbook', 'backfill:' || character_id, MAX(100, pages), pages, 100, 5.0, 0
+FROM spellbook_backfill
+ORDER BY character_id;
+
+UPDATE spellbook_backfill
+SET spellbook_id = (
+    SELECT id FROM spellbooks
+    WHERE description = 'backfill:' || spellbook_backfill.character_id
+);
+
+UPDATE spellbooks SET description = NULL
+WHERE id IN (SELECT spellbook_id FROM spellbook_backfill);
+
+-- Characters get an inventory the first time they need one
+INSERT INTO inventories (character_id, max_weight)
+SELECT b.character_id, 100.0
+FROM spellbook_backfill b
+WHERE NOT EXISTS (SELECT 1 FROM inventories i WHERE i.character_id = b.character_id);
+
+INSERT INTO inventory_items (inventory_id, item_type, item_id, quantity)
+SELECT (SELECT MIN(i.id) FROM inventories i WHERE i.character_id = b.character_id), 'spellbook', b.spellbook_id, 1
+FROM spellbook_backfill b;
+
+INSERT INTO spellbook_spells (spellbook_id, spell_id, spell_name, spell_level, spell_class, pages)
+SELECT b.spellbook_id, ks.spell_id, ks.spell_name, ks.spell_level, ks.spell_class, ks.spell_level * 2
+FROM known_spells ks
+JOIN spellbook_backfill b ON b.character_id = ks.character_id
+JOIN class_rules cr ON cr.class_name = ks.spell_class AND cr.caster_type = 'arcane'
+WHERE ks.spell_level > 0;
+
+UPDATE inventories
+SET current_weight = current_weight + 5.0,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id IN (
+    SELECT (SELECT MIN(i.id) FROM inventories i WHERE i.character_id = b.character_id)
+    FROM spellbook_backfill b
+);
+
+DROP TABLE spellbook_backfill;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back
+
+-- The backfilled books are ordinary spellbooks once created; their owners may
+-- have scribed into them since, so they are left in place
+SELECT 1;
@@ -0,0 +1,41 @@
+-- name: GetAbilityRoll :one
+SELECT id, user_id, method, scores, rolls, seed, nonce, signature, character_id, used_at, created_at
+FROM ability_rolls
+WHERE id = ? LIMIT 1;
+
+-- name: GetAbilityRollsByUser :many
+SELECT id, user_id, method, scores, rolls, seed, nonce, signature, character_id, used_at, created_at
+FROM ability_rolls
+WHERE user_id = ?
+ORDER BY created_at DESC, id DESC;
+
+-- name: GetAbilityRollByCharacter :one
+SELECT id, user_id, method, scores, rolls, seed, nonce, signature, character_id, used_at, created_at
+FROM ability_rolls
+WHERE character_id = ? LIMIT 1;
+
+-- name: CreateAbilityRoll :execresult
+INSERT INTO ability_rolls (
+    user_id, method, scores, rolls, seed, nonce, signature
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: ClaimAbilityRoll :execresult
+UPDATE ability_rolls
+SET used_at = CURRENT_TIMESTAMP
+WHERE id = ? AND used_at IS NULL;
+
+-- name: ReleaseAbilityRoll :exec
+UPDATE ability_rolls
+SET used_at = NULL
+WHERE id = ? AND character_id IS NULL;
+
+-- name: SetAbilityRollCharacter :exec
+UPDATE ability_rolls
+SET character_id = ?
+WHERE id = ?;
+
+-- name: CountUnusedDiceAbilityRolls :one
+SELECT COUNT(*) FROM ability_rolls
+WHERE user_id = ? AND used_at IS NULL AND method != 'point_buy';
@@ -0,0 +1,36 @@
+-- name: CreateActiveEffect :execresult
+INSERT INTO active_effects (
+    character_id,
+    source_type,
+    source_id,
+    name,
+    attribute,
+    score,
+    duration_rounds,
+    remaining_rounds
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: GetActiveEffect :one
+SELECT * FROM active_effects
+WHERE id = ? AND character_id = ?
+LIMIT 1;
+
+-- name: GetActiveEffectsByCharacter :many
+SELECT * FROM active_effects
+WHERE character_id = ? AND remaining_rounds > 0
+ORDER BY id;
+
+-- name: AdvanceActiveEffects :exec
+UPDATE active_effects
+SET remaining_rounds = MAX(remaining_rounds - ?, 0)
+WHERE character_id = ?;
+
+-- name: DeleteExpiredActiveEffects :exec
+DELETE FROM active_effects
+WHERE character_id = ? AND remaining_rounds = 0;
+
+-- name: DeleteActiveEffect :exec
+DELETE FROM active_effects
+WHERE id = ? AND character_id = ?;
@@ -0,0 +1,23 @@
+-- name: CreateAttributeAdjustment :execresult
+INSERT INTO attribute_adjustments (
+    character_id, attribute, kind, amount, score, source
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+);
+
+-- name: GetAttributeAdjustment :one
+SELECT * FROM attribute_adjustments
+WHERE id = ? AND character_id = ?;
+
+-- name: GetAttributeAdjustmentsByCharacter :many
+SELECT * FROM attribute_adjustments
+WHERE character_id = ?
+ORDER BY id;
+
+-- name: DeleteAttributeAdjustment :exec
+DELETE FROM attribute_adjustments
+WHERE id = ? AND character_id = ?;
+
+-- name: RestoreAttributeAdjustments :execresult
+DELETE FROM attribute_adjustments
+WHERE character_id = ? AND kind = ? AND attribute LIKE ?;
@@ -0,0 +1,26 @@
+-- name: GetCharacterGrant :one
+SELECT id, character_id, user_id, grant_type, created_at
+FROM character_grants
+WHERE character_id = ? AND user_id = ?
+LIMIT 1;
+
+-- name: GetCharacterGrants :many
+SELECT id, character_id, user_id, grant_type, created_at
+FROM character_grants
+WHERE character_id = ?
+ORDER BY id;
+
+-- name: GetCharacterGrantsByUser :many
+SELECT id, character_id, user_id, grant_type, created_at
+FROM character_grants
+WHERE user_id = ?
+ORDER BY character_id;
+
+-- name: UpsertCharacterGrant :exec
+INSERT INTO character_grants (character_id, user_id, grant_type)
+VALUES (?, ?, ?)
+ON CONFLICT(character_id, user_id) DO UPDATE SET grant_type = excluded.grant_type;
+
+-- name: DeleteCharacterGrant :exec
+DELETE FROM character_grants
+WHERE character_id = ? AND user_id = ?;
@@ -1,37 +1,38 @@
 -- name: GetCharacter :one
-SELECT id, user_id, name, class, level, strength, dexterity, constitution,
+SELECT id, user_id, name, class, kindred, level, strength, dexterity, constitution,
        wisdom, intelligence, charisma, max_hit_points, current_hit_points, temporary_hit_points, experience_points,
-       created_at, updated_at
+       stabilized, retired_at, created_at, updated_at
 FROM characters
 WHERE id = ? LIMIT 1;
 
 -- name: GetCharactersByUser :many
-SELECT id, user_id, name, class, level, strength, dexterity, constitution,
+SELECT id, user_id, name, class, kindred, level, strength, dexterity, constitution,
        wisdom, intelligence, charisma, max_hit_points, current_hit_points, temporary_hit_points, experience_points,
-       created_at, updated_at
+       stabilized, retired_at, created_at, updated_at
 FROM characters
 WHERE user_id = ?
 ORDER BY name;
 
 -- name: ListCharacters :many
-SELECT id, user_id, name, class, level, strength, dexterity, constitution,
+SELECT id, user_id, name, class, kindred, level, strength, dexterity, constitution,
        wisdom, intelligence, charisma, max_hit_points, current_hit_points, temporary_hit_points, experience_points,
-       created_at, updated_at
+       stabilized, retired_at, created_at, updated_at
 FROM characters
 ORDER BY name;
 
 -- name: CreateCharacter :execresult
 INSERT INTO characters (
-  user_id, name, class, level, strength, dexterity, constitution,
+  user_id, name, class, kindred, level, strength, dexterity, constitution,
   wisdom, intelligence, charisma, max_hit_points, current_hit_points, temporary_hit_points, experience_points
 ) VALUES (
-  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 );
 
 -- name: UpdateCharacter :execresult
 UPDATE characters
 SET name = ?,
     class = ?,
+    kindred = ?,
     level = ?,
     strength = ?,
     dexterity = ?,
@@ -46,6 +47,20 @@ SET name = ?,
     updated_at = datetime('now')
 WHERE id = ?;
 
+-- name: UpdateCharacterHitPoints :exec
+UPDATE characters
+SET current_hit_points = ?,
+    temporary_hit_points = ?,
+    stabilized = ?,
+    updated_at = datetime('now')
+WHERE id = ?;
+
+-- name: RetireCharacter :exec
+UPDATE characters
+SET retired_at = CURRENT_TIMESTAMP,
+    updated_at = datetime('now')
+WHERE id = ? AND retired_at IS NULL;
+
 -- name: DeleteCharacter :execresult
 DELETE FROM characters
 WHERE id = ?;
\ No newline at end of file
@@ -13,43 +13,47 @@ WHERE class_name = ? AND level > ?
 ORDER BY level
 LIMIT 1;
 
--- name: GetClericTurningAbility :one
-SELECT turning_ability FROM cleric_turning_ability
-WHERE class_name = 'Cleric' AND level = ?;
+-- name: GetClassRules :one
+SELECT * FROM class_rules
+WHERE class_name = ? LIMIT 1;
 
--- name: GetPaladinTurningAbility :one
-SELECT turning_ability FROM paladin_turning_ability
-WHERE class_name = 'Paladin' AND level = ?;
+-- name: ListClassRules :many
+SELECT * FROM class_rules
+ORDER BY class_name;
 
--- name: GetNecromancerTurningAbility :one
-SELECT turning_ability FROM necromancer_turning_ability
-WHERE class_name = 'Necromancer' AND level = ?;
+-- name: GetClassTurningAbility :one
+SELECT turning_ability FROM class_turning_ability
+WHERE class_name = ? AND level = ?;
 
--- name: GetMonkACBonus :one
-SELECT ac_bonus FROM monk_ac_bonus
-WHERE level = ?;
+-- name: GetClassExtraSpellSlots :many
+SELECT slot_key, slots FROM class_extra_spell_slots
+WHERE class_name = ? AND level = ?
+ORDER BY slot_key;
 
--- name: GetMonkEmptyHandDamage :one
-SELECT damage FROM monk_empty_hand_damage
-WHERE level = ?;
+-- name: GetClassMartialArts :one
+SELECT ac_bonus, empty_hand_damage FROM class_martial_arts
+WHERE class_name = ? AND level = ?;
 
--- name: GetBerserkerNaturalAC :one
-SELECT natural_ac FROM berserker_natural_ac
+-- name: GetClassBackstabMultiplier :one
+SELECT multiplier FROM class_backstab_multipliers
+WHERE class_name = ? AND min_level <= ?
+ORDER BY min_level DESC LIMIT 1;
+
+-- name: GetClassNaturalAC :one
+SELECT natural_ac FROM class_natural_ac
 WHERE class_name = ? AND level = ?;
 
 -- name: GetClassAbilities :many
-SELECT a.id, a.name, a.description, cam.min_level
-FROM abilities a
-JOIN class_ability_mapping cam ON a.id = cam.ability_id
-WHERE cam.class_name = ?
-ORDER BY cam.min_level, a.name;
+SELECT id, name, description, min_level
+FROM class_abilities
+WHERE class_name = ?
+ORDER BY min_level, name;
 
 -- name: GetClassAbilitiesByLevel :many
-SELECT a.id, a.name, a.description, cam.min_level
-FROM abilities a
-JOIN class_ability_mapping cam ON a.id = cam.ability_id
-WHERE cam.class_name = ? AND cam.min_level <= ?
-ORDER BY cam.min_level, a.name;
+SELECT id, name, description, min_level
+FROM class_abilities
+WHERE class_name = ? AND min_level <= ?
+ORDER BY min_level, name;
 
 -- name: GetRangerDruidSpellSlots :many
 SELECT spell_level, slots FROM ranger_druid_spell_slots
@@ -77,7 +81,7 @@ WHERE level = ?;
 SELECT * FROM bard_illusionist_spells
 WHERE level = ?;
 
--- name: GetRunesPerDay :one
+-- name: GetClassRunesPerDay :one
 SELECT level1, level2, level3, level4, level5, level6
-FROM runes_per_day
-WHERE class_name = 'Runegraver' AND level = ?;
+FROM class_runes_per_day
+WHERE class_name = ? AND level = ?;
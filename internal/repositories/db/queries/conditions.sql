@@ -0,0 +1,76 @@
+-- name: GetCondition :one
+SELECT * FROM conditions
+WHERE id = ? LIMIT 1;
+
+-- name: GetConditionByName :one
+SELECT * FROM conditions
+WHERE name = ? LIMIT 1;
+
+-- name: ListConditions :many
+SELECT * FROM conditions
+ORDER BY name;
+
+-- name: CreateCondition :execresult
+INSERT INTO conditions (
+  name, description, to_hit_modifier, ac_bonus, save_modifier, movement_modifier, check_modifier
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: UpdateCondition :execresult
+UPDATE conditions
+SET name = ?,
+    description = ?,
+    to_hit_modifier = ?,
+    ac_bonus = ?,
+    save_modifier = ?,
+    movement_modifier = ?,
+    check_modifier = ?,
+    updated_at = datetime('now')
+WHERE id = ?;
+
+-- name: DeleteCondition :execresult
+DELETE FROM conditions
+WHERE id = ?;
+
+-- name: GetCharacterCondition :one
+SELECT cc.id, cc.character_id, cc.condition_id, c.name, c.to_hit_modifier, c.ac_bonus,
+       c.save_modifier, c.movement_modifier, c.check_modifier, cc.source, cc.duration_rounds,
+       cc.remaining_rounds, cc.created_at
+FROM character_conditions cc
+JOIN conditions c ON c.id = cc.condition_id
+WHERE cc.id = ? AND cc.character_id = ?
+LIMIT 1;
+
+-- name: GetCharacterConditions :many
+SELECT cc.id, cc.character_id, cc.condition_id, c.name, c.to_hit_modifier, c.ac_bonus,
+       c.save_modifier, c.movement_modifier, c.check_modifier, cc.source, cc.duration_rounds,
+       cc.remaining_rounds, cc.created_at
+FROM character_conditions cc
+JOIN conditions c ON c.id = cc.condition_id
+WHERE cc.character_id = ? AND (cc.remaining_rounds IS NULL OR cc.remaining_rounds > 0)
+ORDER BY cc.id;
+
+-- name: AddCharacterCondition :execresult
+INSERT INTO character_conditions (
+    character_id,
+    condition_id,
+    source,
+    duration_rounds,
+    remaining_rounds
+) VALUES (
+    ?, ?, ?, ?, ?
+);
+
+-- name: AdvanceCharacterConditions :exec
+UPDATE character_conditions
+SET remaining_rounds = MAX(remaining_rounds - ?, 0)
+WHERE character_id = ? AND remaining_rounds IS NOT NULL;
+
+-- name: DeleteExpiredCharacterConditions :exec
+DELETE FROM character_conditions
+WHERE character_id = ? AND remaining_rounds = 0;
+
+-- name: DeleteCharacterCondition :exec
+DELETE FROM character_conditions
+WHERE id = ? AND character_id = ?;
@@ -0,0 +1,149 @@
+-- name: GetContentPack :one
+SELECT * FROM content_packs
+WHERE id = ? LIMIT 1;
+
+-- name: GetContentPackByNamespace :one
+SELECT * FROM content_packs
+WHERE namespace = ? LIMIT 1;
+
+-- name: ListContentPacks :many
+SELECT * FROM content_packs
+ORDER BY namespace;
+
+-- name: CreateContentPack :execresult
+INSERT INTO content_packs (
+    namespace, name, version, author, description, installed_by
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+);
+
+-- name: DeleteContentPack :execresult
+DELETE FROM content_packs
+WHERE id = ?;
+
+-- name: CreateContentPackEntry :exec
+INSERT INTO content_pack_entries (
+    pack_id, entry_type, entry_id, entry_name
+) VALUES (
+    ?, ?, ?, ?
+);
+
+-- name: ListContentPackEntries :many
+SELECT * FROM content_pack_entries
+WHERE pack_id = ?
+ORDER BY entry_type, entry_name;
+
+-- name: DeleteContentPackEntries :exec
+DELETE FROM content_pack_entries
+WHERE pack_id = ?;
+
+-- name: CreateClassLevel :exec
+INSERT INTO class_data (
+    class_name, level, experience_points, hit_dice, saving_throw, fighting_ability, casting_ability,
+    spell_slots_level1, spell_slots_level2, spell_slots_level3, spell_slots_level4, spell_slots_level5, spell_slots_level6
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: CreateClassRules :exec
+INSERT INTO class_rules (
+    class_name, death_save_bonus, transformation_save_bonus, device_save_bonus, sorcery_save_bonus,
+    avoidance_save_bonus, surprise_chance, max_armor, shields_allowed, hooks, prime_attributes,
+    caster_type, casting_class, casting_attribute
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: CreateClassBackstabMultiplier :exec
+INSERT INTO class_backstab_multipliers (
+    class_name, min_level, multiplier
+) VALUES (
+    ?, ?, ?
+);
+
+-- name: CreateClassNaturalAC :exec
+INSERT INTO class_natural_ac (
+    class_name, level, natural_ac
+) VALUES (
+    ?, ?, ?
+);
+
+-- name: CreateClassMartialArts :exec
+INSERT INTO class_martial_arts (
+    class_name, level, ac_bonus, empty_hand_damage
+) VALUES (
+    ?, ?, ?, ?
+);
+
+-- name: CreateClassRunesPerDay :exec
+INSERT INTO class_runes_per_day (
+    class_name, level, level1, level2, level3, level4, level5, level6
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: CreateAbility :execresult
+INSERT INTO abilities (
+    name, description
+) VALUES (
+    ?, ?
+);
+
+-- name: CreateClassAbilityMapping :exec
+INSERT INTO class_ability_mapping (
+    class_name, ability_id, min_level
+) VALUES (
+    ?, ?, ?
+);
+
+-- name: DeleteClass :exec
+DELETE FROM class_data
+WHERE class_name = ?;
+
+-- name: DeleteClassRules :exec
+DELETE FROM class_rules
+WHERE class_name = ?;
+
+-- name: DeleteClassBackstabMultipliers :exec
+DELETE FROM class_backstab_multipliers
+WHERE class_name = ?;
+
+-- name: DeleteClassNaturalAC :exec
+DELETE FROM class_natural_ac
+WHERE class_name = ?;
+
+-- name: DeleteClassMartialArts :exec
+DELETE FROM class_martial_arts
+WHERE class_name = ?;
+
+-- name: DeleteClassRunesPerDay :exec
+DELETE FROM class_runes_per_day
+WHERE class_name = ?;
+
+-- name: DeleteClassAbilityMappings :exec
+DELETE FROM class_ability_mapping
+WHERE class_name = ?;
+
+-- name: DeleteAbility :exec
+DELETE FROM abilities
+WHERE id = ?;
+
+-- name: CountCharactersByClass :one
+SELECT COUNT(*) FROM characters
+WHERE class = ?;
+
+-- name: CountInventoryItemsByItem :one
+SELECT COUNT(*) FROM inventory_items
+WHERE item_type = ? AND item_id = ?;
+
+-- name: CountSpellReferences :one
+SELECT COUNT(*) FROM (
+    SELECT spell_id FROM known_spells
+    UNION ALL
+    SELECT spell_id FROM prepared_spells
+    UNION ALL
+    SELECT spell_id FROM spell_scrolls
+    UNION ALL
+    SELECT spell_id FROM spellbook_spells
+) AS spell_references
+WHERE spell_id = ?;
@@ -0,0 +1,62 @@
+-- name: CreateEncounter :execresult
+INSERT INTO encounters (user_id, name)
+VALUES (?, ?);
+
+-- name: GetEncounter :one
+SELECT * FROM encounters
+WHERE id = ? LIMIT 1;
+
+-- name: GetEncountersByUser :many
+SELECT * FROM encounters
+WHERE user_id = ?
+ORDER BY created_at DESC, id DESC;
+
+-- name: UpdateEncounterInitiativeMode :exec
+UPDATE encounters
+SET initiative_mode = ?,
+    updated_at = datetime('now')
+WHERE id = ?;
+
+-- name: UpdateEncounterTurn :exec
+UPDATE encounters
+SET round = ?,
+    turn = ?,
+    updated_at = datetime('now')
+WHERE id = ?;
+
+-- name: DeleteEncounter :exec
+DELETE FROM encounters
+WHERE id = ?;
+
+-- name: AddEncounterParticipant :execresult
+INSERT INTO encounter_participants (
+  encounter_id, side, character_id, monster_id, name, armor_class, max_hp, current_hp
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: GetEncounterParticipants :many
+SELECT p.id, p.encounter_id, p.side, p.character_id, p.monster_id,
+       COALESCE(c.name, p.name) AS name, p.armor_class,
+       CAST(COALESCE(c.max_hit_points, p.max_hp, 0) AS INTEGER) AS max_hp,
+       CAST(COALESCE(c.current_hit_points, p.current_hp, 0) AS INTEGER) AS current_hp,
+       p.initiative, p.surprised, p.created_at
+FROM encounter_participants p
+LEFT JOIN characters c ON c.id = p.character_id
+WHERE p.encounter_id = ?
+ORDER BY p.initiative IS NULL, p.initiative DESC, p.id;
+
+-- name: UpdateParticipantInitiative :exec
+UPDATE encounter_participants
+SET initiative = ?,
+    surprised = ?
+WHERE id = ?;
+
+-- name: UpdateParticipantHP :exec
+UPDATE encounter_participants
+SET current_hp = ?
+WHERE id = ?;
+
+-- name: DeleteEncounterParticipant :exec
+DELETE FROM encounter_participants
+WHERE id = ? AND encounter_id = ?;
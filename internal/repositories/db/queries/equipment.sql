@@ -12,9 +12,9 @@ ORDER BY name;
 
 -- name: CreateEquipment :execresult
 INSERT INTO equipment (
-  name, description, cost, weight
+  name, description, cost, weight, save_bonus
 ) VALUES (
-  ?, ?, ?, ?
+  ?, ?, ?, ?, ?
 );
 
 -- name: UpdateEquipment :execresult
@@ -23,6 +23,7 @@ SET name = ?,
     description = ?,
     cost = ?,
     weight = ?,
+    save_bonus = ?,
     updated_at = datetime('now')
 WHERE id = ?;
 
@@ -59,7 +59,9 @@ INSERT INTO inventory_items (
     quantity,
     is_equipped,
     slot,
-    notes
+    notes,
+    charges,
+    uses_remaining
 ) VALUES (
     ?,
     ?,
@@ -67,6 +69,8 @@ INSERT INTO inventory_items (
     ?,
     ?,
     ?,
+    ?,
+    ?,
     ?
 );
 
@@ -80,6 +84,16 @@ SET
     updated_at = CURRENT_TIMESTAMP
 WHERE id = sqlc.arg('id');
 
+-- name: UpdateInventoryItemCharges :exec
+UPDATE inventory_items
+SET charges = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: UpdateInventoryItemUses :exec
+UPDATE inventory_items
+SET uses_remaining = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
 -- name: RemoveInventoryItem :exec
 DELETE FROM inventory_items
 WHERE id = ?;
@@ -108,6 +122,7 @@ SET current_weight = (
             WHEN 'spell_scroll' THEN (SELECT weight FROM spell_scrolls WHERE spell_scrolls.id = ii.item_id)
             WHEN 'container' THEN (SELECT weight FROM containers WHERE containers.id = ii.item_id)
             WHEN 'equipment' THEN (SELECT weight FROM equipment WHERE equipment.id = ii.item_id)
+            WHEN 'spellbook' THEN (SELECT weight FROM spellbooks WHERE spellbooks.id = ii.item_id)
             ELSE 0.1
         END, 0.1)
     ), 0) FROM inventory_items ii WHERE ii.inventory_id = inventories.id)
@@ -0,0 +1,21 @@
+-- name: GetCharacterInventoryItem :one
+SELECT ii.* FROM inventory_items ii
+JOIN inventories inv ON inv.id = ii.inventory_id
+WHERE ii.id = ? AND inv.character_id = ?;
+
+-- name: CreateItemUse :execresult
+INSERT INTO item_uses (
+    character_id, inventory_item_id, item_type, item_id, item_name, method,
+    die, roll, chance, success, mishap, effect, target
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: GetItemUse :one
+SELECT * FROM item_uses
+WHERE id = ?;
+
+-- name: GetItemUsesByCharacter :many
+SELECT * FROM item_uses
+WHERE character_id = ?
+ORDER BY used_at DESC, id DESC;
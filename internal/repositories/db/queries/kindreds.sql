@@ -0,0 +1,40 @@
+-- name: GetKindred :one
+SELECT * FROM kindreds
+WHERE id = ? LIMIT 1;
+
+-- name: GetKindredByName :one
+SELECT * FROM kindreds
+WHERE name = ? LIMIT 1;
+
+-- name: ListKindreds :many
+SELECT * FROM kindreds
+ORDER BY name;
+
+-- name: CreateKindred :execresult
+INSERT INTO kindreds (
+  name, description, strength_modifier, dexterity_modifier, constitution_modifier,
+  intelligence_modifier, wisdom_modifier, charisma_modifier,
+  languages, allowed_classes, special_abilities
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: UpdateKindred :execresult
+UPDATE kindreds
+SET name = ?,
+    description = ?,
+    strength_modifier = ?,
+    dexterity_modifier = ?,
+    constitution_modifier = ?,
+    intelligence_modifier = ?,
+    wisdom_modifier = ?,
+    charisma_modifier = ?,
+    languages = ?,
+    allowed_classes = ?,
+    special_abilities = ?,
+    updated_at = datetime('now')
+WHERE id = ?;
+
+-- name: DeleteKindred :execresult
+DELETE FROM kindreds
+WHERE id = ?;
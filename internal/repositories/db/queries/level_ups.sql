@@ -0,0 +1,17 @@
+-- name: GetLevelUp :one
+SELECT id, character_id, from_level, to_level, hit_dice, hp_roll, seed, hp_modifier, hp_gained, fixed_hp, details, created_at
+FROM level_ups
+WHERE id = ? LIMIT 1;
+
+-- name: GetLevelUpsByCharacter :many
+SELECT id, character_id, from_level, to_level, hit_dice, hp_roll, seed, hp_modifier, hp_gained, fixed_hp, details, created_at
+FROM level_ups
+WHERE character_id = ?
+ORDER BY to_level, id;
+
+-- name: CreateLevelUp :execresult
+INSERT INTO level_ups (
+    character_id, from_level, to_level, hit_dice, hp_roll, seed, hp_modifier, hp_gained, fixed_hp, details
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
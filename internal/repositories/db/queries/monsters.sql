@@ -0,0 +1,51 @@
+-- name: GetMonster :one
+SELECT * FROM monsters
+WHERE id = ? LIMIT 1;
+
+-- name: GetMonsterByName :one
+SELECT * FROM monsters
+WHERE name = ? LIMIT 1;
+
+-- name: ListMonsters :many
+SELECT * FROM monsters
+ORDER BY name;
+
+-- name: SearchMonsters :many
+SELECT * FROM monsters
+WHERE name LIKE sqlc.arg(name)
+  AND terrain LIKE sqlc.arg(terrain)
+  AND hit_dice_level >= sqlc.arg(min_hd)
+  AND hit_dice_level <= sqlc.arg(max_hd)
+ORDER BY hit_dice_level, name;
+
+-- name: CreateMonster :execresult
+INSERT INTO monsters (
+  name, description, hit_dice, hit_dice_level, armor_class, movement,
+  attacks, damage, saving_throw, morale, xp_value, treasure_class,
+  special_abilities, terrain
+) VALUES (
+  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: UpdateMonster :execresult
+UPDATE monsters
+SET name = ?,
+    description = ?,
+    hit_dice = ?,
+    hit_dice_level = ?,
+    armor_class = ?,
+    movement = ?,
+    attacks = ?,
+    damage = ?,
+    saving_throw = ?,
+    morale = ?,
+    xp_value = ?,
+    treasure_class = ?,
+    special_abilities = ?,
+    terrain = ?,
+    updated_at = datetime('now')
+WHERE id = ?;
+
+-- name: DeleteMonster :execresult
+DELETE FROM monsters
+WHERE id = ?;
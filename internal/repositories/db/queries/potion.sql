@@ -12,9 +12,9 @@ ORDER BY name;
 
 -- name: CreatePotion :execresult
 INSERT INTO potions (
-  name, description, uses, weight
+  name, description, uses, weight, effect_attribute, effect_score, effect_duration
 ) VALUES (
-  ?, ?, ?, ?
+  ?, ?, ?, ?, ?, ?, ?
 );
 
 -- name: UpdatePotion :execresult
@@ -23,6 +23,9 @@ SET name = ?,
     description = ?,
     uses = ?,
     weight = ?,
+    effect_attribute = ?,
+    effect_score = ?,
+    effect_duration = ?,
     updated_at = datetime('now')
 WHERE id = ?;
 
@@ -50,6 +50,16 @@ ORDER BY spell_level, slot_index;
 
 -- name: GetPreparedSpellByCharacterAndSpell :one
 SELECT * FROM prepared_spells
+WHERE character_id = ? AND spell_id = ?
+ORDER BY slot_index DESC
+LIMIT 1;
+
+-- name: GetPreparedSpellBySlot :one
+SELECT * FROM prepared_spells
+WHERE character_id = ? AND spell_id = ? AND slot_index = ?;
+
+-- name: CountPreparedSpellInstances :one
+SELECT COUNT(*) as count FROM prepared_spells
 WHERE character_id = ? AND spell_id = ?;
 
 -- name: CountPreparedSpellsByLevelAndClass :one
@@ -57,9 +67,19 @@ SELECT COUNT(*) as count FROM prepared_spells
 WHERE character_id = ? AND spell_level = ? AND spell_class = ?;
 
 -- name: GetNextAvailableSlotIndex :one
-SELECT COALESCE(MAX(slot_index), 0) + 1 as next_slot_index
-FROM prepared_spells
-WHERE character_id = ? AND spell_level = ? AND spell_class = ?;
+-- Returns the lowest free slot so slots freed by unpreparing are reused
+SELECT CAST(MIN(used.slot_index) + 1 AS INTEGER) as next_slot_index
+FROM (
+    SELECT 0 AS slot_index
+    UNION ALL
+    SELECT slot_index FROM prepared_spells
+    WHERE character_id = ?1 AND spell_level = ?2 AND spell_class = ?3
+) used
+WHERE NOT EXISTS (
+    SELECT 1 FROM prepared_spells p
+    WHERE p.character_id = ?1 AND p.spell_level = ?2 AND p.spell_class = ?3
+      AND p.slot_index = used.slot_index + 1
+);
 
 -- name: PrepareSpell :execresult
 INSERT INTO prepared_spells (
@@ -0,0 +1,36 @@
+-- name: GetPreparedSpell :one
+SELECT * FROM prepared_spells
+WHERE id = ? AND character_id = ?;
+
+-- name: GetNextUnexpendedPreparedSpell :one
+SELECT * FROM prepared_spells
+WHERE character_id = ? AND spell_id = ? AND expended_at IS NULL
+ORDER BY spell_level, slot_index
+LIMIT 1;
+
+-- name: ExpendPreparedSpell :exec
+UPDATE prepared_spells
+SET expended_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: RestorePreparedSpell :exec
+UPDATE prepared_spells
+SET expended_at = NULL, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: CreateSpellCast :execresult
+INSERT INTO spell_casts (
+    character_id, prepared_spell_id, spell_id, spell_name, spell_level, spell_class,
+    target, round, notes
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: GetSpellCast :one
+SELECT * FROM spell_casts
+WHERE id = ?;
+
+-- name: GetSpellCastsByCharacter :many
+SELECT * FROM spell_casts
+WHERE character_id = ?
+ORDER BY cast_at DESC, id DESC;
@@ -0,0 +1,27 @@
+-- name: GetCharacterSpellScrollItem :one
+SELECT ii.* FROM inventory_items ii
+JOIN inventories inv ON inv.id = ii.inventory_id
+WHERE ii.id = ? AND inv.character_id = ? AND ii.item_type = 'spell_scroll';
+
+-- name: GetFailedSpellLearningAttempt :one
+SELECT * FROM spell_learning_attempts
+WHERE character_id = ? AND spell_id = ? AND character_level = ? AND success = 0
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: CreateSpellLearningAttempt :execresult
+INSERT INTO spell_learning_attempts (
+    character_id, spell_id, spell_name, spell_level, spell_class,
+    source_type, source_id, character_level, roll, chance, success
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: GetSpellLearningAttempt :one
+SELECT * FROM spell_learning_attempts
+WHERE id = ?;
+
+-- name: GetSpellLearningAttemptsByCharacter :many
+SELECT * FROM spell_learning_attempts
+WHERE character_id = ?
+ORDER BY created_at DESC, id DESC;
@@ -0,0 +1,66 @@
+-- name: GetSpellbook :one
+SELECT * FROM spellbooks
+WHERE id = ?;
+
+-- name: GetSpellbooksByCharacter :many
+SELECT s.id, s.name, s.description, s.total_pages, s.used_pages, s.value, s.weight,
+       s.is_travelling, s.created_at, s.updated_at, ii.id AS inventory_item_id, inv.character_id
+FROM spellbooks s
+JOIN inventory_items ii ON ii.item_type = 'spellbook' AND ii.item_id = s.id
+JOIN inventories inv ON inv.id = ii.inventory_id
+WHERE inv.character_id = ?
+ORDER BY s.id;
+
+-- name: GetSpellbookForCharacter :one
+SELECT s.id, s.name, s.description, s.total_pages, s.used_pages, s.value, s.weight,
+       s.is_travelling, s.created_at, s.updated_at, ii.id AS inventory_item_id, inv.character_id
+FROM spellbooks s
+JOIN inventory_items ii ON ii.item_type = 'spellbook' AND ii.item_id = s.id
+JOIN inventories inv ON inv.id = ii.inventory_id
+WHERE s.id = ? AND inv.character_id = ?;
+
+-- name: GetSpellbookOwner :one
+SELECT inv.character_id
+FROM inventory_items ii
+JOIN inventories inv ON inv.id = ii.inventory_id
+WHERE ii.item_type = 'spellbook' AND ii.item_id = ?
+LIMIT 1;
+
+-- name: CreateSpellbook :execresult
+INSERT INTO spellbooks (
+    name, description, total_pages, used_pages, value, weight, is_travelling
+) VALUES (
+    ?, ?, ?, 0, ?, ?, ?
+);
+
+-- name: UpdateSpellbookUsedPages :exec
+UPDATE spellbooks
+SET used_pages = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
+
+-- name: GetSpellbookSpells :many
+SELECT * FROM spellbook_spells
+WHERE spellbook_id = ?
+ORDER BY spell_level, spell_name;
+
+-- name: GetSpellbookSpell :one
+SELECT * FROM spellbook_spells
+WHERE spellbook_id = ? AND spell_id = ?;
+
+-- name: AddSpellbookSpell :execresult
+INSERT INTO spellbook_spells (
+    spellbook_id, spell_id, spell_name, spell_level, spell_class, pages
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+);
+
+-- name: RemoveSpellbookSpell :exec
+DELETE FROM spellbook_spells
+WHERE spellbook_id = ? AND spell_id = ?;
+
+-- name: CountCarriedSpellbooksWithSpell :one
+SELECT COUNT(*) as count
+FROM spellbook_spells ss
+JOIN inventory_items ii ON ii.item_type = 'spellbook' AND ii.item_id = ss.spellbook_id
+JOIN inventories inv ON inv.id = ii.inventory_id
+WHERE inv.character_id = ? AND ss.spell_id = ?;
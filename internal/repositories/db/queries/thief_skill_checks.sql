@@ -0,0 +1,15 @@
+-- name: CreateThiefSkillCheck :execresult
+INSERT INTO thief_skill_checks (
+    character_id, skill_name, base_chance, modifiers, chance, roll, success, notes
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: GetThiefSkillCheck :one
+SELECT * FROM thief_skill_checks
+WHERE id = ?;
+
+-- name: GetThiefSkillChecksByCharacter :many
+SELECT * FROM thief_skill_checks
+WHERE character_id = ?
+ORDER BY created_at DESC, id DESC;
@@ -0,0 +1,24 @@
+-- name: CreateTurnUndeadAttempt :execresult
+INSERT INTO turn_undead_attempts (
+    character_id, undead_type, turning_ability, command, entry, roll, chance, result, affected
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: GetTurnUndeadAttempt :one
+SELECT * FROM turn_undead_attempts
+WHERE id = ?;
+
+-- name: GetTurnUndeadAttemptsByCharacter :many
+SELECT * FROM turn_undead_attempts
+WHERE character_id = ?
+ORDER BY created_at DESC, id DESC;
+
+-- name: CountUnrecoveredTurnUndeadAttempts :one
+SELECT COUNT(*) FROM turn_undead_attempts
+WHERE character_id = ? AND recovered = 0;
+
+-- name: RecoverTurnUndeadAttempts :execresult
+UPDATE turn_undead_attempts
+SET recovered = 1
+WHERE character_id = ? AND recovered = 0;
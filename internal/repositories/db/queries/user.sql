@@ -1,13 +1,13 @@
 -- name: GetUser :one
-SELECT id, username, email, created_at, updated_at FROM users
+SELECT id, username, email, role, created_at, updated_at FROM users
 WHERE id = ? LIMIT 1;
 
 -- name: GetFullUserByEmail :one
-SELECT id, username, email, password_hash, created_at, updated_at FROM users
+SELECT id, username, email, password_hash, created_at, updated_at, role FROM users
 WHERE email = ? LIMIT 1;
 
 -- name: ListUsers :many
-SELECT id, username, email, created_at, updated_at FROM users
+SELECT id, username, email, role, created_at, updated_at FROM users
 ORDER BY username;
 
 -- name: CreateUser :execresult
@@ -19,7 +19,7 @@ INSERT INTO users (
 
 -- name: UpdateUser :execresult
 UPDATE users
-SET username = ?, email = ?, updated_at = datetime('now')
+SET username = ?, email = ?, updated_at = strftime('%Y-%m-%d %H:%M:%f', 'now')
 WHERE id = ?;
 
 -- name: DeleteUser :execresult
@@ -31,3 +31,13 @@ UPDATE users
 SET password_hash = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = ?;
+
+-- name: UpdateUserRole :exec
+UPDATE users
+SET role = ?,
+    updated_at = strftime('%Y-%m-%d %H:%M:%f', 'now')
+WHERE id = ?;
+
+-- name: CountUsersByRole :one
+SELECT COUNT(*) AS count FROM users
+WHERE role = ?;
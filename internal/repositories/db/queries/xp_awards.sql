@@ -0,0 +1,33 @@
+-- name: GetXPAward :one
+SELECT id, character_id, amount, bonus_percent, bonus_amount, total, source, session, notes, awarded_by, reverses_award_id, created_at
+FROM xp_awards
+WHERE id = ? LIMIT 1;
+
+-- name: GetXPAwardsByCharacter :many
+SELECT id, character_id, amount, bonus_percent, bonus_amount, total, source, session, notes, awarded_by, reverses_award_id, created_at
+FROM xp_awards
+WHERE character_id = ?
+ORDER BY created_at, id;
+
+-- name: GetXPAwardReversal :one
+SELECT id, character_id, amount, bonus_percent, bonus_amount, total, source, session, notes, awarded_by, reverses_award_id, created_at
+FROM xp_awards
+WHERE reverses_award_id = ? LIMIT 1;
+
+-- name: CreateXPAward :execresult
+INSERT INTO xp_awards (
+    character_id, amount, bonus_percent, bonus_amount, total, source, session, notes, awarded_by, reverses_award_id
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+);
+
+-- name: SumXPAwardsByCharacter :one
+SELECT CAST(COALESCE(SUM(total), 0) AS INTEGER) AS total
+FROM xp_awards
+WHERE character_id = ?;
+
+-- name: UpdateCharacterExperience :exec
+UPDATE characters
+SET experience_points = ?,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = ?;
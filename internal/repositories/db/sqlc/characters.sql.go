@@ -13,10 +13,10 @@ import (
 
 const createCharacter = `-- name: CreateCharacter :execresult
 INSERT INTO characters (
-  user_id, name, class, level, strength, dexterity, constitution,
+  user_id, name, class, kindred, level, strength, dexterity, constitution,
   wisdom, intelligence, charisma, max_hit_points, current_hit_points, temporary_hit_points, experience_points
 ) VALUES (
-  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+  ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 )
 `
 
@@ -24,6 +24,7 @@ type CreateCharacterParams struct {
 	UserID             int64
 	Name               string
 	Class              string
+	Kindred            string
 	Level              int64
 	Strength           int64
 	Dexterity          int64
@@ -42,6 +43,7 @@ func (q *Queries) CreateCharacter(ctx context.Context, arg CreateCharacterParams
 		arg.UserID,
 		arg.Name,
 		arg.Class,
+		arg.Kindred,
 		arg.Level,
 		arg.Strength,
 		arg.Dexterity,
@@ -66,9 +68,9 @@ func (q *Queries) DeleteCharacter(ctx context.Context, id int64) (sql.Result, er
 }
 
 const getCharacter = `-- name: GetCharacter :one
-SELECT id, user_id, name, class, level, strength, dexterity, constitution,
+SELECT id, user_id, name, class, kindred, level, strength, dexterity, constitution,
        wisdom, intelligence, charisma, max_hit_points, current_hit_points, temporary_hit_points, experience_points,
-       created_at, updated_at
+       stabilized, retired_at, created_at, updated_at
 FROM characters
 WHERE id = ? LIMIT 1
 `
@@ -78,6 +80,7 @@ type GetCharacterRow struct {
 	UserID             int64
 	Name               string
 	Class              string
+	Kindred            string
 	Level              int64
 	Strength           int64
 	Dexterity          int64
@@ -89,6 +92,8 @@ type GetCharacterRow struct {
 	CurrentHitPoints   int64
 	TemporaryHitPoints int64
 	ExperiencePoints   int64
+	Stabilized         bool
+	RetiredAt          sql.NullTime
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 }
@@ -101,6 +106,7 @@ func (q *Queries) GetCharacter(ctx context.Context, id int64) (GetCharacterRow,
 		&i.UserID,
 		&i.Name,
 		&i.Class,
+		&i.Kindred,
 		&i.Level,
 		&i.Strength,
 		&i.Dexterity,
@@ -112,6 +118,8 @@ func (q *Queries) GetCharacter(ctx context.Context, id int64) (GetCharacterRow,
 		&i.CurrentHitPoints,
 		&i.TemporaryHitPoints,
 		&i.ExperiencePoints,
+		&i.Stabilized,
+		&i.RetiredAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -119,9 +127,9 @@ func (q *Queries) GetCharacter(ctx context.Context, id int64) (GetCharacterRow,
 }
 
 const getCharactersByUser = `-- name: GetCharactersByUser :many
-SELECT id, user_id, name, class, level, strength, dexterity, constitution,
+SELECT id, user_id, name, class, kindred, level, strength, dexterity, constitution,
        wisdom, intelligence, charisma, max_hit_points, current_hit_points, temporary_hit_points, experience_points,
-       created_at, updated_at
+       stabilized, retired_at, created_at, updated_at
 FROM characters
 WHERE user_id = ?
 ORDER BY name
@@ -132,6 +140,7 @@ type GetCharactersByUserRow struct {
 	UserID             int64
 	Name               string
 	Class              string
+	Kindred            string
 	Level              int64
 	Strength           int64
 	Dexterity          int64
@@ -143,6 +152,8 @@ type GetCharactersByUserRow struct {
 	CurrentHitPoints   int64
 	TemporaryHitPoints int64
 	ExperiencePoints   int64
+	Stabilized         bool
+	RetiredAt          sql.NullTime
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 }
@@ -161,6 +172,7 @@ func (q *Queries) GetCharactersByUser(ctx context.Context, userID int64) ([]GetC
 			&i.UserID,
 			&i.Name,
 			&i.Class,
+			&i.Kindred,
 			&i.Level,
 			&i.Strength,
 			&i.Dexterity,
@@ -172,6 +184,8 @@ func (q *Queries) GetCharactersByUser(ctx context.Context, userID int64) ([]GetC
 			&i.CurrentHitPoints,
 			&i.TemporaryHitPoints,
 			&i.ExperiencePoints,
+			&i.Stabilized,
+			&i.RetiredAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -189,9 +203,9 @@ func (q *Queries) GetCharactersByUser(ctx context.Context, userID int64) ([]GetC
 }
 
 const listCharacters = `-- name: ListCharacters :many
-SELECT id, user_id, name, class, level, strength, dexterity, constitution,
+SELECT id, user_id, name, class, kindred, level, strength, dexterity, constitution,
        wisdom, intelligence, charisma, max_hit_points, current_hit_points, temporary_hit_points, experience_points,
-       created_at, updated_at
+       stabilized, retired_at, created_at, updated_at
 FROM characters
 ORDER BY name
 `
@@ -201,6 +215,7 @@ type ListCharactersRow struct {
 	UserID             int64
 	Name               string
 	Class              string
+	Kindred            string
 	Level              int64
 	Strength           int64
 	Dexterity          int64
@@ -212,6 +227,8 @@ type ListCharactersRow struct {
 	CurrentHitPoints   int64
 	TemporaryHitPoints int64
 	ExperiencePoints   int64
+	Stabilized         bool
+	RetiredAt          sql.NullTime
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 }
@@ -230,6 +247,7 @@ func (q *Queries) ListCharacters(ctx context.Context) ([]ListCharactersRow, erro
 			&i.UserID,
 			&i.Name,
 			&i.Class,
+			&i.Kindred,
 			&i.Level,
 			&i.Strength,
 			&i.Dexterity,
@@ -241,6 +259,8 @@ func (q *Queries) ListCharacters(ctx context.Context) ([]ListCharactersRow, erro
 			&i.CurrentHitPoints,
 			&i.TemporaryHitPoints,
 			&i.ExperiencePoints,
+			&i.Stabilized,
+			&i.RetiredAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -257,10 +277,23 @@ func (q *Queries) ListCharacters(ctx context.Context) ([]ListCharactersRow, erro
 	return items, nil
 }
 
+const retireCharacter = `-- name: RetireCharacter :exec
+UPDATE characters
+SET retired_at = CURRENT_TIMESTAMP,
+    updated_at = datetime('now')
+WHERE id = ? AND retired_at IS NULL
+`
+
+func (q *Queries) RetireCharacter(ctx context.Context, id int64) error {
+	_, err := q.exec(ctx, q.retireCharacterStmt, retireCharacter, id)
+	return err
+}
+
 const updateCharacter = `-- name: UpdateCharacter :execresult
 UPDATE characters
 SET name = ?,
     class = ?,
+    kindred = ?,
     level = ?,
     strength = ?,
     dexterity = ?,
@@ -279,6 +312,7 @@ WHERE id = ?
 type UpdateCharacterParams struct {
 	Name               string
 	Class              string
+	Kindred            string
 	Level              int64
 	Strength           int64
 	Dexterity          int64
@@ -297,6 +331,7 @@ func (q *Queries) UpdateCharacter(ctx context.Context, arg UpdateCharacterParams
 	return q.exec(ctx, q.updateCharacterStmt, updateCharacter,
 		arg.Name,
 		arg.Class,
+		arg.Kindred,
 		arg.Level,
 		arg.Strength,
 		arg.Dexterity,
@@ -311,3 +346,29 @@ func (q *Queries) UpdateCharacter(ctx context.Context, arg UpdateCharacterParams
 		arg.ID,
 	)
 }
+
+const updateCharacterHitPoints = `-- name: UpdateCharacterHitPoints :exec
+UPDATE characters
+SET current_hit_points = ?,
+    temporary_hit_points = ?,
+    stabilized = ?,
+    updated_at = datetime('now')
+WHERE id = ?
+`
+
+type UpdateCharacterHitPointsParams struct {
+	CurrentHitPoints   int64
+	TemporaryHitPoints int64
+	Stabilized         bool
+	ID                 int64
+}
+
+func (q *Queries) UpdateCharacterHitPoints(ctx context.Context, arg UpdateCharacterHitPointsParams) error {
+	_, err := q.exec(ctx, q.updateCharacterHitPointsStmt, updateCharacterHitPoints,
+		arg.CurrentHitPoints,
+		arg.TemporaryHitPoints,
+		arg.Stabilized,
+		arg.ID,
+	)
+	return err
+}
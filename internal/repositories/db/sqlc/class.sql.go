@@ -90,29 +90,11 @@ func (q *Queries) GetBardIllusionistSpells(ctx context.Context, level int64) (Ba
 	return i, err
 }
 
-const getBerserkerNaturalAC = `-- name: GetBerserkerNaturalAC :one
-SELECT natural_ac FROM berserker_natural_ac
-WHERE class_name = ? AND level = ?
-`
-
-type GetBerserkerNaturalACParams struct {
-	ClassName string
-	Level     int64
-}
-
-func (q *Queries) GetBerserkerNaturalAC(ctx context.Context, arg GetBerserkerNaturalACParams) (int64, error) {
-	row := q.queryRow(ctx, q.getBerserkerNaturalACStmt, getBerserkerNaturalAC, arg.ClassName, arg.Level)
-	var natural_ac int64
-	err := row.Scan(&natural_ac)
-	return natural_ac, err
-}
-
 const getClassAbilities = `-- name: GetClassAbilities :many
-SELECT a.id, a.name, a.description, cam.min_level
-FROM abilities a
-JOIN class_ability_mapping cam ON a.id = cam.ability_id
-WHERE cam.class_name = ?
-ORDER BY cam.min_level, a.name
+SELECT id, name, description, min_level
+FROM class_abilities
+WHERE class_name = ?
+ORDER BY min_level, name
 `
 
 type GetClassAbilitiesRow struct {
@@ -151,11 +133,10 @@ func (q *Queries) GetClassAbilities(ctx context.Context, className string) ([]Ge
 }
 
 const getClassAbilitiesByLevel = `-- name: GetClassAbilitiesByLevel :many
-SELECT a.id, a.name, a.description, cam.min_level
-FROM abilities a
-JOIN class_ability_mapping cam ON a.id = cam.ability_id
-WHERE cam.class_name = ? AND cam.min_level <= ?
-ORDER BY cam.min_level, a.name
+SELECT id, name, description, min_level
+FROM class_abilities
+WHERE class_name = ? AND min_level <= ?
+ORDER BY min_level, name
 `
 
 type GetClassAbilitiesByLevelParams struct {
@@ -198,6 +179,24 @@ func (q *Queries) GetClassAbilitiesByLevel(ctx context.Context, arg GetClassAbil
 	return items, nil
 }
 
+const getClassBackstabMultiplier = `-- name: GetClassBackstabMultiplier :one
+SELECT multiplier FROM class_backstab_multipliers
+WHERE class_name = ? AND min_level <= ?
+ORDER BY min_level DESC LIMIT 1
+`
+
+type GetClassBackstabMultiplierParams struct {
+	ClassName string
+	MinLevel  int64
+}
+
+func (q *Queries) GetClassBackstabMultiplier(ctx context.Context, arg GetClassBackstabMultiplierParams) (int64, error) {
+	row := q.queryRow(ctx, q.getClassBackstabMultiplierStmt, getClassBackstabMultiplier, arg.ClassName, arg.MinLevel)
+	var multiplier int64
+	err := row.Scan(&multiplier)
+	return multiplier, err
+}
+
 const getClassData = `-- name: GetClassData :one
 SELECT id, class_name, level, experience_points, hit_dice, saving_throw, fighting_ability, casting_ability, spell_slots_level1, spell_slots_level2, spell_slots_level3, spell_slots_level4, spell_slots_level5, spell_slots_level6 FROM class_data
 WHERE class_name = ? AND level = ?
@@ -230,49 +229,163 @@ func (q *Queries) GetClassData(ctx context.Context, arg GetClassDataParams) (Cla
 	return i, err
 }
 
-const getClericTurningAbility = `-- name: GetClericTurningAbility :one
-SELECT turning_ability FROM cleric_turning_ability
-WHERE class_name = 'Cleric' AND level = ?
+const getClassExtraSpellSlots = `-- name: GetClassExtraSpellSlots :many
+SELECT slot_key, slots FROM class_extra_spell_slots
+WHERE class_name = ? AND level = ?
+ORDER BY slot_key
 `
 
-func (q *Queries) GetClericTurningAbility(ctx context.Context, level int64) (int64, error) {
-	row := q.queryRow(ctx, q.getClericTurningAbilityStmt, getClericTurningAbility, level)
-	var turning_ability int64
-	err := row.Scan(&turning_ability)
-	return turning_ability, err
+type GetClassExtraSpellSlotsParams struct {
+	ClassName string
+	Level     int64
 }
 
-const getMonkACBonus = `-- name: GetMonkACBonus :one
-SELECT ac_bonus FROM monk_ac_bonus
-WHERE level = ?
+type GetClassExtraSpellSlotsRow struct {
+	SlotKey string
+	Slots   int64
+}
+
+func (q *Queries) GetClassExtraSpellSlots(ctx context.Context, arg GetClassExtraSpellSlotsParams) ([]GetClassExtraSpellSlotsRow, error) {
+	rows, err := q.query(ctx, q.getClassExtraSpellSlotsStmt, getClassExtraSpellSlots, arg.ClassName, arg.Level)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetClassExtraSpellSlotsRow{}
+	for rows.Next() {
+		var i GetClassExtraSpellSlotsRow
+		if err := rows.Scan(
+			&i.SlotKey,
+			&i.Slots,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getClassMartialArts = `-- name: GetClassMartialArts :one
+SELECT ac_bonus, empty_hand_damage FROM class_martial_arts
+WHERE class_name = ? AND level = ?
 `
 
-func (q *Queries) GetMonkACBonus(ctx context.Context, level int64) (int64, error) {
-	row := q.queryRow(ctx, q.getMonkACBonusStmt, getMonkACBonus, level)
-	var ac_bonus int64
-	err := row.Scan(&ac_bonus)
-	return ac_bonus, err
+type GetClassMartialArtsParams struct {
+	ClassName string
+	Level     int64
 }
 
-const getMonkEmptyHandDamage = `-- name: GetMonkEmptyHandDamage :one
-SELECT damage FROM monk_empty_hand_damage
-WHERE level = ?
+type GetClassMartialArtsRow struct {
+	AcBonus         int64
+	EmptyHandDamage string
+}
+
+func (q *Queries) GetClassMartialArts(ctx context.Context, arg GetClassMartialArtsParams) (GetClassMartialArtsRow, error) {
+	row := q.queryRow(ctx, q.getClassMartialArtsStmt, getClassMartialArts, arg.ClassName, arg.Level)
+	var i GetClassMartialArtsRow
+	err := row.Scan(
+		&i.AcBonus,
+		&i.EmptyHandDamage,
+	)
+	return i, err
+}
+
+const getClassNaturalAC = `-- name: GetClassNaturalAC :one
+SELECT natural_ac FROM class_natural_ac
+WHERE class_name = ? AND level = ?
 `
 
-func (q *Queries) GetMonkEmptyHandDamage(ctx context.Context, level int64) (string, error) {
-	row := q.queryRow(ctx, q.getMonkEmptyHandDamageStmt, getMonkEmptyHandDamage, level)
-	var damage string
-	err := row.Scan(&damage)
-	return damage, err
+type GetClassNaturalACParams struct {
+	ClassName string
+	Level     int64
+}
+
+func (q *Queries) GetClassNaturalAC(ctx context.Context, arg GetClassNaturalACParams) (int64, error) {
+	row := q.queryRow(ctx, q.getClassNaturalACStmt, getClassNaturalAC, arg.ClassName, arg.Level)
+	var natural_ac int64
+	err := row.Scan(&natural_ac)
+	return natural_ac, err
+}
+
+const getClassRules = `-- name: GetClassRules :one
+SELECT class_name, death_save_bonus, transformation_save_bonus, device_save_bonus, sorcery_save_bonus, avoidance_save_bonus, surprise_chance, max_armor, shields_allowed, hooks, prime_attributes, caster_type, casting_class, casting_attribute FROM class_rules
+WHERE class_name = ? LIMIT 1
+`
+
+func (q *Queries) GetClassRules(ctx context.Context, className string) (ClassRule, error) {
+	row := q.queryRow(ctx, q.getClassRulesStmt, getClassRules, className)
+	var i ClassRule
+	err := row.Scan(
+		&i.ClassName,
+		&i.DeathSaveBonus,
+		&i.TransformationSaveBonus,
+		&i.DeviceSaveBonus,
+		&i.SorcerySaveBonus,
+		&i.AvoidanceSaveBonus,
+		&i.SurpriseChance,
+		&i.MaxArmor,
+		&i.ShieldsAllowed,
+		&i.Hooks,
+		&i.PrimeAttributes,
+		&i.CasterType,
+		&i.CastingClass,
+		&i.CastingAttribute,
+	)
+	return i, err
 }
 
-const getNecromancerTurningAbility = `-- name: GetNecromancerTurningAbility :one
-SELECT turning_ability FROM necromancer_turning_ability
-WHERE class_name = 'Necromancer' AND level = ?
+const getClassRunesPerDay = `-- name: GetClassRunesPerDay :one
+SELECT level1, level2, level3, level4, level5, level6
+FROM class_runes_per_day
+WHERE class_name = ? AND level = ?
 `
 
-func (q *Queries) GetNecromancerTurningAbility(ctx context.Context, level int64) (int64, error) {
-	row := q.queryRow(ctx, q.getNecromancerTurningAbilityStmt, getNecromancerTurningAbility, level)
+type GetClassRunesPerDayParams struct {
+	ClassName string
+	Level     int64
+}
+
+type GetClassRunesPerDayRow struct {
+	Level1 sql.NullInt64
+	Level2 sql.NullInt64
+	Level3 sql.NullInt64
+	Level4 sql.NullInt64
+	Level5 sql.NullInt64
+	Level6 sql.NullInt64
+}
+
+func (q *Queries) GetClassRunesPerDay(ctx context.Context, arg GetClassRunesPerDayParams) (GetClassRunesPerDayRow, error) {
+	row := q.queryRow(ctx, q.getClassRunesPerDayStmt, getClassRunesPerDay, arg.ClassName, arg.Level)
+	var i GetClassRunesPerDayRow
+	err := row.Scan(
+		&i.Level1,
+		&i.Level2,
+		&i.Level3,
+		&i.Level4,
+		&i.Level5,
+		&i.Level6,
+	)
+	return i, err
+}
+
+const getClassTurningAbility = `-- name: GetClassTurningAbility :one
+SELECT turning_ability FROM class_turning_ability
+WHERE class_name = ? AND level = ?
+`
+
+type GetClassTurningAbilityParams struct {
+	ClassName string
+	Level     int64
+}
+
+func (q *Queries) GetClassTurningAbility(ctx context.Context, arg GetClassTurningAbilityParams) (int64, error) {
+	row := q.queryRow(ctx, q.getClassTurningAbilityStmt, getClassTurningAbility, arg.ClassName, arg.Level)
 	var turning_ability int64
 	err := row.Scan(&turning_ability)
 	return turning_ability, err
@@ -312,18 +425,6 @@ func (q *Queries) GetNextLevelData(ctx context.Context, arg GetNextLevelDataPara
 	return i, err
 }
 
-const getPaladinTurningAbility = `-- name: GetPaladinTurningAbility :one
-SELECT turning_ability FROM paladin_turning_ability
-WHERE class_name = 'Paladin' AND level = ?
-`
-
-func (q *Queries) GetPaladinTurningAbility(ctx context.Context, level int64) (int64, error) {
-	row := q.queryRow(ctx, q.getPaladinTurningAbilityStmt, getPaladinTurningAbility, level)
-	var turning_ability int64
-	err := row.Scan(&turning_ability)
-	return turning_ability, err
-}
-
 const getRangerDruidSpellSlots = `-- name: GetRangerDruidSpellSlots :many
 SELECT spell_level, slots FROM ranger_druid_spell_slots
 WHERE class_level <= ?
@@ -344,7 +445,10 @@ func (q *Queries) GetRangerDruidSpellSlots(ctx context.Context, classLevel int64
 	items := []GetRangerDruidSpellSlotsRow{}
 	for rows.Next() {
 		var i GetRangerDruidSpellSlotsRow
-		if err := rows.Scan(&i.SpellLevel, &i.Slots); err != nil {
+		if err := rows.Scan(
+			&i.SpellLevel,
+			&i.Slots,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -378,7 +482,10 @@ func (q *Queries) GetRangerMagicianSpellSlots(ctx context.Context, classLevel in
 	items := []GetRangerMagicianSpellSlotsRow{}
 	for rows.Next() {
 		var i GetRangerMagicianSpellSlotsRow
-		if err := rows.Scan(&i.SpellLevel, &i.Slots); err != nil {
+		if err := rows.Scan(
+			&i.SpellLevel,
+			&i.Slots,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -392,35 +499,6 @@ func (q *Queries) GetRangerMagicianSpellSlots(ctx context.Context, classLevel in
 	return items, nil
 }
 
-const getRunesPerDay = `-- name: GetRunesPerDay :one
-SELECT level1, level2, level3, level4, level5, level6
-FROM runes_per_day
-WHERE class_name = 'Runegraver' AND level = ?
-`
-
-type GetRunesPerDayRow struct {
-	Level1 sql.NullInt64
-	Level2 sql.NullInt64
-	Level3 sql.NullInt64
-	Level4 sql.NullInt64
-	Level5 sql.NullInt64
-	Level6 sql.NullInt64
-}
-
-func (q *Queries) GetRunesPerDay(ctx context.Context, level int64) (GetRunesPerDayRow, error) {
-	row := q.queryRow(ctx, q.getRunesPerDayStmt, getRunesPerDay, level)
-	var i GetRunesPerDayRow
-	err := row.Scan(
-		&i.Level1,
-		&i.Level2,
-		&i.Level3,
-		&i.Level4,
-		&i.Level5,
-		&i.Level6,
-	)
-	return i, err
-}
-
 const getShamanArcaneSpells = `-- name: GetShamanArcaneSpells :one
 SELECT level, spell_slots_level1, spell_slots_level2, spell_slots_level3, spell_slots_level4, spell_slots_level5, spell_slots_level6 FROM shaman_arcane_spells
 WHERE level = ?
@@ -460,3 +538,46 @@ func (q *Queries) GetShamanDivineSpells(ctx context.Context, level int64) (Shama
 	)
 	return i, err
 }
+
+const listClassRules = `-- name: ListClassRules :many
+SELECT class_name, death_save_bonus, transformation_save_bonus, device_save_bonus, sorcery_save_bonus, avoidance_save_bonus, surprise_chance, max_armor, shields_allowed, hooks, prime_attributes, caster_type, casting_class, casting_attribute FROM class_rules
+ORDER BY class_name
+`
+
+func (q *Queries) ListClassRules(ctx context.Context) ([]ClassRule, error) {
+	rows, err := q.query(ctx, q.listClassRulesStmt, listClassRules)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ClassRule{}
+	for rows.Next() {
+		var i ClassRule
+		if err := rows.Scan(
+			&i.ClassName,
+			&i.DeathSaveBonus,
+			&i.TransformationSaveBonus,
+			&i.DeviceSaveBonus,
+			&i.SorcerySaveBonus,
+			&i.AvoidanceSaveBonus,
+			&i.SurpriseChance,
+			&i.MaxArmor,
+			&i.ShieldsAllowed,
+			&i.Hooks,
+			&i.PrimeAttributes,
+			&i.CasterType,
+			&i.CastingClass,
+			&i.CastingAttribute,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -24,45 +24,144 @@ func New(db DBTX) *Queries {
 func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	q := Queries{db: db}
 	var err error
+	if q.addCharacterConditionStmt, err = db.PrepareContext(ctx, addCharacterCondition); err != nil {
+		return nil, fmt.Errorf("error preparing query AddCharacterCondition: %w", err)
+	}
+	if q.addEncounterParticipantStmt, err = db.PrepareContext(ctx, addEncounterParticipant); err != nil {
+		return nil, fmt.Errorf("error preparing query AddEncounterParticipant: %w", err)
+	}
 	if q.addInventoryItemStmt, err = db.PrepareContext(ctx, addInventoryItem); err != nil {
 		return nil, fmt.Errorf("error preparing query AddInventoryItem: %w", err)
 	}
 	if q.addKnownSpellStmt, err = db.PrepareContext(ctx, addKnownSpell); err != nil {
 		return nil, fmt.Errorf("error preparing query AddKnownSpell: %w", err)
 	}
+	if q.addSpellbookSpellStmt, err = db.PrepareContext(ctx, addSpellbookSpell); err != nil {
+		return nil, fmt.Errorf("error preparing query AddSpellbookSpell: %w", err)
+	}
 	if q.addWeaponMasteryStmt, err = db.PrepareContext(ctx, addWeaponMastery); err != nil {
 		return nil, fmt.Errorf("error preparing query AddWeaponMastery: %w", err)
 	}
+	if q.advanceActiveEffectsStmt, err = db.PrepareContext(ctx, advanceActiveEffects); err != nil {
+		return nil, fmt.Errorf("error preparing query AdvanceActiveEffects: %w", err)
+	}
+	if q.advanceCharacterConditionsStmt, err = db.PrepareContext(ctx, advanceCharacterConditions); err != nil {
+		return nil, fmt.Errorf("error preparing query AdvanceCharacterConditions: %w", err)
+	}
+	if q.claimAbilityRollStmt, err = db.PrepareContext(ctx, claimAbilityRoll); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimAbilityRoll: %w", err)
+	}
 	if q.clearPreparedSpellsStmt, err = db.PrepareContext(ctx, clearPreparedSpells); err != nil {
 		return nil, fmt.Errorf("error preparing query ClearPreparedSpells: %w", err)
 	}
+	if q.countCarriedSpellbooksWithSpellStmt, err = db.PrepareContext(ctx, countCarriedSpellbooksWithSpell); err != nil {
+		return nil, fmt.Errorf("error preparing query CountCarriedSpellbooksWithSpell: %w", err)
+	}
+	if q.countCharactersByClassStmt, err = db.PrepareContext(ctx, countCharactersByClass); err != nil {
+		return nil, fmt.Errorf("error preparing query CountCharactersByClass: %w", err)
+	}
+	if q.countInventoryItemsByItemStmt, err = db.PrepareContext(ctx, countInventoryItemsByItem); err != nil {
+		return nil, fmt.Errorf("error preparing query CountInventoryItemsByItem: %w", err)
+	}
+	if q.countPreparedSpellInstancesStmt, err = db.PrepareContext(ctx, countPreparedSpellInstances); err != nil {
+		return nil, fmt.Errorf("error preparing query CountPreparedSpellInstances: %w", err)
+	}
 	if q.countPreparedSpellsByLevelAndClassStmt, err = db.PrepareContext(ctx, countPreparedSpellsByLevelAndClass); err != nil {
 		return nil, fmt.Errorf("error preparing query CountPreparedSpellsByLevelAndClass: %w", err)
 	}
+	if q.countSpellReferencesStmt, err = db.PrepareContext(ctx, countSpellReferences); err != nil {
+		return nil, fmt.Errorf("error preparing query CountSpellReferences: %w", err)
+	}
+	if q.countUnrecoveredTurnUndeadAttemptsStmt, err = db.PrepareContext(ctx, countUnrecoveredTurnUndeadAttempts); err != nil {
+		return nil, fmt.Errorf("error preparing query CountUnrecoveredTurnUndeadAttempts: %w", err)
+	}
+	if q.countUnusedDiceAbilityRollsStmt, err = db.PrepareContext(ctx, countUnusedDiceAbilityRolls); err != nil {
+		return nil, fmt.Errorf("error preparing query CountUnusedDiceAbilityRolls: %w", err)
+	}
+	if q.countUsersByRoleStmt, err = db.PrepareContext(ctx, countUsersByRole); err != nil {
+		return nil, fmt.Errorf("error preparing query CountUsersByRole: %w", err)
+	}
 	if q.countWeaponMasteriesStmt, err = db.PrepareContext(ctx, countWeaponMasteries); err != nil {
 		return nil, fmt.Errorf("error preparing query CountWeaponMasteries: %w", err)
 	}
+	if q.createAbilityStmt, err = db.PrepareContext(ctx, createAbility); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateAbility: %w", err)
+	}
+	if q.createAbilityRollStmt, err = db.PrepareContext(ctx, createAbilityRoll); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateAbilityRoll: %w", err)
+	}
+	if q.createActiveEffectStmt, err = db.PrepareContext(ctx, createActiveEffect); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateActiveEffect: %w", err)
+	}
 	if q.createAmmoStmt, err = db.PrepareContext(ctx, createAmmo); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateAmmo: %w", err)
 	}
 	if q.createArmorStmt, err = db.PrepareContext(ctx, createArmor); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateArmor: %w", err)
 	}
+	if q.createAttributeAdjustmentStmt, err = db.PrepareContext(ctx, createAttributeAdjustment); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateAttributeAdjustment: %w", err)
+	}
 	if q.createCharacterStmt, err = db.PrepareContext(ctx, createCharacter); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateCharacter: %w", err)
 	}
+	if q.createClassAbilityMappingStmt, err = db.PrepareContext(ctx, createClassAbilityMapping); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateClassAbilityMapping: %w", err)
+	}
+	if q.createClassBackstabMultiplierStmt, err = db.PrepareContext(ctx, createClassBackstabMultiplier); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateClassBackstabMultiplier: %w", err)
+	}
+	if q.createClassLevelStmt, err = db.PrepareContext(ctx, createClassLevel); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateClassLevel: %w", err)
+	}
+	if q.createClassMartialArtsStmt, err = db.PrepareContext(ctx, createClassMartialArts); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateClassMartialArts: %w", err)
+	}
+	if q.createClassNaturalACStmt, err = db.PrepareContext(ctx, createClassNaturalAC); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateClassNaturalAC: %w", err)
+	}
+	if q.createClassRulesStmt, err = db.PrepareContext(ctx, createClassRules); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateClassRules: %w", err)
+	}
+	if q.createClassRunesPerDayStmt, err = db.PrepareContext(ctx, createClassRunesPerDay); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateClassRunesPerDay: %w", err)
+	}
+	if q.createConditionStmt, err = db.PrepareContext(ctx, createCondition); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateCondition: %w", err)
+	}
 	if q.createContainerStmt, err = db.PrepareContext(ctx, createContainer); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateContainer: %w", err)
 	}
+	if q.createContentPackStmt, err = db.PrepareContext(ctx, createContentPack); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateContentPack: %w", err)
+	}
+	if q.createContentPackEntryStmt, err = db.PrepareContext(ctx, createContentPackEntry); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateContentPackEntry: %w", err)
+	}
+	if q.createEncounterStmt, err = db.PrepareContext(ctx, createEncounter); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateEncounter: %w", err)
+	}
 	if q.createEquipmentStmt, err = db.PrepareContext(ctx, createEquipment); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateEquipment: %w", err)
 	}
 	if q.createInventoryStmt, err = db.PrepareContext(ctx, createInventory); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateInventory: %w", err)
 	}
+	if q.createItemUseStmt, err = db.PrepareContext(ctx, createItemUse); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateItemUse: %w", err)
+	}
+	if q.createKindredStmt, err = db.PrepareContext(ctx, createKindred); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateKindred: %w", err)
+	}
+	if q.createLevelUpStmt, err = db.PrepareContext(ctx, createLevelUp); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateLevelUp: %w", err)
+	}
 	if q.createMagicItemStmt, err = db.PrepareContext(ctx, createMagicItem); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateMagicItem: %w", err)
 	}
+	if q.createMonsterStmt, err = db.PrepareContext(ctx, createMonster); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateMonster: %w", err)
+	}
 	if q.createPotionStmt, err = db.PrepareContext(ctx, createPotion); err != nil {
 		return nil, fmt.Errorf("error preparing query CreatePotion: %w", err)
 	}
@@ -75,39 +174,120 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createSpellStmt, err = db.PrepareContext(ctx, createSpell); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateSpell: %w", err)
 	}
+	if q.createSpellCastStmt, err = db.PrepareContext(ctx, createSpellCast); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateSpellCast: %w", err)
+	}
+	if q.createSpellLearningAttemptStmt, err = db.PrepareContext(ctx, createSpellLearningAttempt); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateSpellLearningAttempt: %w", err)
+	}
 	if q.createSpellScrollStmt, err = db.PrepareContext(ctx, createSpellScroll); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateSpellScroll: %w", err)
 	}
+	if q.createSpellbookStmt, err = db.PrepareContext(ctx, createSpellbook); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateSpellbook: %w", err)
+	}
+	if q.createThiefSkillCheckStmt, err = db.PrepareContext(ctx, createThiefSkillCheck); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateThiefSkillCheck: %w", err)
+	}
 	if q.createTreasureStmt, err = db.PrepareContext(ctx, createTreasure); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateTreasure: %w", err)
 	}
+	if q.createTurnUndeadAttemptStmt, err = db.PrepareContext(ctx, createTurnUndeadAttempt); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateTurnUndeadAttempt: %w", err)
+	}
 	if q.createUserStmt, err = db.PrepareContext(ctx, createUser); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateUser: %w", err)
 	}
 	if q.createWeaponStmt, err = db.PrepareContext(ctx, createWeapon); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateWeapon: %w", err)
 	}
+	if q.createXPAwardStmt, err = db.PrepareContext(ctx, createXPAward); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateXPAward: %w", err)
+	}
+	if q.deleteAbilityStmt, err = db.PrepareContext(ctx, deleteAbility); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAbility: %w", err)
+	}
+	if q.deleteActiveEffectStmt, err = db.PrepareContext(ctx, deleteActiveEffect); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteActiveEffect: %w", err)
+	}
 	if q.deleteAmmoStmt, err = db.PrepareContext(ctx, deleteAmmo); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteAmmo: %w", err)
 	}
 	if q.deleteArmorStmt, err = db.PrepareContext(ctx, deleteArmor); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteArmor: %w", err)
 	}
+	if q.deleteAttributeAdjustmentStmt, err = db.PrepareContext(ctx, deleteAttributeAdjustment); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAttributeAdjustment: %w", err)
+	}
 	if q.deleteCharacterStmt, err = db.PrepareContext(ctx, deleteCharacter); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteCharacter: %w", err)
 	}
+	if q.deleteCharacterConditionStmt, err = db.PrepareContext(ctx, deleteCharacterCondition); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteCharacterCondition: %w", err)
+	}
+	if q.deleteCharacterGrantStmt, err = db.PrepareContext(ctx, deleteCharacterGrant); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteCharacterGrant: %w", err)
+	}
+	if q.deleteClassStmt, err = db.PrepareContext(ctx, deleteClass); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteClass: %w", err)
+	}
+	if q.deleteClassAbilityMappingsStmt, err = db.PrepareContext(ctx, deleteClassAbilityMappings); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteClassAbilityMappings: %w", err)
+	}
+	if q.deleteClassBackstabMultipliersStmt, err = db.PrepareContext(ctx, deleteClassBackstabMultipliers); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteClassBackstabMultipliers: %w", err)
+	}
+	if q.deleteClassMartialArtsStmt, err = db.PrepareContext(ctx, deleteClassMartialArts); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteClassMartialArts: %w", err)
+	}
+	if q.deleteClassNaturalACStmt, err = db.PrepareContext(ctx, deleteClassNaturalAC); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteClassNaturalAC: %w", err)
+	}
+	if q.deleteClassRulesStmt, err = db.PrepareContext(ctx, deleteClassRules); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteClassRules: %w", err)
+	}
+	if q.deleteClassRunesPerDayStmt, err = db.PrepareContext(ctx, deleteClassRunesPerDay); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteClassRunesPerDay: %w", err)
+	}
+	if q.deleteConditionStmt, err = db.PrepareContext(ctx, deleteCondition); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteCondition: %w", err)
+	}
 	if q.deleteContainerStmt, err = db.PrepareContext(ctx, deleteContainer); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteContainer: %w", err)
 	}
+	if q.deleteContentPackStmt, err = db.PrepareContext(ctx, deleteContentPack); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteContentPack: %w", err)
+	}
+	if q.deleteContentPackEntriesStmt, err = db.PrepareContext(ctx, deleteContentPackEntries); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteContentPackEntries: %w", err)
+	}
+	if q.deleteEncounterStmt, err = db.PrepareContext(ctx, deleteEncounter); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteEncounter: %w", err)
+	}
+	if q.deleteEncounterParticipantStmt, err = db.PrepareContext(ctx, deleteEncounterParticipant); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteEncounterParticipant: %w", err)
+	}
 	if q.deleteEquipmentStmt, err = db.PrepareContext(ctx, deleteEquipment); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteEquipment: %w", err)
 	}
+	if q.deleteExpiredActiveEffectsStmt, err = db.PrepareContext(ctx, deleteExpiredActiveEffects); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteExpiredActiveEffects: %w", err)
+	}
+	if q.deleteExpiredCharacterConditionsStmt, err = db.PrepareContext(ctx, deleteExpiredCharacterConditions); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteExpiredCharacterConditions: %w", err)
+	}
 	if q.deleteInventoryStmt, err = db.PrepareContext(ctx, deleteInventory); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteInventory: %w", err)
 	}
+	if q.deleteKindredStmt, err = db.PrepareContext(ctx, deleteKindred); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteKindred: %w", err)
+	}
 	if q.deleteMagicItemStmt, err = db.PrepareContext(ctx, deleteMagicItem); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteMagicItem: %w", err)
 	}
+	if q.deleteMonsterStmt, err = db.PrepareContext(ctx, deleteMonster); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteMonster: %w", err)
+	}
 	if q.deletePotionStmt, err = db.PrepareContext(ctx, deletePotion); err != nil {
 		return nil, fmt.Errorf("error preparing query DeletePotion: %w", err)
 	}
@@ -135,6 +315,24 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteWeaponMasteryStmt, err = db.PrepareContext(ctx, deleteWeaponMastery); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteWeaponMastery: %w", err)
 	}
+	if q.expendPreparedSpellStmt, err = db.PrepareContext(ctx, expendPreparedSpell); err != nil {
+		return nil, fmt.Errorf("error preparing query ExpendPreparedSpell: %w", err)
+	}
+	if q.getAbilityRollStmt, err = db.PrepareContext(ctx, getAbilityRoll); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAbilityRoll: %w", err)
+	}
+	if q.getAbilityRollByCharacterStmt, err = db.PrepareContext(ctx, getAbilityRollByCharacter); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAbilityRollByCharacter: %w", err)
+	}
+	if q.getAbilityRollsByUserStmt, err = db.PrepareContext(ctx, getAbilityRollsByUser); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAbilityRollsByUser: %w", err)
+	}
+	if q.getActiveEffectStmt, err = db.PrepareContext(ctx, getActiveEffect); err != nil {
+		return nil, fmt.Errorf("error preparing query GetActiveEffect: %w", err)
+	}
+	if q.getActiveEffectsByCharacterStmt, err = db.PrepareContext(ctx, getActiveEffectsByCharacter); err != nil {
+		return nil, fmt.Errorf("error preparing query GetActiveEffectsByCharacter: %w", err)
+	}
 	if q.getAllClassDataStmt, err = db.PrepareContext(ctx, getAllClassData); err != nil {
 		return nil, fmt.Errorf("error preparing query GetAllClassData: %w", err)
 	}
@@ -150,11 +348,11 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getArmorByNameStmt, err = db.PrepareContext(ctx, getArmorByName); err != nil {
 		return nil, fmt.Errorf("error preparing query GetArmorByName: %w", err)
 	}
-	if q.getBarbarianAbilitiesStmt, err = db.PrepareContext(ctx, getBarbarianAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetBarbarianAbilities: %w", err)
+	if q.getAttributeAdjustmentStmt, err = db.PrepareContext(ctx, getAttributeAdjustment); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAttributeAdjustment: %w", err)
 	}
-	if q.getBardAbilitiesStmt, err = db.PrepareContext(ctx, getBardAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetBardAbilities: %w", err)
+	if q.getAttributeAdjustmentsByCharacterStmt, err = db.PrepareContext(ctx, getAttributeAdjustmentsByCharacter); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAttributeAdjustmentsByCharacter: %w", err)
 	}
 	if q.getBardDruidSpellsStmt, err = db.PrepareContext(ctx, getBardDruidSpells); err != nil {
 		return nil, fmt.Errorf("error preparing query GetBardDruidSpells: %w", err)
@@ -162,21 +360,33 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getBardIllusionistSpellsStmt, err = db.PrepareContext(ctx, getBardIllusionistSpells); err != nil {
 		return nil, fmt.Errorf("error preparing query GetBardIllusionistSpells: %w", err)
 	}
-	if q.getBerserkerAbilitiesStmt, err = db.PrepareContext(ctx, getBerserkerAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetBerserkerAbilities: %w", err)
-	}
-	if q.getBerserkerNaturalACStmt, err = db.PrepareContext(ctx, getBerserkerNaturalAC); err != nil {
-		return nil, fmt.Errorf("error preparing query GetBerserkerNaturalAC: %w", err)
-	}
-	if q.getCataphractAbilitiesStmt, err = db.PrepareContext(ctx, getCataphractAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetCataphractAbilities: %w", err)
-	}
 	if q.getCharacterStmt, err = db.PrepareContext(ctx, getCharacter); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCharacter: %w", err)
 	}
+	if q.getCharacterConditionStmt, err = db.PrepareContext(ctx, getCharacterCondition); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCharacterCondition: %w", err)
+	}
+	if q.getCharacterConditionsStmt, err = db.PrepareContext(ctx, getCharacterConditions); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCharacterConditions: %w", err)
+	}
 	if q.getCharacterForSpellcastingStmt, err = db.PrepareContext(ctx, getCharacterForSpellcasting); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCharacterForSpellcasting: %w", err)
 	}
+	if q.getCharacterGrantStmt, err = db.PrepareContext(ctx, getCharacterGrant); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCharacterGrant: %w", err)
+	}
+	if q.getCharacterGrantsStmt, err = db.PrepareContext(ctx, getCharacterGrants); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCharacterGrants: %w", err)
+	}
+	if q.getCharacterGrantsByUserStmt, err = db.PrepareContext(ctx, getCharacterGrantsByUser); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCharacterGrantsByUser: %w", err)
+	}
+	if q.getCharacterInventoryItemStmt, err = db.PrepareContext(ctx, getCharacterInventoryItem); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCharacterInventoryItem: %w", err)
+	}
+	if q.getCharacterSpellScrollItemStmt, err = db.PrepareContext(ctx, getCharacterSpellScrollItem); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCharacterSpellScrollItem: %w", err)
+	}
 	if q.getCharactersByUserStmt, err = db.PrepareContext(ctx, getCharactersByUser); err != nil {
 		return nil, fmt.Errorf("error preparing query GetCharactersByUser: %w", err)
 	}
@@ -186,17 +396,38 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getClassAbilitiesByLevelStmt, err = db.PrepareContext(ctx, getClassAbilitiesByLevel); err != nil {
 		return nil, fmt.Errorf("error preparing query GetClassAbilitiesByLevel: %w", err)
 	}
+	if q.getClassBackstabMultiplierStmt, err = db.PrepareContext(ctx, getClassBackstabMultiplier); err != nil {
+		return nil, fmt.Errorf("error preparing query GetClassBackstabMultiplier: %w", err)
+	}
 	if q.getClassDataStmt, err = db.PrepareContext(ctx, getClassData); err != nil {
 		return nil, fmt.Errorf("error preparing query GetClassData: %w", err)
 	}
 	if q.getClassDataForSpellcastingStmt, err = db.PrepareContext(ctx, getClassDataForSpellcasting); err != nil {
 		return nil, fmt.Errorf("error preparing query GetClassDataForSpellcasting: %w", err)
 	}
-	if q.getClericAbilitiesStmt, err = db.PrepareContext(ctx, getClericAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetClericAbilities: %w", err)
+	if q.getClassExtraSpellSlotsStmt, err = db.PrepareContext(ctx, getClassExtraSpellSlots); err != nil {
+		return nil, fmt.Errorf("error preparing query GetClassExtraSpellSlots: %w", err)
+	}
+	if q.getClassMartialArtsStmt, err = db.PrepareContext(ctx, getClassMartialArts); err != nil {
+		return nil, fmt.Errorf("error preparing query GetClassMartialArts: %w", err)
+	}
+	if q.getClassNaturalACStmt, err = db.PrepareContext(ctx, getClassNaturalAC); err != nil {
+		return nil, fmt.Errorf("error preparing query GetClassNaturalAC: %w", err)
+	}
+	if q.getClassRulesStmt, err = db.PrepareContext(ctx, getClassRules); err != nil {
+		return nil, fmt.Errorf("error preparing query GetClassRules: %w", err)
 	}
-	if q.getClericTurningAbilityStmt, err = db.PrepareContext(ctx, getClericTurningAbility); err != nil {
-		return nil, fmt.Errorf("error preparing query GetClericTurningAbility: %w", err)
+	if q.getClassRunesPerDayStmt, err = db.PrepareContext(ctx, getClassRunesPerDay); err != nil {
+		return nil, fmt.Errorf("error preparing query GetClassRunesPerDay: %w", err)
+	}
+	if q.getClassTurningAbilityStmt, err = db.PrepareContext(ctx, getClassTurningAbility); err != nil {
+		return nil, fmt.Errorf("error preparing query GetClassTurningAbility: %w", err)
+	}
+	if q.getConditionStmt, err = db.PrepareContext(ctx, getCondition); err != nil {
+		return nil, fmt.Errorf("error preparing query GetCondition: %w", err)
+	}
+	if q.getConditionByNameStmt, err = db.PrepareContext(ctx, getConditionByName); err != nil {
+		return nil, fmt.Errorf("error preparing query GetConditionByName: %w", err)
 	}
 	if q.getContainerStmt, err = db.PrepareContext(ctx, getContainer); err != nil {
 		return nil, fmt.Errorf("error preparing query GetContainer: %w", err)
@@ -204,11 +435,20 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getContainerByNameStmt, err = db.PrepareContext(ctx, getContainerByName); err != nil {
 		return nil, fmt.Errorf("error preparing query GetContainerByName: %w", err)
 	}
-	if q.getCryomancerAbilitiesStmt, err = db.PrepareContext(ctx, getCryomancerAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetCryomancerAbilities: %w", err)
+	if q.getContentPackStmt, err = db.PrepareContext(ctx, getContentPack); err != nil {
+		return nil, fmt.Errorf("error preparing query GetContentPack: %w", err)
+	}
+	if q.getContentPackByNamespaceStmt, err = db.PrepareContext(ctx, getContentPackByNamespace); err != nil {
+		return nil, fmt.Errorf("error preparing query GetContentPackByNamespace: %w", err)
 	}
-	if q.getDruidAbilitiesStmt, err = db.PrepareContext(ctx, getDruidAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetDruidAbilities: %w", err)
+	if q.getEncounterStmt, err = db.PrepareContext(ctx, getEncounter); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEncounter: %w", err)
+	}
+	if q.getEncounterParticipantsStmt, err = db.PrepareContext(ctx, getEncounterParticipants); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEncounterParticipants: %w", err)
+	}
+	if q.getEncountersByUserStmt, err = db.PrepareContext(ctx, getEncountersByUser); err != nil {
+		return nil, fmt.Errorf("error preparing query GetEncountersByUser: %w", err)
 	}
 	if q.getEquipmentStmt, err = db.PrepareContext(ctx, getEquipment); err != nil {
 		return nil, fmt.Errorf("error preparing query GetEquipment: %w", err)
@@ -219,18 +459,12 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getEquippedItemsStmt, err = db.PrepareContext(ctx, getEquippedItems); err != nil {
 		return nil, fmt.Errorf("error preparing query GetEquippedItems: %w", err)
 	}
-	if q.getFighterAbilitiesStmt, err = db.PrepareContext(ctx, getFighterAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetFighterAbilities: %w", err)
+	if q.getFailedSpellLearningAttemptStmt, err = db.PrepareContext(ctx, getFailedSpellLearningAttempt); err != nil {
+		return nil, fmt.Errorf("error preparing query GetFailedSpellLearningAttempt: %w", err)
 	}
 	if q.getFullUserByEmailStmt, err = db.PrepareContext(ctx, getFullUserByEmail); err != nil {
 		return nil, fmt.Errorf("error preparing query GetFullUserByEmail: %w", err)
 	}
-	if q.getHuntsmanAbilitiesStmt, err = db.PrepareContext(ctx, getHuntsmanAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetHuntsmanAbilities: %w", err)
-	}
-	if q.getIllusionistAbilitiesStmt, err = db.PrepareContext(ctx, getIllusionistAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetIllusionistAbilities: %w", err)
-	}
 	if q.getInventoryStmt, err = db.PrepareContext(ctx, getInventory); err != nil {
 		return nil, fmt.Errorf("error preparing query GetInventory: %w", err)
 	}
@@ -249,9 +483,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getInventoryItemsByTypeStmt, err = db.PrepareContext(ctx, getInventoryItemsByType); err != nil {
 		return nil, fmt.Errorf("error preparing query GetInventoryItemsByType: %w", err)
 	}
+	if q.getItemUseStmt, err = db.PrepareContext(ctx, getItemUse); err != nil {
+		return nil, fmt.Errorf("error preparing query GetItemUse: %w", err)
+	}
+	if q.getItemUsesByCharacterStmt, err = db.PrepareContext(ctx, getItemUsesByCharacter); err != nil {
+		return nil, fmt.Errorf("error preparing query GetItemUsesByCharacter: %w", err)
+	}
 	if q.getItemsBySlotStmt, err = db.PrepareContext(ctx, getItemsBySlot); err != nil {
 		return nil, fmt.Errorf("error preparing query GetItemsBySlot: %w", err)
 	}
+	if q.getKindredStmt, err = db.PrepareContext(ctx, getKindred); err != nil {
+		return nil, fmt.Errorf("error preparing query GetKindred: %w", err)
+	}
+	if q.getKindredByNameStmt, err = db.PrepareContext(ctx, getKindredByName); err != nil {
+		return nil, fmt.Errorf("error preparing query GetKindredByName: %w", err)
+	}
 	if q.getKnownSpellByCharacterAndSpellStmt, err = db.PrepareContext(ctx, getKnownSpellByCharacterAndSpell); err != nil {
 		return nil, fmt.Errorf("error preparing query GetKnownSpellByCharacterAndSpell: %w", err)
 	}
@@ -261,8 +507,11 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getKnownSpellsByClassStmt, err = db.PrepareContext(ctx, getKnownSpellsByClass); err != nil {
 		return nil, fmt.Errorf("error preparing query GetKnownSpellsByClass: %w", err)
 	}
-	if q.getLegerdemainistAbilitiesStmt, err = db.PrepareContext(ctx, getLegerdemainistAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetLegerdemainistAbilities: %w", err)
+	if q.getLevelUpStmt, err = db.PrepareContext(ctx, getLevelUp); err != nil {
+		return nil, fmt.Errorf("error preparing query GetLevelUp: %w", err)
+	}
+	if q.getLevelUpsByCharacterStmt, err = db.PrepareContext(ctx, getLevelUpsByCharacter); err != nil {
+		return nil, fmt.Errorf("error preparing query GetLevelUpsByCharacter: %w", err)
 	}
 	if q.getMagicItemStmt, err = db.PrepareContext(ctx, getMagicItem); err != nil {
 		return nil, fmt.Errorf("error preparing query GetMagicItem: %w", err)
@@ -270,23 +519,11 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getMagicItemByNameStmt, err = db.PrepareContext(ctx, getMagicItemByName); err != nil {
 		return nil, fmt.Errorf("error preparing query GetMagicItemByName: %w", err)
 	}
-	if q.getMagicianAbilitiesStmt, err = db.PrepareContext(ctx, getMagicianAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetMagicianAbilities: %w", err)
-	}
-	if q.getMonkACBonusStmt, err = db.PrepareContext(ctx, getMonkACBonus); err != nil {
-		return nil, fmt.Errorf("error preparing query GetMonkACBonus: %w", err)
+	if q.getMonsterStmt, err = db.PrepareContext(ctx, getMonster); err != nil {
+		return nil, fmt.Errorf("error preparing query GetMonster: %w", err)
 	}
-	if q.getMonkAbilitiesStmt, err = db.PrepareContext(ctx, getMonkAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetMonkAbilities: %w", err)
-	}
-	if q.getMonkEmptyHandDamageStmt, err = db.PrepareContext(ctx, getMonkEmptyHandDamage); err != nil {
-		return nil, fmt.Errorf("error preparing query GetMonkEmptyHandDamage: %w", err)
-	}
-	if q.getNecromancerAbilitiesStmt, err = db.PrepareContext(ctx, getNecromancerAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetNecromancerAbilities: %w", err)
-	}
-	if q.getNecromancerTurningAbilityStmt, err = db.PrepareContext(ctx, getNecromancerTurningAbility); err != nil {
-		return nil, fmt.Errorf("error preparing query GetNecromancerTurningAbility: %w", err)
+	if q.getMonsterByNameStmt, err = db.PrepareContext(ctx, getMonsterByName); err != nil {
+		return nil, fmt.Errorf("error preparing query GetMonsterByName: %w", err)
 	}
 	if q.getNextAvailableSlotIndexStmt, err = db.PrepareContext(ctx, getNextAvailableSlotIndex); err != nil {
 		return nil, fmt.Errorf("error preparing query GetNextAvailableSlotIndex: %w", err)
@@ -294,11 +531,8 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getNextLevelDataStmt, err = db.PrepareContext(ctx, getNextLevelData); err != nil {
 		return nil, fmt.Errorf("error preparing query GetNextLevelData: %w", err)
 	}
-	if q.getPaladinAbilitiesStmt, err = db.PrepareContext(ctx, getPaladinAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetPaladinAbilities: %w", err)
-	}
-	if q.getPaladinTurningAbilityStmt, err = db.PrepareContext(ctx, getPaladinTurningAbility); err != nil {
-		return nil, fmt.Errorf("error preparing query GetPaladinTurningAbility: %w", err)
+	if q.getNextUnexpendedPreparedSpellStmt, err = db.PrepareContext(ctx, getNextUnexpendedPreparedSpell); err != nil {
+		return nil, fmt.Errorf("error preparing query GetNextUnexpendedPreparedSpell: %w", err)
 	}
 	if q.getPotionStmt, err = db.PrepareContext(ctx, getPotion); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPotion: %w", err)
@@ -306,27 +540,21 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getPotionByNameStmt, err = db.PrepareContext(ctx, getPotionByName); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPotionByName: %w", err)
 	}
+	if q.getPreparedSpellStmt, err = db.PrepareContext(ctx, getPreparedSpell); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPreparedSpell: %w", err)
+	}
 	if q.getPreparedSpellByCharacterAndSpellStmt, err = db.PrepareContext(ctx, getPreparedSpellByCharacterAndSpell); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPreparedSpellByCharacterAndSpell: %w", err)
 	}
+	if q.getPreparedSpellBySlotStmt, err = db.PrepareContext(ctx, getPreparedSpellBySlot); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPreparedSpellBySlot: %w", err)
+	}
 	if q.getPreparedSpellsStmt, err = db.PrepareContext(ctx, getPreparedSpells); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPreparedSpells: %w", err)
 	}
 	if q.getPreparedSpellsByClassStmt, err = db.PrepareContext(ctx, getPreparedSpellsByClass); err != nil {
 		return nil, fmt.Errorf("error preparing query GetPreparedSpellsByClass: %w", err)
 	}
-	if q.getPriestAbilitiesStmt, err = db.PrepareContext(ctx, getPriestAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetPriestAbilities: %w", err)
-	}
-	if q.getPurloinerAbilitiesStmt, err = db.PrepareContext(ctx, getPurloinerAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetPurloinerAbilities: %w", err)
-	}
-	if q.getPyromancerAbilitiesStmt, err = db.PrepareContext(ctx, getPyromancerAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetPyromancerAbilities: %w", err)
-	}
-	if q.getRangerAbilitiesStmt, err = db.PrepareContext(ctx, getRangerAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetRangerAbilities: %w", err)
-	}
 	if q.getRangerDruidSpellSlotsStmt, err = db.PrepareContext(ctx, getRangerDruidSpellSlots); err != nil {
 		return nil, fmt.Errorf("error preparing query GetRangerDruidSpellSlots: %w", err)
 	}
@@ -339,18 +567,6 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getRingByNameStmt, err = db.PrepareContext(ctx, getRingByName); err != nil {
 		return nil, fmt.Errorf("error preparing query GetRingByName: %w", err)
 	}
-	if q.getRunegraverAbilitiesStmt, err = db.PrepareContext(ctx, getRunegraverAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetRunegraverAbilities: %w", err)
-	}
-	if q.getRunesPerDayStmt, err = db.PrepareContext(ctx, getRunesPerDay); err != nil {
-		return nil, fmt.Errorf("error preparing query GetRunesPerDay: %w", err)
-	}
-	if q.getScoutAbilitiesStmt, err = db.PrepareContext(ctx, getScoutAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetScoutAbilities: %w", err)
-	}
-	if q.getShamanAbilitiesStmt, err = db.PrepareContext(ctx, getShamanAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetShamanAbilities: %w", err)
-	}
 	if q.getShamanArcaneSpellsStmt, err = db.PrepareContext(ctx, getShamanArcaneSpells); err != nil {
 		return nil, fmt.Errorf("error preparing query GetShamanArcaneSpells: %w", err)
 	}
@@ -366,20 +582,53 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getSpellStmt, err = db.PrepareContext(ctx, getSpell); err != nil {
 		return nil, fmt.Errorf("error preparing query GetSpell: %w", err)
 	}
+	if q.getSpellCastStmt, err = db.PrepareContext(ctx, getSpellCast); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSpellCast: %w", err)
+	}
+	if q.getSpellCastsByCharacterStmt, err = db.PrepareContext(ctx, getSpellCastsByCharacter); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSpellCastsByCharacter: %w", err)
+	}
 	if q.getSpellForSpellcastingStmt, err = db.PrepareContext(ctx, getSpellForSpellcasting); err != nil {
 		return nil, fmt.Errorf("error preparing query GetSpellForSpellcasting: %w", err)
 	}
+	if q.getSpellLearningAttemptStmt, err = db.PrepareContext(ctx, getSpellLearningAttempt); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSpellLearningAttempt: %w", err)
+	}
+	if q.getSpellLearningAttemptsByCharacterStmt, err = db.PrepareContext(ctx, getSpellLearningAttemptsByCharacter); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSpellLearningAttemptsByCharacter: %w", err)
+	}
 	if q.getSpellScrollStmt, err = db.PrepareContext(ctx, getSpellScroll); err != nil {
 		return nil, fmt.Errorf("error preparing query GetSpellScroll: %w", err)
 	}
 	if q.getSpellScrollsBySpellStmt, err = db.PrepareContext(ctx, getSpellScrollsBySpell); err != nil {
 		return nil, fmt.Errorf("error preparing query GetSpellScrollsBySpell: %w", err)
 	}
+	if q.getSpellbookStmt, err = db.PrepareContext(ctx, getSpellbook); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSpellbook: %w", err)
+	}
+	if q.getSpellbookForCharacterStmt, err = db.PrepareContext(ctx, getSpellbookForCharacter); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSpellbookForCharacter: %w", err)
+	}
+	if q.getSpellbookOwnerStmt, err = db.PrepareContext(ctx, getSpellbookOwner); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSpellbookOwner: %w", err)
+	}
+	if q.getSpellbookSpellStmt, err = db.PrepareContext(ctx, getSpellbookSpell); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSpellbookSpell: %w", err)
+	}
+	if q.getSpellbookSpellsStmt, err = db.PrepareContext(ctx, getSpellbookSpells); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSpellbookSpells: %w", err)
+	}
+	if q.getSpellbooksByCharacterStmt, err = db.PrepareContext(ctx, getSpellbooksByCharacter); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSpellbooksByCharacter: %w", err)
+	}
 	if q.getSpellsByClassLevelStmt, err = db.PrepareContext(ctx, getSpellsByClassLevel); err != nil {
 		return nil, fmt.Errorf("error preparing query GetSpellsByClassLevel: %w", err)
 	}
-	if q.getThiefAbilitiesStmt, err = db.PrepareContext(ctx, getThiefAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetThiefAbilities: %w", err)
+	if q.getThiefSkillCheckStmt, err = db.PrepareContext(ctx, getThiefSkillCheck); err != nil {
+		return nil, fmt.Errorf("error preparing query GetThiefSkillCheck: %w", err)
+	}
+	if q.getThiefSkillChecksByCharacterStmt, err = db.PrepareContext(ctx, getThiefSkillChecksByCharacter); err != nil {
+		return nil, fmt.Errorf("error preparing query GetThiefSkillChecksByCharacter: %w", err)
 	}
 	if q.getThiefSkillsByLevelStmt, err = db.PrepareContext(ctx, getThiefSkillsByLevel); err != nil {
 		return nil, fmt.Errorf("error preparing query GetThiefSkillsByLevel: %w", err)
@@ -390,12 +639,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getTreasureByCharacterStmt, err = db.PrepareContext(ctx, getTreasureByCharacter); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTreasureByCharacter: %w", err)
 	}
+	if q.getTurnUndeadAttemptStmt, err = db.PrepareContext(ctx, getTurnUndeadAttempt); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTurnUndeadAttempt: %w", err)
+	}
+	if q.getTurnUndeadAttemptsByCharacterStmt, err = db.PrepareContext(ctx, getTurnUndeadAttemptsByCharacter); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTurnUndeadAttemptsByCharacter: %w", err)
+	}
 	if q.getUserStmt, err = db.PrepareContext(ctx, getUser); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUser: %w", err)
 	}
-	if q.getWarlockAbilitiesStmt, err = db.PrepareContext(ctx, getWarlockAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetWarlockAbilities: %w", err)
-	}
 	if q.getWeaponStmt, err = db.PrepareContext(ctx, getWeapon); err != nil {
 		return nil, fmt.Errorf("error preparing query GetWeapon: %w", err)
 	}
@@ -411,8 +663,14 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getWeaponMasteryByIDStmt, err = db.PrepareContext(ctx, getWeaponMasteryByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetWeaponMasteryByID: %w", err)
 	}
-	if q.getWitchAbilitiesStmt, err = db.PrepareContext(ctx, getWitchAbilities); err != nil {
-		return nil, fmt.Errorf("error preparing query GetWitchAbilities: %w", err)
+	if q.getXPAwardStmt, err = db.PrepareContext(ctx, getXPAward); err != nil {
+		return nil, fmt.Errorf("error preparing query GetXPAward: %w", err)
+	}
+	if q.getXPAwardReversalStmt, err = db.PrepareContext(ctx, getXPAwardReversal); err != nil {
+		return nil, fmt.Errorf("error preparing query GetXPAwardReversal: %w", err)
+	}
+	if q.getXPAwardsByCharacterStmt, err = db.PrepareContext(ctx, getXPAwardsByCharacter); err != nil {
+		return nil, fmt.Errorf("error preparing query GetXPAwardsByCharacter: %w", err)
 	}
 	if q.listAmmoStmt, err = db.PrepareContext(ctx, listAmmo); err != nil {
 		return nil, fmt.Errorf("error preparing query ListAmmo: %w", err)
@@ -423,21 +681,39 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.listCharactersStmt, err = db.PrepareContext(ctx, listCharacters); err != nil {
 		return nil, fmt.Errorf("error preparing query ListCharacters: %w", err)
 	}
+	if q.listClassRulesStmt, err = db.PrepareContext(ctx, listClassRules); err != nil {
+		return nil, fmt.Errorf("error preparing query ListClassRules: %w", err)
+	}
+	if q.listConditionsStmt, err = db.PrepareContext(ctx, listConditions); err != nil {
+		return nil, fmt.Errorf("error preparing query ListConditions: %w", err)
+	}
 	if q.listContainersStmt, err = db.PrepareContext(ctx, listContainers); err != nil {
 		return nil, fmt.Errorf("error preparing query ListContainers: %w", err)
 	}
+	if q.listContentPackEntriesStmt, err = db.PrepareContext(ctx, listContentPackEntries); err != nil {
+		return nil, fmt.Errorf("error preparing query ListContentPackEntries: %w", err)
+	}
+	if q.listContentPacksStmt, err = db.PrepareContext(ctx, listContentPacks); err != nil {
+		return nil, fmt.Errorf("error preparing query ListContentPacks: %w", err)
+	}
 	if q.listEquipmentStmt, err = db.PrepareContext(ctx, listEquipment); err != nil {
 		return nil, fmt.Errorf("error preparing query ListEquipment: %w", err)
 	}
 	if q.listInventoriesStmt, err = db.PrepareContext(ctx, listInventories); err != nil {
 		return nil, fmt.Errorf("error preparing query ListInventories: %w", err)
 	}
+	if q.listKindredsStmt, err = db.PrepareContext(ctx, listKindreds); err != nil {
+		return nil, fmt.Errorf("error preparing query ListKindreds: %w", err)
+	}
 	if q.listMagicItemsStmt, err = db.PrepareContext(ctx, listMagicItems); err != nil {
 		return nil, fmt.Errorf("error preparing query ListMagicItems: %w", err)
 	}
 	if q.listMagicItemsByTypeStmt, err = db.PrepareContext(ctx, listMagicItemsByType); err != nil {
 		return nil, fmt.Errorf("error preparing query ListMagicItemsByType: %w", err)
 	}
+	if q.listMonstersStmt, err = db.PrepareContext(ctx, listMonsters); err != nil {
+		return nil, fmt.Errorf("error preparing query ListMonsters: %w", err)
+	}
 	if q.listPotionsStmt, err = db.PrepareContext(ctx, listPotions); err != nil {
 		return nil, fmt.Errorf("error preparing query ListPotions: %w", err)
 	}
@@ -474,6 +750,12 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.recalculateInventoryWeightStmt, err = db.PrepareContext(ctx, recalculateInventoryWeight); err != nil {
 		return nil, fmt.Errorf("error preparing query RecalculateInventoryWeight: %w", err)
 	}
+	if q.recoverTurnUndeadAttemptsStmt, err = db.PrepareContext(ctx, recoverTurnUndeadAttempts); err != nil {
+		return nil, fmt.Errorf("error preparing query RecoverTurnUndeadAttempts: %w", err)
+	}
+	if q.releaseAbilityRollStmt, err = db.PrepareContext(ctx, releaseAbilityRoll); err != nil {
+		return nil, fmt.Errorf("error preparing query ReleaseAbilityRoll: %w", err)
+	}
 	if q.removeAllInventoryItemsStmt, err = db.PrepareContext(ctx, removeAllInventoryItems); err != nil {
 		return nil, fmt.Errorf("error preparing query RemoveAllInventoryItems: %w", err)
 	}
@@ -483,9 +765,30 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.removeKnownSpellStmt, err = db.PrepareContext(ctx, removeKnownSpell); err != nil {
 		return nil, fmt.Errorf("error preparing query RemoveKnownSpell: %w", err)
 	}
+	if q.removeSpellbookSpellStmt, err = db.PrepareContext(ctx, removeSpellbookSpell); err != nil {
+		return nil, fmt.Errorf("error preparing query RemoveSpellbookSpell: %w", err)
+	}
 	if q.resetAllMemorizedSpellsStmt, err = db.PrepareContext(ctx, resetAllMemorizedSpells); err != nil {
 		return nil, fmt.Errorf("error preparing query ResetAllMemorizedSpells: %w", err)
 	}
+	if q.restoreAttributeAdjustmentsStmt, err = db.PrepareContext(ctx, restoreAttributeAdjustments); err != nil {
+		return nil, fmt.Errorf("error preparing query RestoreAttributeAdjustments: %w", err)
+	}
+	if q.restorePreparedSpellStmt, err = db.PrepareContext(ctx, restorePreparedSpell); err != nil {
+		return nil, fmt.Errorf("error preparing query RestorePreparedSpell: %w", err)
+	}
+	if q.retireCharacterStmt, err = db.PrepareContext(ctx, retireCharacter); err != nil {
+		return nil, fmt.Errorf("error preparing query RetireCharacter: %w", err)
+	}
+	if q.searchMonstersStmt, err = db.PrepareContext(ctx, searchMonsters); err != nil {
+		return nil, fmt.Errorf("error preparing query SearchMonsters: %w", err)
+	}
+	if q.setAbilityRollCharacterStmt, err = db.PrepareContext(ctx, setAbilityRollCharacter); err != nil {
+		return nil, fmt.Errorf("error preparing query SetAbilityRollCharacter: %w", err)
+	}
+	if q.sumXPAwardsByCharacterStmt, err = db.PrepareContext(ctx, sumXPAwardsByCharacter); err != nil {
+		return nil, fmt.Errorf("error preparing query SumXPAwardsByCharacter: %w", err)
+	}
 	if q.unprepareSpellStmt, err = db.PrepareContext(ctx, unprepareSpell); err != nil {
 		return nil, fmt.Errorf("error preparing query UnprepareSpell: %w", err)
 	}
@@ -498,9 +801,24 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateCharacterStmt, err = db.PrepareContext(ctx, updateCharacter); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateCharacter: %w", err)
 	}
+	if q.updateCharacterExperienceStmt, err = db.PrepareContext(ctx, updateCharacterExperience); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateCharacterExperience: %w", err)
+	}
+	if q.updateCharacterHitPointsStmt, err = db.PrepareContext(ctx, updateCharacterHitPoints); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateCharacterHitPoints: %w", err)
+	}
+	if q.updateConditionStmt, err = db.PrepareContext(ctx, updateCondition); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateCondition: %w", err)
+	}
 	if q.updateContainerStmt, err = db.PrepareContext(ctx, updateContainer); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateContainer: %w", err)
 	}
+	if q.updateEncounterInitiativeModeStmt, err = db.PrepareContext(ctx, updateEncounterInitiativeMode); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateEncounterInitiativeMode: %w", err)
+	}
+	if q.updateEncounterTurnStmt, err = db.PrepareContext(ctx, updateEncounterTurn); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateEncounterTurn: %w", err)
+	}
 	if q.updateEquipmentStmt, err = db.PrepareContext(ctx, updateEquipment); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateEquipment: %w", err)
 	}
@@ -510,12 +828,30 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateInventoryItemStmt, err = db.PrepareContext(ctx, updateInventoryItem); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateInventoryItem: %w", err)
 	}
+	if q.updateInventoryItemChargesStmt, err = db.PrepareContext(ctx, updateInventoryItemCharges); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateInventoryItemCharges: %w", err)
+	}
+	if q.updateInventoryItemUsesStmt, err = db.PrepareContext(ctx, updateInventoryItemUses); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateInventoryItemUses: %w", err)
+	}
 	if q.updateInventoryWeightStmt, err = db.PrepareContext(ctx, updateInventoryWeight); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateInventoryWeight: %w", err)
 	}
+	if q.updateKindredStmt, err = db.PrepareContext(ctx, updateKindred); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateKindred: %w", err)
+	}
 	if q.updateMagicItemStmt, err = db.PrepareContext(ctx, updateMagicItem); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateMagicItem: %w", err)
 	}
+	if q.updateMonsterStmt, err = db.PrepareContext(ctx, updateMonster); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateMonster: %w", err)
+	}
+	if q.updateParticipantHPStmt, err = db.PrepareContext(ctx, updateParticipantHP); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateParticipantHP: %w", err)
+	}
+	if q.updateParticipantInitiativeStmt, err = db.PrepareContext(ctx, updateParticipantInitiative); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateParticipantInitiative: %w", err)
+	}
 	if q.updatePotionStmt, err = db.PrepareContext(ctx, updatePotion); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdatePotion: %w", err)
 	}
@@ -531,6 +867,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateSpellScrollStmt, err = db.PrepareContext(ctx, updateSpellScroll); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateSpellScroll: %w", err)
 	}
+	if q.updateSpellbookUsedPagesStmt, err = db.PrepareContext(ctx, updateSpellbookUsedPages); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateSpellbookUsedPages: %w", err)
+	}
 	if q.updateTreasureStmt, err = db.PrepareContext(ctx, updateTreasure); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateTreasure: %w", err)
 	}
@@ -540,17 +879,33 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateUserPasswordStmt, err = db.PrepareContext(ctx, updateUserPassword); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateUserPassword: %w", err)
 	}
+	if q.updateUserRoleStmt, err = db.PrepareContext(ctx, updateUserRole); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateUserRole: %w", err)
+	}
 	if q.updateWeaponStmt, err = db.PrepareContext(ctx, updateWeapon); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateWeapon: %w", err)
 	}
 	if q.updateWeaponMasteryLevelStmt, err = db.PrepareContext(ctx, updateWeaponMasteryLevel); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateWeaponMasteryLevel: %w", err)
 	}
+	if q.upsertCharacterGrantStmt, err = db.PrepareContext(ctx, upsertCharacterGrant); err != nil {
+		return nil, fmt.Errorf("error preparing query UpsertCharacterGrant: %w", err)
+	}
 	return &q, nil
 }
 
 func (q *Queries) Close() error {
 	var err error
+	if q.addCharacterConditionStmt != nil {
+		if cerr := q.addCharacterConditionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addCharacterConditionStmt: %w", cerr)
+		}
+	}
+	if q.addEncounterParticipantStmt != nil {
+		if cerr := q.addEncounterParticipantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addEncounterParticipantStmt: %w", cerr)
+		}
+	}
 	if q.addInventoryItemStmt != nil {
 		if cerr := q.addInventoryItemStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addInventoryItemStmt: %w", cerr)
@@ -561,26 +916,101 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing addKnownSpellStmt: %w", cerr)
 		}
 	}
+	if q.addSpellbookSpellStmt != nil {
+		if cerr := q.addSpellbookSpellStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addSpellbookSpellStmt: %w", cerr)
+		}
+	}
 	if q.addWeaponMasteryStmt != nil {
 		if cerr := q.addWeaponMasteryStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addWeaponMasteryStmt: %w", cerr)
 		}
 	}
+	if q.advanceActiveEffectsStmt != nil {
+		if cerr := q.advanceActiveEffectsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing advanceActiveEffectsStmt: %w", cerr)
+		}
+	}
+	if q.advanceCharacterConditionsStmt != nil {
+		if cerr := q.advanceCharacterConditionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing advanceCharacterConditionsStmt: %w", cerr)
+		}
+	}
+	if q.claimAbilityRollStmt != nil {
+		if cerr := q.claimAbilityRollStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimAbilityRollStmt: %w", cerr)
+		}
+	}
 	if q.clearPreparedSpellsStmt != nil {
 		if cerr := q.clearPreparedSpellsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing clearPreparedSpellsStmt: %w", cerr)
 		}
 	}
+	if q.countCarriedSpellbooksWithSpellStmt != nil {
+		if cerr := q.countCarriedSpellbooksWithSpellStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countCarriedSpellbooksWithSpellStmt: %w", cerr)
+		}
+	}
+	if q.countCharactersByClassStmt != nil {
+		if cerr := q.countCharactersByClassStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countCharactersByClassStmt: %w", cerr)
+		}
+	}
+	if q.countInventoryItemsByItemStmt != nil {
+		if cerr := q.countInventoryItemsByItemStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countInventoryItemsByItemStmt: %w", cerr)
+		}
+	}
+	if q.countPreparedSpellInstancesStmt != nil {
+		if cerr := q.countPreparedSpellInstancesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countPreparedSpellInstancesStmt: %w", cerr)
+		}
+	}
 	if q.countPreparedSpellsByLevelAndClassStmt != nil {
 		if cerr := q.countPreparedSpellsByLevelAndClassStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing countPreparedSpellsByLevelAndClassStmt: %w", cerr)
 		}
 	}
+	if q.countSpellReferencesStmt != nil {
+		if cerr := q.countSpellReferencesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countSpellReferencesStmt: %w", cerr)
+		}
+	}
+	if q.countUnrecoveredTurnUndeadAttemptsStmt != nil {
+		if cerr := q.countUnrecoveredTurnUndeadAttemptsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countUnrecoveredTurnUndeadAttemptsStmt: %w", cerr)
+		}
+	}
+	if q.countUnusedDiceAbilityRollsStmt != nil {
+		if cerr := q.countUnusedDiceAbilityRollsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countUnusedDiceAbilityRollsStmt: %w", cerr)
+		}
+	}
+	if q.countUsersByRoleStmt != nil {
+		if cerr := q.countUsersByRoleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countUsersByRoleStmt: %w", cerr)
+		}
+	}
 	if q.countWeaponMasteriesStmt != nil {
 		if cerr := q.countWeaponMasteriesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing countWeaponMasteriesStmt: %w", cerr)
 		}
 	}
+	if q.createAbilityStmt != nil {
+		if cerr := q.createAbilityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createAbilityStmt: %w", cerr)
+		}
+	}
+	if q.createAbilityRollStmt != nil {
+		if cerr := q.createAbilityRollStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createAbilityRollStmt: %w", cerr)
+		}
+	}
+	if q.createActiveEffectStmt != nil {
+		if cerr := q.createActiveEffectStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createActiveEffectStmt: %w", cerr)
+		}
+	}
 	if q.createAmmoStmt != nil {
 		if cerr := q.createAmmoStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createAmmoStmt: %w", cerr)
@@ -591,16 +1021,76 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createArmorStmt: %w", cerr)
 		}
 	}
+	if q.createAttributeAdjustmentStmt != nil {
+		if cerr := q.createAttributeAdjustmentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createAttributeAdjustmentStmt: %w", cerr)
+		}
+	}
 	if q.createCharacterStmt != nil {
 		if cerr := q.createCharacterStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createCharacterStmt: %w", cerr)
 		}
 	}
+	if q.createClassAbilityMappingStmt != nil {
+		if cerr := q.createClassAbilityMappingStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createClassAbilityMappingStmt: %w", cerr)
+		}
+	}
+	if q.createClassBackstabMultiplierStmt != nil {
+		if cerr := q.createClassBackstabMultiplierStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createClassBackstabMultiplierStmt: %w", cerr)
+		}
+	}
+	if q.createClassLevelStmt != nil {
+		if cerr := q.createClassLevelStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createClassLevelStmt: %w", cerr)
+		}
+	}
+	if q.createClassMartialArtsStmt != nil {
+		if cerr := q.createClassMartialArtsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createClassMartialArtsStmt: %w", cerr)
+		}
+	}
+	if q.createClassNaturalACStmt != nil {
+		if cerr := q.createClassNaturalACStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createClassNaturalACStmt: %w", cerr)
+		}
+	}
+	if q.createClassRulesStmt != nil {
+		if cerr := q.createClassRulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createClassRulesStmt: %w", cerr)
+		}
+	}
+	if q.createClassRunesPerDayStmt != nil {
+		if cerr := q.createClassRunesPerDayStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createClassRunesPerDayStmt: %w", cerr)
+		}
+	}
+	if q.createConditionStmt != nil {
+		if cerr := q.createConditionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createConditionStmt: %w", cerr)
+		}
+	}
 	if q.createContainerStmt != nil {
 		if cerr := q.createContainerStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createContainerStmt: %w", cerr)
 		}
 	}
+	if q.createContentPackStmt != nil {
+		if cerr := q.createContentPackStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createContentPackStmt: %w", cerr)
+		}
+	}
+	if q.createContentPackEntryStmt != nil {
+		if cerr := q.createContentPackEntryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createContentPackEntryStmt: %w", cerr)
+		}
+	}
+	if q.createEncounterStmt != nil {
+		if cerr := q.createEncounterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createEncounterStmt: %w", cerr)
+		}
+	}
 	if q.createEquipmentStmt != nil {
 		if cerr := q.createEquipmentStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createEquipmentStmt: %w", cerr)
@@ -611,11 +1101,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createInventoryStmt: %w", cerr)
 		}
 	}
+	if q.createItemUseStmt != nil {
+		if cerr := q.createItemUseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createItemUseStmt: %w", cerr)
+		}
+	}
+	if q.createKindredStmt != nil {
+		if cerr := q.createKindredStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createKindredStmt: %w", cerr)
+		}
+	}
+	if q.createLevelUpStmt != nil {
+		if cerr := q.createLevelUpStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createLevelUpStmt: %w", cerr)
+		}
+	}
 	if q.createMagicItemStmt != nil {
 		if cerr := q.createMagicItemStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createMagicItemStmt: %w", cerr)
 		}
 	}
+	if q.createMonsterStmt != nil {
+		if cerr := q.createMonsterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createMonsterStmt: %w", cerr)
+		}
+	}
 	if q.createPotionStmt != nil {
 		if cerr := q.createPotionStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createPotionStmt: %w", cerr)
@@ -636,16 +1146,41 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createSpellStmt: %w", cerr)
 		}
 	}
+	if q.createSpellCastStmt != nil {
+		if cerr := q.createSpellCastStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createSpellCastStmt: %w", cerr)
+		}
+	}
+	if q.createSpellLearningAttemptStmt != nil {
+		if cerr := q.createSpellLearningAttemptStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createSpellLearningAttemptStmt: %w", cerr)
+		}
+	}
 	if q.createSpellScrollStmt != nil {
 		if cerr := q.createSpellScrollStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createSpellScrollStmt: %w", cerr)
 		}
 	}
+	if q.createSpellbookStmt != nil {
+		if cerr := q.createSpellbookStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createSpellbookStmt: %w", cerr)
+		}
+	}
+	if q.createThiefSkillCheckStmt != nil {
+		if cerr := q.createThiefSkillCheckStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createThiefSkillCheckStmt: %w", cerr)
+		}
+	}
 	if q.createTreasureStmt != nil {
 		if cerr := q.createTreasureStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createTreasureStmt: %w", cerr)
 		}
 	}
+	if q.createTurnUndeadAttemptStmt != nil {
+		if cerr := q.createTurnUndeadAttemptStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createTurnUndeadAttemptStmt: %w", cerr)
+		}
+	}
 	if q.createUserStmt != nil {
 		if cerr := q.createUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createUserStmt: %w", cerr)
@@ -656,6 +1191,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createWeaponStmt: %w", cerr)
 		}
 	}
+	if q.createXPAwardStmt != nil {
+		if cerr := q.createXPAwardStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createXPAwardStmt: %w", cerr)
+		}
+	}
+	if q.deleteAbilityStmt != nil {
+		if cerr := q.deleteAbilityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAbilityStmt: %w", cerr)
+		}
+	}
+	if q.deleteActiveEffectStmt != nil {
+		if cerr := q.deleteActiveEffectStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteActiveEffectStmt: %w", cerr)
+		}
+	}
 	if q.deleteAmmoStmt != nil {
 		if cerr := q.deleteAmmoStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteAmmoStmt: %w", cerr)
@@ -666,31 +1216,126 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteArmorStmt: %w", cerr)
 		}
 	}
+	if q.deleteAttributeAdjustmentStmt != nil {
+		if cerr := q.deleteAttributeAdjustmentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAttributeAdjustmentStmt: %w", cerr)
+		}
+	}
 	if q.deleteCharacterStmt != nil {
 		if cerr := q.deleteCharacterStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteCharacterStmt: %w", cerr)
 		}
 	}
+	if q.deleteCharacterConditionStmt != nil {
+		if cerr := q.deleteCharacterConditionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteCharacterConditionStmt: %w", cerr)
+		}
+	}
+	if q.deleteCharacterGrantStmt != nil {
+		if cerr := q.deleteCharacterGrantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteCharacterGrantStmt: %w", cerr)
+		}
+	}
+	if q.deleteClassStmt != nil {
+		if cerr := q.deleteClassStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteClassStmt: %w", cerr)
+		}
+	}
+	if q.deleteClassAbilityMappingsStmt != nil {
+		if cerr := q.deleteClassAbilityMappingsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteClassAbilityMappingsStmt: %w", cerr)
+		}
+	}
+	if q.deleteClassBackstabMultipliersStmt != nil {
+		if cerr := q.deleteClassBackstabMultipliersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteClassBackstabMultipliersStmt: %w", cerr)
+		}
+	}
+	if q.deleteClassMartialArtsStmt != nil {
+		if cerr := q.deleteClassMartialArtsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteClassMartialArtsStmt: %w", cerr)
+		}
+	}
+	if q.deleteClassNaturalACStmt != nil {
+		if cerr := q.deleteClassNaturalACStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteClassNaturalACStmt: %w", cerr)
+		}
+	}
+	if q.deleteClassRulesStmt != nil {
+		if cerr := q.deleteClassRulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteClassRulesStmt: %w", cerr)
+		}
+	}
+	if q.deleteClassRunesPerDayStmt != nil {
+		if cerr := q.deleteClassRunesPerDayStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteClassRunesPerDayStmt: %w", cerr)
+		}
+	}
+	if q.deleteConditionStmt != nil {
+		if cerr := q.deleteConditionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteConditionStmt: %w", cerr)
+		}
+	}
 	if q.deleteContainerStmt != nil {
 		if cerr := q.deleteContainerStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteContainerStmt: %w", cerr)
 		}
 	}
+	if q.deleteContentPackStmt != nil {
+		if cerr := q.deleteContentPackStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteContentPackStmt: %w", cerr)
+		}
+	}
+	if q.deleteContentPackEntriesStmt != nil {
+		if cerr := q.deleteContentPackEntriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteContentPackEntriesStmt: %w", cerr)
+		}
+	}
+	if q.deleteEncounterStmt != nil {
+		if cerr := q.deleteEncounterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteEncounterStmt: %w", cerr)
+		}
+	}
+	if q.deleteEncounterParticipantStmt != nil {
+		if cerr := q.deleteEncounterParticipantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteEncounterParticipantStmt: %w", cerr)
+		}
+	}
 	if q.deleteEquipmentStmt != nil {
 		if cerr := q.deleteEquipmentStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteEquipmentStmt: %w", cerr)
 		}
 	}
+	if q.deleteExpiredActiveEffectsStmt != nil {
+		if cerr := q.deleteExpiredActiveEffectsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteExpiredActiveEffectsStmt: %w", cerr)
+		}
+	}
+	if q.deleteExpiredCharacterConditionsStmt != nil {
+		if cerr := q.deleteExpiredCharacterConditionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteExpiredCharacterConditionsStmt: %w", cerr)
+		}
+	}
 	if q.deleteInventoryStmt != nil {
 		if cerr := q.deleteInventoryStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteInventoryStmt: %w", cerr)
 		}
 	}
+	if q.deleteKindredStmt != nil {
+		if cerr := q.deleteKindredStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteKindredStmt: %w", cerr)
+		}
+	}
 	if q.deleteMagicItemStmt != nil {
 		if cerr := q.deleteMagicItemStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteMagicItemStmt: %w", cerr)
 		}
 	}
+	if q.deleteMonsterStmt != nil {
+		if cerr := q.deleteMonsterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteMonsterStmt: %w", cerr)
+		}
+	}
 	if q.deletePotionStmt != nil {
 		if cerr := q.deletePotionStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deletePotionStmt: %w", cerr)
@@ -736,6 +1381,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteWeaponMasteryStmt: %w", cerr)
 		}
 	}
+	if q.expendPreparedSpellStmt != nil {
+		if cerr := q.expendPreparedSpellStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing expendPreparedSpellStmt: %w", cerr)
+		}
+	}
+	if q.getAbilityRollStmt != nil {
+		if cerr := q.getAbilityRollStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAbilityRollStmt: %w", cerr)
+		}
+	}
+	if q.getAbilityRollByCharacterStmt != nil {
+		if cerr := q.getAbilityRollByCharacterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAbilityRollByCharacterStmt: %w", cerr)
+		}
+	}
+	if q.getAbilityRollsByUserStmt != nil {
+		if cerr := q.getAbilityRollsByUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAbilityRollsByUserStmt: %w", cerr)
+		}
+	}
+	if q.getActiveEffectStmt != nil {
+		if cerr := q.getActiveEffectStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getActiveEffectStmt: %w", cerr)
+		}
+	}
+	if q.getActiveEffectsByCharacterStmt != nil {
+		if cerr := q.getActiveEffectsByCharacterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getActiveEffectsByCharacterStmt: %w", cerr)
+		}
+	}
 	if q.getAllClassDataStmt != nil {
 		if cerr := q.getAllClassDataStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getAllClassDataStmt: %w", cerr)
@@ -761,14 +1436,14 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getArmorByNameStmt: %w", cerr)
 		}
 	}
-	if q.getBarbarianAbilitiesStmt != nil {
-		if cerr := q.getBarbarianAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getBarbarianAbilitiesStmt: %w", cerr)
+	if q.getAttributeAdjustmentStmt != nil {
+		if cerr := q.getAttributeAdjustmentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAttributeAdjustmentStmt: %w", cerr)
 		}
 	}
-	if q.getBardAbilitiesStmt != nil {
-		if cerr := q.getBardAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getBardAbilitiesStmt: %w", cerr)
+	if q.getAttributeAdjustmentsByCharacterStmt != nil {
+		if cerr := q.getAttributeAdjustmentsByCharacterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAttributeAdjustmentsByCharacterStmt: %w", cerr)
 		}
 	}
 	if q.getBardDruidSpellsStmt != nil {
@@ -781,24 +1456,19 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getBardIllusionistSpellsStmt: %w", cerr)
 		}
 	}
-	if q.getBerserkerAbilitiesStmt != nil {
-		if cerr := q.getBerserkerAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getBerserkerAbilitiesStmt: %w", cerr)
-		}
-	}
-	if q.getBerserkerNaturalACStmt != nil {
-		if cerr := q.getBerserkerNaturalACStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getBerserkerNaturalACStmt: %w", cerr)
+	if q.getCharacterStmt != nil {
+		if cerr := q.getCharacterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCharacterStmt: %w", cerr)
 		}
 	}
-	if q.getCataphractAbilitiesStmt != nil {
-		if cerr := q.getCataphractAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getCataphractAbilitiesStmt: %w", cerr)
+	if q.getCharacterConditionStmt != nil {
+		if cerr := q.getCharacterConditionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCharacterConditionStmt: %w", cerr)
 		}
 	}
-	if q.getCharacterStmt != nil {
-		if cerr := q.getCharacterStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getCharacterStmt: %w", cerr)
+	if q.getCharacterConditionsStmt != nil {
+		if cerr := q.getCharacterConditionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCharacterConditionsStmt: %w", cerr)
 		}
 	}
 	if q.getCharacterForSpellcastingStmt != nil {
@@ -806,6 +1476,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getCharacterForSpellcastingStmt: %w", cerr)
 		}
 	}
+	if q.getCharacterGrantStmt != nil {
+		if cerr := q.getCharacterGrantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCharacterGrantStmt: %w", cerr)
+		}
+	}
+	if q.getCharacterGrantsStmt != nil {
+		if cerr := q.getCharacterGrantsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCharacterGrantsStmt: %w", cerr)
+		}
+	}
+	if q.getCharacterGrantsByUserStmt != nil {
+		if cerr := q.getCharacterGrantsByUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCharacterGrantsByUserStmt: %w", cerr)
+		}
+	}
+	if q.getCharacterInventoryItemStmt != nil {
+		if cerr := q.getCharacterInventoryItemStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCharacterInventoryItemStmt: %w", cerr)
+		}
+	}
+	if q.getCharacterSpellScrollItemStmt != nil {
+		if cerr := q.getCharacterSpellScrollItemStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getCharacterSpellScrollItemStmt: %w", cerr)
+		}
+	}
 	if q.getCharactersByUserStmt != nil {
 		if cerr := q.getCharactersByUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getCharactersByUserStmt: %w", cerr)
@@ -821,6 +1516,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getClassAbilitiesByLevelStmt: %w", cerr)
 		}
 	}
+	if q.getClassBackstabMultiplierStmt != nil {
+		if cerr := q.getClassBackstabMultiplierStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getClassBackstabMultiplierStmt: %w", cerr)
+		}
+	}
 	if q.getClassDataStmt != nil {
 		if cerr := q.getClassDataStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getClassDataStmt: %w", cerr)
@@ -831,14 +1531,44 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getClassDataForSpellcastingStmt: %w", cerr)
 		}
 	}
-	if q.getClericAbilitiesStmt != nil {
-		if cerr := q.getClericAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getClericAbilitiesStmt: %w", cerr)
+	if q.getClassExtraSpellSlotsStmt != nil {
+		if cerr := q.getClassExtraSpellSlotsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getClassExtraSpellSlotsStmt: %w", cerr)
+		}
+	}
+	if q.getClassMartialArtsStmt != nil {
+		if cerr := q.getClassMartialArtsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getClassMartialArtsStmt: %w", cerr)
+		}
+	}
+	if q.getClassNaturalACStmt != nil {
+		if cerr := q.getClassNaturalACStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getClassNaturalACStmt: %w", cerr)
+		}
+	}
+	if q.getClassRulesStmt != nil {
+		if cerr := q.getClassRulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getClassRulesStmt: %w", cerr)
+		}
+	}
+	if q.getClassRunesPerDayStmt != nil {
+		if cerr := q.getClassRunesPerDayStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getClassRunesPerDayStmt: %w", cerr)
+		}
+	}
+	if q.getClassTurningAbilityStmt != nil {
+		if cerr := q.getClassTurningAbilityStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getClassTurningAbilityStmt: %w", cerr)
 		}
 	}
-	if q.getClericTurningAbilityStmt != nil {
-		if cerr := q.getClericTurningAbilityStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getClericTurningAbilityStmt: %w", cerr)
+	if q.getConditionStmt != nil {
+		if cerr := q.getConditionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getConditionStmt: %w", cerr)
+		}
+	}
+	if q.getConditionByNameStmt != nil {
+		if cerr := q.getConditionByNameStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getConditionByNameStmt: %w", cerr)
 		}
 	}
 	if q.getContainerStmt != nil {
@@ -851,14 +1581,29 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getContainerByNameStmt: %w", cerr)
 		}
 	}
-	if q.getCryomancerAbilitiesStmt != nil {
-		if cerr := q.getCryomancerAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getCryomancerAbilitiesStmt: %w", cerr)
+	if q.getContentPackStmt != nil {
+		if cerr := q.getContentPackStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getContentPackStmt: %w", cerr)
+		}
+	}
+	if q.getContentPackByNamespaceStmt != nil {
+		if cerr := q.getContentPackByNamespaceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getContentPackByNamespaceStmt: %w", cerr)
+		}
+	}
+	if q.getEncounterStmt != nil {
+		if cerr := q.getEncounterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEncounterStmt: %w", cerr)
+		}
+	}
+	if q.getEncounterParticipantsStmt != nil {
+		if cerr := q.getEncounterParticipantsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEncounterParticipantsStmt: %w", cerr)
 		}
 	}
-	if q.getDruidAbilitiesStmt != nil {
-		if cerr := q.getDruidAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getDruidAbilitiesStmt: %w", cerr)
+	if q.getEncountersByUserStmt != nil {
+		if cerr := q.getEncountersByUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getEncountersByUserStmt: %w", cerr)
 		}
 	}
 	if q.getEquipmentStmt != nil {
@@ -876,9 +1621,9 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getEquippedItemsStmt: %w", cerr)
 		}
 	}
-	if q.getFighterAbilitiesStmt != nil {
-		if cerr := q.getFighterAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getFighterAbilitiesStmt: %w", cerr)
+	if q.getFailedSpellLearningAttemptStmt != nil {
+		if cerr := q.getFailedSpellLearningAttemptStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getFailedSpellLearningAttemptStmt: %w", cerr)
 		}
 	}
 	if q.getFullUserByEmailStmt != nil {
@@ -886,16 +1631,6 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getFullUserByEmailStmt: %w", cerr)
 		}
 	}
-	if q.getHuntsmanAbilitiesStmt != nil {
-		if cerr := q.getHuntsmanAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getHuntsmanAbilitiesStmt: %w", cerr)
-		}
-	}
-	if q.getIllusionistAbilitiesStmt != nil {
-		if cerr := q.getIllusionistAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getIllusionistAbilitiesStmt: %w", cerr)
-		}
-	}
 	if q.getInventoryStmt != nil {
 		if cerr := q.getInventoryStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getInventoryStmt: %w", cerr)
@@ -926,11 +1661,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getInventoryItemsByTypeStmt: %w", cerr)
 		}
 	}
+	if q.getItemUseStmt != nil {
+		if cerr := q.getItemUseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getItemUseStmt: %w", cerr)
+		}
+	}
+	if q.getItemUsesByCharacterStmt != nil {
+		if cerr := q.getItemUsesByCharacterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getItemUsesByCharacterStmt: %w", cerr)
+		}
+	}
 	if q.getItemsBySlotStmt != nil {
 		if cerr := q.getItemsBySlotStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getItemsBySlotStmt: %w", cerr)
 		}
 	}
+	if q.getKindredStmt != nil {
+		if cerr := q.getKindredStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getKindredStmt: %w", cerr)
+		}
+	}
+	if q.getKindredByNameStmt != nil {
+		if cerr := q.getKindredByNameStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getKindredByNameStmt: %w", cerr)
+		}
+	}
 	if q.getKnownSpellByCharacterAndSpellStmt != nil {
 		if cerr := q.getKnownSpellByCharacterAndSpellStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getKnownSpellByCharacterAndSpellStmt: %w", cerr)
@@ -946,9 +1701,14 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getKnownSpellsByClassStmt: %w", cerr)
 		}
 	}
-	if q.getLegerdemainistAbilitiesStmt != nil {
-		if cerr := q.getLegerdemainistAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getLegerdemainistAbilitiesStmt: %w", cerr)
+	if q.getLevelUpStmt != nil {
+		if cerr := q.getLevelUpStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getLevelUpStmt: %w", cerr)
+		}
+	}
+	if q.getLevelUpsByCharacterStmt != nil {
+		if cerr := q.getLevelUpsByCharacterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getLevelUpsByCharacterStmt: %w", cerr)
 		}
 	}
 	if q.getMagicItemStmt != nil {
@@ -961,34 +1721,14 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getMagicItemByNameStmt: %w", cerr)
 		}
 	}
-	if q.getMagicianAbilitiesStmt != nil {
-		if cerr := q.getMagicianAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getMagicianAbilitiesStmt: %w", cerr)
-		}
-	}
-	if q.getMonkACBonusStmt != nil {
-		if cerr := q.getMonkACBonusStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getMonkACBonusStmt: %w", cerr)
-		}
-	}
-	if q.getMonkAbilitiesStmt != nil {
-		if cerr := q.getMonkAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getMonkAbilitiesStmt: %w", cerr)
-		}
-	}
-	if q.getMonkEmptyHandDamageStmt != nil {
-		if cerr := q.getMonkEmptyHandDamageStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getMonkEmptyHandDamageStmt: %w", cerr)
+	if q.getMonsterStmt != nil {
+		if cerr := q.getMonsterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getMonsterStmt: %w", cerr)
 		}
 	}
-	if q.getNecromancerAbilitiesStmt != nil {
-		if cerr := q.getNecromancerAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getNecromancerAbilitiesStmt: %w", cerr)
-		}
-	}
-	if q.getNecromancerTurningAbilityStmt != nil {
-		if cerr := q.getNecromancerTurningAbilityStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getNecromancerTurningAbilityStmt: %w", cerr)
+	if q.getMonsterByNameStmt != nil {
+		if cerr := q.getMonsterByNameStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getMonsterByNameStmt: %w", cerr)
 		}
 	}
 	if q.getNextAvailableSlotIndexStmt != nil {
@@ -1001,14 +1741,9 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getNextLevelDataStmt: %w", cerr)
 		}
 	}
-	if q.getPaladinAbilitiesStmt != nil {
-		if cerr := q.getPaladinAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getPaladinAbilitiesStmt: %w", cerr)
-		}
-	}
-	if q.getPaladinTurningAbilityStmt != nil {
-		if cerr := q.getPaladinTurningAbilityStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getPaladinTurningAbilityStmt: %w", cerr)
+	if q.getNextUnexpendedPreparedSpellStmt != nil {
+		if cerr := q.getNextUnexpendedPreparedSpellStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getNextUnexpendedPreparedSpellStmt: %w", cerr)
 		}
 	}
 	if q.getPotionStmt != nil {
@@ -1021,11 +1756,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getPotionByNameStmt: %w", cerr)
 		}
 	}
+	if q.getPreparedSpellStmt != nil {
+		if cerr := q.getPreparedSpellStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPreparedSpellStmt: %w", cerr)
+		}
+	}
 	if q.getPreparedSpellByCharacterAndSpellStmt != nil {
 		if cerr := q.getPreparedSpellByCharacterAndSpellStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getPreparedSpellByCharacterAndSpellStmt: %w", cerr)
 		}
 	}
+	if q.getPreparedSpellBySlotStmt != nil {
+		if cerr := q.getPreparedSpellBySlotStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPreparedSpellBySlotStmt: %w", cerr)
+		}
+	}
 	if q.getPreparedSpellsStmt != nil {
 		if cerr := q.getPreparedSpellsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getPreparedSpellsStmt: %w", cerr)
@@ -1036,26 +1781,6 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getPreparedSpellsByClassStmt: %w", cerr)
 		}
 	}
-	if q.getPriestAbilitiesStmt != nil {
-		if cerr := q.getPriestAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getPriestAbilitiesStmt: %w", cerr)
-		}
-	}
-	if q.getPurloinerAbilitiesStmt != nil {
-		if cerr := q.getPurloinerAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getPurloinerAbilitiesStmt: %w", cerr)
-		}
-	}
-	if q.getPyromancerAbilitiesStmt != nil {
-		if cerr := q.getPyromancerAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getPyromancerAbilitiesStmt: %w", cerr)
-		}
-	}
-	if q.getRangerAbilitiesStmt != nil {
-		if cerr := q.getRangerAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getRangerAbilitiesStmt: %w", cerr)
-		}
-	}
 	if q.getRangerDruidSpellSlotsStmt != nil {
 		if cerr := q.getRangerDruidSpellSlotsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getRangerDruidSpellSlotsStmt: %w", cerr)
@@ -1076,26 +1801,6 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getRingByNameStmt: %w", cerr)
 		}
 	}
-	if q.getRunegraverAbilitiesStmt != nil {
-		if cerr := q.getRunegraverAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getRunegraverAbilitiesStmt: %w", cerr)
-		}
-	}
-	if q.getRunesPerDayStmt != nil {
-		if cerr := q.getRunesPerDayStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getRunesPerDayStmt: %w", cerr)
-		}
-	}
-	if q.getScoutAbilitiesStmt != nil {
-		if cerr := q.getScoutAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getScoutAbilitiesStmt: %w", cerr)
-		}
-	}
-	if q.getShamanAbilitiesStmt != nil {
-		if cerr := q.getShamanAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getShamanAbilitiesStmt: %w", cerr)
-		}
-	}
 	if q.getShamanArcaneSpellsStmt != nil {
 		if cerr := q.getShamanArcaneSpellsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getShamanArcaneSpellsStmt: %w", cerr)
@@ -1121,11 +1826,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getSpellStmt: %w", cerr)
 		}
 	}
+	if q.getSpellCastStmt != nil {
+		if cerr := q.getSpellCastStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSpellCastStmt: %w", cerr)
+		}
+	}
+	if q.getSpellCastsByCharacterStmt != nil {
+		if cerr := q.getSpellCastsByCharacterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSpellCastsByCharacterStmt: %w", cerr)
+		}
+	}
 	if q.getSpellForSpellcastingStmt != nil {
 		if cerr := q.getSpellForSpellcastingStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getSpellForSpellcastingStmt: %w", cerr)
 		}
 	}
+	if q.getSpellLearningAttemptStmt != nil {
+		if cerr := q.getSpellLearningAttemptStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSpellLearningAttemptStmt: %w", cerr)
+		}
+	}
+	if q.getSpellLearningAttemptsByCharacterStmt != nil {
+		if cerr := q.getSpellLearningAttemptsByCharacterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSpellLearningAttemptsByCharacterStmt: %w", cerr)
+		}
+	}
 	if q.getSpellScrollStmt != nil {
 		if cerr := q.getSpellScrollStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getSpellScrollStmt: %w", cerr)
@@ -1136,14 +1861,49 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getSpellScrollsBySpellStmt: %w", cerr)
 		}
 	}
+	if q.getSpellbookStmt != nil {
+		if cerr := q.getSpellbookStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSpellbookStmt: %w", cerr)
+		}
+	}
+	if q.getSpellbookForCharacterStmt != nil {
+		if cerr := q.getSpellbookForCharacterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSpellbookForCharacterStmt: %w", cerr)
+		}
+	}
+	if q.getSpellbookOwnerStmt != nil {
+		if cerr := q.getSpellbookOwnerStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSpellbookOwnerStmt: %w", cerr)
+		}
+	}
+	if q.getSpellbookSpellStmt != nil {
+		if cerr := q.getSpellbookSpellStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSpellbookSpellStmt: %w", cerr)
+		}
+	}
+	if q.getSpellbookSpellsStmt != nil {
+		if cerr := q.getSpellbookSpellsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSpellbookSpellsStmt: %w", cerr)
+		}
+	}
+	if q.getSpellbooksByCharacterStmt != nil {
+		if cerr := q.getSpellbooksByCharacterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSpellbooksByCharacterStmt: %w", cerr)
+		}
+	}
 	if q.getSpellsByClassLevelStmt != nil {
 		if cerr := q.getSpellsByClassLevelStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getSpellsByClassLevelStmt: %w", cerr)
 		}
 	}
-	if q.getThiefAbilitiesStmt != nil {
-		if cerr := q.getThiefAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getThiefAbilitiesStmt: %w", cerr)
+	if q.getThiefSkillCheckStmt != nil {
+		if cerr := q.getThiefSkillCheckStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getThiefSkillCheckStmt: %w", cerr)
+		}
+	}
+	if q.getThiefSkillChecksByCharacterStmt != nil {
+		if cerr := q.getThiefSkillChecksByCharacterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getThiefSkillChecksByCharacterStmt: %w", cerr)
 		}
 	}
 	if q.getThiefSkillsByLevelStmt != nil {
@@ -1161,16 +1921,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getTreasureByCharacterStmt: %w", cerr)
 		}
 	}
+	if q.getTurnUndeadAttemptStmt != nil {
+		if cerr := q.getTurnUndeadAttemptStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTurnUndeadAttemptStmt: %w", cerr)
+		}
+	}
+	if q.getTurnUndeadAttemptsByCharacterStmt != nil {
+		if cerr := q.getTurnUndeadAttemptsByCharacterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTurnUndeadAttemptsByCharacterStmt: %w", cerr)
+		}
+	}
 	if q.getUserStmt != nil {
 		if cerr := q.getUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getUserStmt: %w", cerr)
 		}
 	}
-	if q.getWarlockAbilitiesStmt != nil {
-		if cerr := q.getWarlockAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getWarlockAbilitiesStmt: %w", cerr)
-		}
-	}
 	if q.getWeaponStmt != nil {
 		if cerr := q.getWeaponStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getWeaponStmt: %w", cerr)
@@ -1196,9 +1961,19 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getWeaponMasteryByIDStmt: %w", cerr)
 		}
 	}
-	if q.getWitchAbilitiesStmt != nil {
-		if cerr := q.getWitchAbilitiesStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getWitchAbilitiesStmt: %w", cerr)
+	if q.getXPAwardStmt != nil {
+		if cerr := q.getXPAwardStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getXPAwardStmt: %w", cerr)
+		}
+	}
+	if q.getXPAwardReversalStmt != nil {
+		if cerr := q.getXPAwardReversalStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getXPAwardReversalStmt: %w", cerr)
+		}
+	}
+	if q.getXPAwardsByCharacterStmt != nil {
+		if cerr := q.getXPAwardsByCharacterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getXPAwardsByCharacterStmt: %w", cerr)
 		}
 	}
 	if q.listAmmoStmt != nil {
@@ -1216,11 +1991,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listCharactersStmt: %w", cerr)
 		}
 	}
+	if q.listClassRulesStmt != nil {
+		if cerr := q.listClassRulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listClassRulesStmt: %w", cerr)
+		}
+	}
+	if q.listConditionsStmt != nil {
+		if cerr := q.listConditionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listConditionsStmt: %w", cerr)
+		}
+	}
 	if q.listContainersStmt != nil {
 		if cerr := q.listContainersStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listContainersStmt: %w", cerr)
 		}
 	}
+	if q.listContentPackEntriesStmt != nil {
+		if cerr := q.listContentPackEntriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listContentPackEntriesStmt: %w", cerr)
+		}
+	}
+	if q.listContentPacksStmt != nil {
+		if cerr := q.listContentPacksStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listContentPacksStmt: %w", cerr)
+		}
+	}
 	if q.listEquipmentStmt != nil {
 		if cerr := q.listEquipmentStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listEquipmentStmt: %w", cerr)
@@ -1231,6 +2026,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listInventoriesStmt: %w", cerr)
 		}
 	}
+	if q.listKindredsStmt != nil {
+		if cerr := q.listKindredsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listKindredsStmt: %w", cerr)
+		}
+	}
 	if q.listMagicItemsStmt != nil {
 		if cerr := q.listMagicItemsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listMagicItemsStmt: %w", cerr)
@@ -1241,6 +2041,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing listMagicItemsByTypeStmt: %w", cerr)
 		}
 	}
+	if q.listMonstersStmt != nil {
+		if cerr := q.listMonstersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listMonstersStmt: %w", cerr)
+		}
+	}
 	if q.listPotionsStmt != nil {
 		if cerr := q.listPotionsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listPotionsStmt: %w", cerr)
@@ -1301,6 +2106,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing recalculateInventoryWeightStmt: %w", cerr)
 		}
 	}
+	if q.recoverTurnUndeadAttemptsStmt != nil {
+		if cerr := q.recoverTurnUndeadAttemptsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing recoverTurnUndeadAttemptsStmt: %w", cerr)
+		}
+	}
+	if q.releaseAbilityRollStmt != nil {
+		if cerr := q.releaseAbilityRollStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing releaseAbilityRollStmt: %w", cerr)
+		}
+	}
 	if q.removeAllInventoryItemsStmt != nil {
 		if cerr := q.removeAllInventoryItemsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing removeAllInventoryItemsStmt: %w", cerr)
@@ -1316,11 +2131,46 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing removeKnownSpellStmt: %w", cerr)
 		}
 	}
+	if q.removeSpellbookSpellStmt != nil {
+		if cerr := q.removeSpellbookSpellStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing removeSpellbookSpellStmt: %w", cerr)
+		}
+	}
 	if q.resetAllMemorizedSpellsStmt != nil {
 		if cerr := q.resetAllMemorizedSpellsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing resetAllMemorizedSpellsStmt: %w", cerr)
 		}
 	}
+	if q.restoreAttributeAdjustmentsStmt != nil {
+		if cerr := q.restoreAttributeAdjustmentsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing restoreAttributeAdjustmentsStmt: %w", cerr)
+		}
+	}
+	if q.restorePreparedSpellStmt != nil {
+		if cerr := q.restorePreparedSpellStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing restorePreparedSpellStmt: %w", cerr)
+		}
+	}
+	if q.retireCharacterStmt != nil {
+		if cerr := q.retireCharacterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing retireCharacterStmt: %w", cerr)
+		}
+	}
+	if q.searchMonstersStmt != nil {
+		if cerr := q.searchMonstersStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing searchMonstersStmt: %w", cerr)
+		}
+	}
+	if q.setAbilityRollCharacterStmt != nil {
+		if cerr := q.setAbilityRollCharacterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setAbilityRollCharacterStmt: %w", cerr)
+		}
+	}
+	if q.sumXPAwardsByCharacterStmt != nil {
+		if cerr := q.sumXPAwardsByCharacterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing sumXPAwardsByCharacterStmt: %w", cerr)
+		}
+	}
 	if q.unprepareSpellStmt != nil {
 		if cerr := q.unprepareSpellStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing unprepareSpellStmt: %w", cerr)
@@ -1341,11 +2191,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateCharacterStmt: %w", cerr)
 		}
 	}
+	if q.updateCharacterExperienceStmt != nil {
+		if cerr := q.updateCharacterExperienceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateCharacterExperienceStmt: %w", cerr)
+		}
+	}
+	if q.updateCharacterHitPointsStmt != nil {
+		if cerr := q.updateCharacterHitPointsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateCharacterHitPointsStmt: %w", cerr)
+		}
+	}
+	if q.updateConditionStmt != nil {
+		if cerr := q.updateConditionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateConditionStmt: %w", cerr)
+		}
+	}
 	if q.updateContainerStmt != nil {
 		if cerr := q.updateContainerStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateContainerStmt: %w", cerr)
 		}
 	}
+	if q.updateEncounterInitiativeModeStmt != nil {
+		if cerr := q.updateEncounterInitiativeModeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateEncounterInitiativeModeStmt: %w", cerr)
+		}
+	}
+	if q.updateEncounterTurnStmt != nil {
+		if cerr := q.updateEncounterTurnStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateEncounterTurnStmt: %w", cerr)
+		}
+	}
 	if q.updateEquipmentStmt != nil {
 		if cerr := q.updateEquipmentStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateEquipmentStmt: %w", cerr)
@@ -1361,16 +2236,46 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateInventoryItemStmt: %w", cerr)
 		}
 	}
+	if q.updateInventoryItemChargesStmt != nil {
+		if cerr := q.updateInventoryItemChargesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateInventoryItemChargesStmt: %w", cerr)
+		}
+	}
+	if q.updateInventoryItemUsesStmt != nil {
+		if cerr := q.updateInventoryItemUsesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateInventoryItemUsesStmt: %w", cerr)
+		}
+	}
 	if q.updateInventoryWeightStmt != nil {
 		if cerr := q.updateInventoryWeightStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateInventoryWeightStmt: %w", cerr)
 		}
 	}
+	if q.updateKindredStmt != nil {
+		if cerr := q.updateKindredStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateKindredStmt: %w", cerr)
+		}
+	}
 	if q.updateMagicItemStmt != nil {
 		if cerr := q.updateMagicItemStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateMagicItemStmt: %w", cerr)
 		}
 	}
+	if q.updateMonsterStmt != nil {
+		if cerr := q.updateMonsterStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateMonsterStmt: %w", cerr)
+		}
+	}
+	if q.updateParticipantHPStmt != nil {
+		if cerr := q.updateParticipantHPStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateParticipantHPStmt: %w", cerr)
+		}
+	}
+	if q.updateParticipantInitiativeStmt != nil {
+		if cerr := q.updateParticipantInitiativeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateParticipantInitiativeStmt: %w", cerr)
+		}
+	}
 	if q.updatePotionStmt != nil {
 		if cerr := q.updatePotionStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updatePotionStmt: %w", cerr)
@@ -1396,6 +2301,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateSpellScrollStmt: %w", cerr)
 		}
 	}
+	if q.updateSpellbookUsedPagesStmt != nil {
+		if cerr := q.updateSpellbookUsedPagesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateSpellbookUsedPagesStmt: %w", cerr)
+		}
+	}
 	if q.updateTreasureStmt != nil {
 		if cerr := q.updateTreasureStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateTreasureStmt: %w", cerr)
@@ -1411,6 +2321,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateUserPasswordStmt: %w", cerr)
 		}
 	}
+	if q.updateUserRoleStmt != nil {
+		if cerr := q.updateUserRoleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateUserRoleStmt: %w", cerr)
+		}
+	}
 	if q.updateWeaponStmt != nil {
 		if cerr := q.updateWeaponStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateWeaponStmt: %w", cerr)
@@ -1421,6 +2336,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateWeaponMasteryLevelStmt: %w", cerr)
 		}
 	}
+	if q.upsertCharacterGrantStmt != nil {
+		if cerr := q.upsertCharacterGrantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing upsertCharacterGrantStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -1460,34 +2380,94 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 type Queries struct {
 	db                                      DBTX
 	tx                                      *sql.Tx
+	addCharacterConditionStmt               *sql.Stmt
+	addEncounterParticipantStmt             *sql.Stmt
 	addInventoryItemStmt                    *sql.Stmt
 	addKnownSpellStmt                       *sql.Stmt
+	addSpellbookSpellStmt                   *sql.Stmt
 	addWeaponMasteryStmt                    *sql.Stmt
+	advanceActiveEffectsStmt                *sql.Stmt
+	advanceCharacterConditionsStmt          *sql.Stmt
+	claimAbilityRollStmt                    *sql.Stmt
 	clearPreparedSpellsStmt                 *sql.Stmt
+	countCarriedSpellbooksWithSpellStmt     *sql.Stmt
+	countCharactersByClassStmt              *sql.Stmt
+	countInventoryItemsByItemStmt           *sql.Stmt
+	countPreparedSpellInstancesStmt         *sql.Stmt
 	countPreparedSpellsByLevelAndClassStmt  *sql.Stmt
+	countSpellReferencesStmt                *sql.Stmt
+	countUnrecoveredTurnUndeadAttemptsStmt  *sql.Stmt
+	countUnusedDiceAbilityRollsStmt         *sql.Stmt
+	countUsersByRoleStmt                    *sql.Stmt
 	countWeaponMasteriesStmt                *sql.Stmt
+	createAbilityStmt                       *sql.Stmt
+	createAbilityRollStmt                   *sql.Stmt
+	createActiveEffectStmt                  *sql.Stmt
 	createAmmoStmt                          *sql.Stmt
 	createArmorStmt                         *sql.Stmt
+	createAttributeAdjustmentStmt           *sql.Stmt
 	createCharacterStmt                     *sql.Stmt
+	createClassAbilityMappingStmt           *sql.Stmt
+	createClassBackstabMultiplierStmt       *sql.Stmt
+	createClassLevelStmt                    *sql.Stmt
+	createClassMartialArtsStmt              *sql.Stmt
+	createClassNaturalACStmt                *sql.Stmt
+	createClassRulesStmt                    *sql.Stmt
+	createClassRunesPerDayStmt              *sql.Stmt
+	createConditionStmt                     *sql.Stmt
 	createContainerStmt                     *sql.Stmt
+	createContentPackStmt                   *sql.Stmt
+	createContentPackEntryStmt              *sql.Stmt
+	createEncounterStmt                     *sql.Stmt
 	createEquipmentStmt                     *sql.Stmt
 	createInventoryStmt                     *sql.Stmt
+	createItemUseStmt                       *sql.Stmt
+	createKindredStmt                       *sql.Stmt
+	createLevelUpStmt                       *sql.Stmt
 	createMagicItemStmt                     *sql.Stmt
+	createMonsterStmt                       *sql.Stmt
 	createPotionStmt                        *sql.Stmt
 	createRingStmt                          *sql.Stmt
 	createShieldStmt                        *sql.Stmt
 	createSpellStmt                         *sql.Stmt
+	createSpellCastStmt                     *sql.Stmt
+	createSpellLearningAttemptStmt          *sql.Stmt
 	createSpellScrollStmt                   *sql.Stmt
+	createSpellbookStmt                     *sql.Stmt
+	createThiefSkillCheckStmt               *sql.Stmt
 	createTreasureStmt                      *sql.Stmt
+	createTurnUndeadAttemptStmt             *sql.Stmt
 	createUserStmt                          *sql.Stmt
 	createWeaponStmt                        *sql.Stmt
+	createXPAwardStmt                       *sql.Stmt
+	deleteAbilityStmt                       *sql.Stmt
+	deleteActiveEffectStmt                  *sql.Stmt
 	deleteAmmoStmt                          *sql.Stmt
 	deleteArmorStmt                         *sql.Stmt
+	deleteAttributeAdjustmentStmt           *sql.Stmt
 	deleteCharacterStmt                     *sql.Stmt
+	deleteCharacterConditionStmt            *sql.Stmt
+	deleteCharacterGrantStmt                *sql.Stmt
+	deleteClassStmt                         *sql.Stmt
+	deleteClassAbilityMappingsStmt          *sql.Stmt
+	deleteClassBackstabMultipliersStmt      *sql.Stmt
+	deleteClassMartialArtsStmt              *sql.Stmt
+	deleteClassNaturalACStmt                *sql.Stmt
+	deleteClassRulesStmt                    *sql.Stmt
+	deleteClassRunesPerDayStmt              *sql.Stmt
+	deleteConditionStmt                     *sql.Stmt
 	deleteContainerStmt                     *sql.Stmt
+	deleteContentPackStmt                   *sql.Stmt
+	deleteContentPackEntriesStmt            *sql.Stmt
+	deleteEncounterStmt                     *sql.Stmt
+	deleteEncounterParticipantStmt          *sql.Stmt
 	deleteEquipmentStmt                     *sql.Stmt
+	deleteExpiredActiveEffectsStmt          *sql.Stmt
+	deleteExpiredCharacterConditionsStmt    *sql.Stmt
 	deleteInventoryStmt                     *sql.Stmt
+	deleteKindredStmt                       *sql.Stmt
 	deleteMagicItemStmt                     *sql.Stmt
+	deleteMonsterStmt                       *sql.Stmt
 	deletePotionStmt                        *sql.Stmt
 	deleteRingStmt                          *sql.Stmt
 	deleteShieldStmt                        *sql.Stmt
@@ -1497,107 +2477,139 @@ type Queries struct {
 	deleteUserStmt                          *sql.Stmt
 	deleteWeaponStmt                        *sql.Stmt
 	deleteWeaponMasteryStmt                 *sql.Stmt
+	expendPreparedSpellStmt                 *sql.Stmt
+	getAbilityRollStmt                      *sql.Stmt
+	getAbilityRollByCharacterStmt           *sql.Stmt
+	getAbilityRollsByUserStmt               *sql.Stmt
+	getActiveEffectStmt                     *sql.Stmt
+	getActiveEffectsByCharacterStmt         *sql.Stmt
 	getAllClassDataStmt                     *sql.Stmt
 	getAmmoStmt                             *sql.Stmt
 	getAmmoByNameStmt                       *sql.Stmt
 	getArmorStmt                            *sql.Stmt
 	getArmorByNameStmt                      *sql.Stmt
-	getBarbarianAbilitiesStmt               *sql.Stmt
-	getBardAbilitiesStmt                    *sql.Stmt
+	getAttributeAdjustmentStmt              *sql.Stmt
+	getAttributeAdjustmentsByCharacterStmt  *sql.Stmt
 	getBardDruidSpellsStmt                  *sql.Stmt
 	getBardIllusionistSpellsStmt            *sql.Stmt
-	getBerserkerAbilitiesStmt               *sql.Stmt
-	getBerserkerNaturalACStmt               *sql.Stmt
-	getCataphractAbilitiesStmt              *sql.Stmt
 	getCharacterStmt                        *sql.Stmt
+	getCharacterConditionStmt               *sql.Stmt
+	getCharacterConditionsStmt              *sql.Stmt
 	getCharacterForSpellcastingStmt         *sql.Stmt
+	getCharacterGrantStmt                   *sql.Stmt
+	getCharacterGrantsStmt                  *sql.Stmt
+	getCharacterGrantsByUserStmt            *sql.Stmt
+	getCharacterInventoryItemStmt           *sql.Stmt
+	getCharacterSpellScrollItemStmt         *sql.Stmt
 	getCharactersByUserStmt                 *sql.Stmt
 	getClassAbilitiesStmt                   *sql.Stmt
 	getClassAbilitiesByLevelStmt            *sql.Stmt
+	getClassBackstabMultiplierStmt          *sql.Stmt
 	getClassDataStmt                        *sql.Stmt
 	getClassDataForSpellcastingStmt         *sql.Stmt
-	getClericAbilitiesStmt                  *sql.Stmt
-	getClericTurningAbilityStmt             *sql.Stmt
+	getClassExtraSpellSlotsStmt             *sql.Stmt
+	getClassMartialArtsStmt                 *sql.Stmt
+	getClassNaturalACStmt                   *sql.Stmt
+	getClassRulesStmt                       *sql.Stmt
+	getClassRunesPerDayStmt                 *sql.Stmt
+	getClassTurningAbilityStmt              *sql.Stmt
+	getConditionStmt                        *sql.Stmt
+	getConditionByNameStmt                  *sql.Stmt
 	getContainerStmt                        *sql.Stmt
 	getContainerByNameStmt                  *sql.Stmt
-	getCryomancerAbilitiesStmt              *sql.Stmt
-	getDruidAbilitiesStmt                   *sql.Stmt
+	getContentPackStmt                      *sql.Stmt
+	getContentPackByNamespaceStmt           *sql.Stmt
+	getEncounterStmt                        *sql.Stmt
+	getEncounterParticipantsStmt            *sql.Stmt
+	getEncountersByUserStmt                 *sql.Stmt
 	getEquipmentStmt                        *sql.Stmt
 	getEquipmentByNameStmt                  *sql.Stmt
 	getEquippedItemsStmt                    *sql.Stmt
-	getFighterAbilitiesStmt                 *sql.Stmt
+	getFailedSpellLearningAttemptStmt       *sql.Stmt
 	getFullUserByEmailStmt                  *sql.Stmt
-	getHuntsmanAbilitiesStmt                *sql.Stmt
-	getIllusionistAbilitiesStmt             *sql.Stmt
 	getInventoryStmt                        *sql.Stmt
 	getInventoryByCharacterStmt             *sql.Stmt
 	getInventoryItemStmt                    *sql.Stmt
 	getInventoryItemByTypeAndItemIDStmt     *sql.Stmt
 	getInventoryItemsStmt                   *sql.Stmt
 	getInventoryItemsByTypeStmt             *sql.Stmt
+	getItemUseStmt                          *sql.Stmt
+	getItemUsesByCharacterStmt              *sql.Stmt
 	getItemsBySlotStmt                      *sql.Stmt
+	getKindredStmt                          *sql.Stmt
+	getKindredByNameStmt                    *sql.Stmt
 	getKnownSpellByCharacterAndSpellStmt    *sql.Stmt
 	getKnownSpellsStmt                      *sql.Stmt
 	getKnownSpellsByClassStmt               *sql.Stmt
-	getLegerdemainistAbilitiesStmt          *sql.Stmt
+	getLevelUpStmt                          *sql.Stmt
+	getLevelUpsByCharacterStmt              *sql.Stmt
 	getMagicItemStmt                        *sql.Stmt
 	getMagicItemByNameStmt                  *sql.Stmt
-	getMagicianAbilitiesStmt                *sql.Stmt
-	getMonkACBonusStmt                      *sql.Stmt
-	getMonkAbilitiesStmt                    *sql.Stmt
-	getMonkEmptyHandDamageStmt              *sql.Stmt
-	getNecromancerAbilitiesStmt             *sql.Stmt
-	getNecromancerTurningAbilityStmt        *sql.Stmt
+	getMonsterStmt                          *sql.Stmt
+	getMonsterByNameStmt                    *sql.Stmt
 	getNextAvailableSlotIndexStmt           *sql.Stmt
 	getNextLevelDataStmt                    *sql.Stmt
-	getPaladinAbilitiesStmt                 *sql.Stmt
-	getPaladinTurningAbilityStmt            *sql.Stmt
+	getNextUnexpendedPreparedSpellStmt      *sql.Stmt
 	getPotionStmt                           *sql.Stmt
 	getPotionByNameStmt                     *sql.Stmt
+	getPreparedSpellStmt                    *sql.Stmt
 	getPreparedSpellByCharacterAndSpellStmt *sql.Stmt
+	getPreparedSpellBySlotStmt              *sql.Stmt
 	getPreparedSpellsStmt                   *sql.Stmt
 	getPreparedSpellsByClassStmt            *sql.Stmt
-	getPriestAbilitiesStmt                  *sql.Stmt
-	getPurloinerAbilitiesStmt               *sql.Stmt
-	getPyromancerAbilitiesStmt              *sql.Stmt
-	getRangerAbilitiesStmt                  *sql.Stmt
 	getRangerDruidSpellSlotsStmt            *sql.Stmt
 	getRangerMagicianSpellSlotsStmt         *sql.Stmt
 	getRingStmt                             *sql.Stmt
 	getRingByNameStmt                       *sql.Stmt
-	getRunegraverAbilitiesStmt              *sql.Stmt
-	getRunesPerDayStmt                      *sql.Stmt
-	getScoutAbilitiesStmt                   *sql.Stmt
-	getShamanAbilitiesStmt                  *sql.Stmt
 	getShamanArcaneSpellsStmt               *sql.Stmt
 	getShamanDivineSpellsStmt               *sql.Stmt
 	getShieldStmt                           *sql.Stmt
 	getShieldByNameStmt                     *sql.Stmt
 	getSpellStmt                            *sql.Stmt
+	getSpellCastStmt                        *sql.Stmt
+	getSpellCastsByCharacterStmt            *sql.Stmt
 	getSpellForSpellcastingStmt             *sql.Stmt
+	getSpellLearningAttemptStmt             *sql.Stmt
+	getSpellLearningAttemptsByCharacterStmt *sql.Stmt
 	getSpellScrollStmt                      *sql.Stmt
 	getSpellScrollsBySpellStmt              *sql.Stmt
+	getSpellbookStmt                        *sql.Stmt
+	getSpellbookForCharacterStmt            *sql.Stmt
+	getSpellbookOwnerStmt                   *sql.Stmt
+	getSpellbookSpellStmt                   *sql.Stmt
+	getSpellbookSpellsStmt                  *sql.Stmt
+	getSpellbooksByCharacterStmt            *sql.Stmt
 	getSpellsByClassLevelStmt               *sql.Stmt
-	getThiefAbilitiesStmt                   *sql.Stmt
+	getThiefSkillCheckStmt                  *sql.Stmt
+	getThiefSkillChecksByCharacterStmt      *sql.Stmt
 	getThiefSkillsByLevelStmt               *sql.Stmt
 	getTreasureStmt                         *sql.Stmt
 	getTreasureByCharacterStmt              *sql.Stmt
+	getTurnUndeadAttemptStmt                *sql.Stmt
+	getTurnUndeadAttemptsByCharacterStmt    *sql.Stmt
 	getUserStmt                             *sql.Stmt
-	getWarlockAbilitiesStmt                 *sql.Stmt
 	getWeaponStmt                           *sql.Stmt
 	getWeaponByNameStmt                     *sql.Stmt
 	getWeaponMasteriesByCharacterStmt       *sql.Stmt
 	getWeaponMasteryByBaseNameStmt          *sql.Stmt
 	getWeaponMasteryByIDStmt                *sql.Stmt
-	getWitchAbilitiesStmt                   *sql.Stmt
+	getXPAwardStmt                          *sql.Stmt
+	getXPAwardReversalStmt                  *sql.Stmt
+	getXPAwardsByCharacterStmt              *sql.Stmt
 	listAmmoStmt                            *sql.Stmt
 	listArmorsStmt                          *sql.Stmt
 	listCharactersStmt                      *sql.Stmt
+	listClassRulesStmt                      *sql.Stmt
+	listConditionsStmt                      *sql.Stmt
 	listContainersStmt                      *sql.Stmt
+	listContentPackEntriesStmt              *sql.Stmt
+	listContentPacksStmt                    *sql.Stmt
 	listEquipmentStmt                       *sql.Stmt
 	listInventoriesStmt                     *sql.Stmt
+	listKindredsStmt                        *sql.Stmt
 	listMagicItemsStmt                      *sql.Stmt
 	listMagicItemsByTypeStmt                *sql.Stmt
+	listMonstersStmt                        *sql.Stmt
 	listPotionsStmt                         *sql.Stmt
 	listRingsStmt                           *sql.Stmt
 	listShieldsStmt                         *sql.Stmt
@@ -1610,64 +2622,147 @@ type Queries struct {
 	markSpellAsMemorizedBySpellIDStmt       *sql.Stmt
 	prepareSpellStmt                        *sql.Stmt
 	recalculateInventoryWeightStmt          *sql.Stmt
+	recoverTurnUndeadAttemptsStmt           *sql.Stmt
+	releaseAbilityRollStmt                  *sql.Stmt
 	removeAllInventoryItemsStmt             *sql.Stmt
 	removeInventoryItemStmt                 *sql.Stmt
 	removeKnownSpellStmt                    *sql.Stmt
+	removeSpellbookSpellStmt                *sql.Stmt
 	resetAllMemorizedSpellsStmt             *sql.Stmt
+	restoreAttributeAdjustmentsStmt         *sql.Stmt
+	restorePreparedSpellStmt                *sql.Stmt
+	retireCharacterStmt                     *sql.Stmt
+	searchMonstersStmt                      *sql.Stmt
+	setAbilityRollCharacterStmt             *sql.Stmt
+	sumXPAwardsByCharacterStmt              *sql.Stmt
 	unprepareSpellStmt                      *sql.Stmt
 	updateAmmoStmt                          *sql.Stmt
 	updateArmorStmt                         *sql.Stmt
 	updateCharacterStmt                     *sql.Stmt
+	updateCharacterExperienceStmt           *sql.Stmt
+	updateCharacterHitPointsStmt            *sql.Stmt
+	updateConditionStmt                     *sql.Stmt
 	updateContainerStmt                     *sql.Stmt
+	updateEncounterInitiativeModeStmt       *sql.Stmt
+	updateEncounterTurnStmt                 *sql.Stmt
 	updateEquipmentStmt                     *sql.Stmt
 	updateInventoryStmt                     *sql.Stmt
 	updateInventoryItemStmt                 *sql.Stmt
+	updateInventoryItemChargesStmt          *sql.Stmt
+	updateInventoryItemUsesStmt             *sql.Stmt
 	updateInventoryWeightStmt               *sql.Stmt
+	updateKindredStmt                       *sql.Stmt
 	updateMagicItemStmt                     *sql.Stmt
+	updateMonsterStmt                       *sql.Stmt
+	updateParticipantHPStmt                 *sql.Stmt
+	updateParticipantInitiativeStmt         *sql.Stmt
 	updatePotionStmt                        *sql.Stmt
 	updateRingStmt                          *sql.Stmt
 	updateShieldStmt                        *sql.Stmt
 	updateSpellStmt                         *sql.Stmt
 	updateSpellScrollStmt                   *sql.Stmt
+	updateSpellbookUsedPagesStmt            *sql.Stmt
 	updateTreasureStmt                      *sql.Stmt
 	updateUserStmt                          *sql.Stmt
 	updateUserPasswordStmt                  *sql.Stmt
+	updateUserRoleStmt                      *sql.Stmt
 	updateWeaponStmt                        *sql.Stmt
 	updateWeaponMasteryLevelStmt            *sql.Stmt
+	upsertCharacterGrantStmt                *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
 		db:                                      tx,
 		tx:                                      tx,
+		addCharacterConditionStmt:               q.addCharacterConditionStmt,
+		addEncounterParticipantStmt:             q.addEncounterParticipantStmt,
 		addInventoryItemStmt:                    q.addInventoryItemStmt,
 		addKnownSpellStmt:                       q.addKnownSpellStmt,
+		addSpellbookSpellStmt:                   q.addSpellbookSpellStmt,
 		addWeaponMasteryStmt:                    q.addWeaponMasteryStmt,
+		advanceActiveEffectsStmt:                q.advanceActiveEffectsStmt,
+		advanceCharacterConditionsStmt:          q.advanceCharacterConditionsStmt,
+		claimAbilityRollStmt:                    q.claimAbilityRollStmt,
 		clearPreparedSpellsStmt:                 q.clearPreparedSpellsStmt,
+		countCarriedSpellbooksWithSpellStmt:     q.countCarriedSpellbooksWithSpellStmt,
+		countCharactersByClassStmt:              q.countCharactersByClassStmt,
+		countInventoryItemsByItemStmt:           q.countInventoryItemsByItemStmt,
+		countPreparedSpellInstancesStmt:         q.countPreparedSpellInstancesStmt,
 		countPreparedSpellsByLevelAndClassStmt:  q.countPreparedSpellsByLevelAndClassStmt,
+		countSpellReferencesStmt:                q.countSpellReferencesStmt,
+		countUnrecoveredTurnUndeadAttemptsStmt:  q.countUnrecoveredTurnUndeadAttemptsStmt,
+		countUnusedDiceAbilityRollsStmt:         q.countUnusedDiceAbilityRollsStmt,
+		countUsersByRoleStmt:                    q.countUsersByRoleStmt,
 		countWeaponMasteriesStmt:                q.countWeaponMasteriesStmt,
+		createAbilityStmt:                       q.createAbilityStmt,
+		createAbilityRollStmt:                   q.createAbilityRollStmt,
+		createActiveEffectStmt:                  q.createActiveEffectStmt,
 		createAmmoStmt:                          q.createAmmoStmt,
 		createArmorStmt:                         q.createArmorStmt,
+		createAttributeAdjustmentStmt:           q.createAttributeAdjustmentStmt,
 		createCharacterStmt:                     q.createCharacterStmt,
+		createClassAbilityMappingStmt:           q.createClassAbilityMappingStmt,
+		createClassBackstabMultiplierStmt:       q.createClassBackstabMultiplierStmt,
+		createClassLevelStmt:                    q.createClassLevelStmt,
+		createClassMartialArtsStmt:              q.createClassMartialArtsStmt,
+		createClassNaturalACStmt:                q.createClassNaturalACStmt,
+		createClassRulesStmt:                    q.createClassRulesStmt,
+		createClassRunesPerDayStmt:              q.createClassRunesPerDayStmt,
+		createConditionStmt:                     q.createConditionStmt,
 		createContainerStmt:                     q.createContainerStmt,
+		createContentPackStmt:                   q.createContentPackStmt,
+		createContentPackEntryStmt:              q.createContentPackEntryStmt,
+		createEncounterStmt:                     q.createEncounterStmt,
 		createEquipmentStmt:                     q.createEquipmentStmt,
 		createInventoryStmt:                     q.createInventoryStmt,
+		createItemUseStmt:                       q.createItemUseStmt,
+		createKindredStmt:                       q.createKindredStmt,
+		createLevelUpStmt:                       q.createLevelUpStmt,
 		createMagicItemStmt:                     q.createMagicItemStmt,
+		createMonsterStmt:                       q.createMonsterStmt,
 		createPotionStmt:                        q.createPotionStmt,
 		createRingStmt:                          q.createRingStmt,
 		createShieldStmt:                        q.createShieldStmt,
 		createSpellStmt:                         q.createSpellStmt,
+		createSpellCastStmt:                     q.createSpellCastStmt,
+		createSpellLearningAttemptStmt:          q.createSpellLearningAttemptStmt,
 		createSpellScrollStmt:                   q.createSpellScrollStmt,
+		createSpellbookStmt:                     q.createSpellbookStmt,
+		createThiefSkillCheckStmt:               q.createThiefSkillCheckStmt,
 		createTreasureStmt:                      q.createTreasureStmt,
+		createTurnUndeadAttemptStmt:             q.createTurnUndeadAttemptStmt,
 		createUserStmt:                          q.createUserStmt,
 		createWeaponStmt:                        q.createWeaponStmt,
+		createXPAwardStmt:                       q.createXPAwardStmt,
+		deleteAbilityStmt:                       q.deleteAbilityStmt,
+		deleteActiveEffectStmt:                  q.deleteActiveEffectStmt,
 		deleteAmmoStmt:                          q.deleteAmmoStmt,
 		deleteArmorStmt:                         q.deleteArmorStmt,
+		deleteAttributeAdjustmentStmt:           q.deleteAttributeAdjustmentStmt,
 		deleteCharacterStmt:                     q.deleteCharacterStmt,
+		deleteCharacterConditionStmt:            q.deleteCharacterConditionStmt,
+		deleteCharacterGrantStmt:                q.deleteCharacterGrantStmt,
+		deleteClassStmt:                         q.deleteClassStmt,
+		deleteClassAbilityMappingsStmt:          q.deleteClassAbilityMappingsStmt,
+		deleteClassBackstabMultipliersStmt:      q.deleteClassBackstabMultipliersStmt,
+		deleteClassMartialArtsStmt:              q.deleteClassMartialArtsStmt,
+		deleteClassNaturalACStmt:                q.deleteClassNaturalACStmt,
+		deleteClassRulesStmt:                    q.deleteClassRulesStmt,
+		deleteClassRunesPerDayStmt:              q.deleteClassRunesPerDayStmt,
+		deleteConditionStmt:                     q.deleteConditionStmt,
 		deleteContainerStmt:                     q.deleteContainerStmt,
+		deleteContentPackStmt:                   q.deleteContentPackStmt,
+		deleteContentPackEntriesStmt:            q.deleteContentPackEntriesStmt,
+		deleteEncounterStmt:                     q.deleteEncounterStmt,
+		deleteEncounterParticipantStmt:          q.deleteEncounterParticipantStmt,
 		deleteEquipmentStmt:                     q.deleteEquipmentStmt,
+		deleteExpiredActiveEffectsStmt:          q.deleteExpiredActiveEffectsStmt,
+		deleteExpiredCharacterConditionsStmt:    q.deleteExpiredCharacterConditionsStmt,
 		deleteInventoryStmt:                     q.deleteInventoryStmt,
+		deleteKindredStmt:                       q.deleteKindredStmt,
 		deleteMagicItemStmt:                     q.deleteMagicItemStmt,
+		deleteMonsterStmt:                       q.deleteMonsterStmt,
 		deletePotionStmt:                        q.deletePotionStmt,
 		deleteRingStmt:                          q.deleteRingStmt,
 		deleteShieldStmt:                        q.deleteShieldStmt,
@@ -1677,107 +2772,139 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		deleteUserStmt:                          q.deleteUserStmt,
 		deleteWeaponStmt:                        q.deleteWeaponStmt,
 		deleteWeaponMasteryStmt:                 q.deleteWeaponMasteryStmt,
+		expendPreparedSpellStmt:                 q.expendPreparedSpellStmt,
+		getAbilityRollStmt:                      q.getAbilityRollStmt,
+		getAbilityRollByCharacterStmt:           q.getAbilityRollByCharacterStmt,
+		getAbilityRollsByUserStmt:               q.getAbilityRollsByUserStmt,
+		getActiveEffectStmt:                     q.getActiveEffectStmt,
+		getActiveEffectsByCharacterStmt:         q.getActiveEffectsByCharacterStmt,
 		getAllClassDataStmt:                     q.getAllClassDataStmt,
 		getAmmoStmt:                             q.getAmmoStmt,
 		getAmmoByNameStmt:                       q.getAmmoByNameStmt,
 		getArmorStmt:                            q.getArmorStmt,
 		getArmorByNameStmt:                      q.getArmorByNameStmt,
-		getBarbarianAbilitiesStmt:               q.getBarbarianAbilitiesStmt,
-		getBardAbilitiesStmt:                    q.getBardAbilitiesStmt,
+		getAttributeAdjustmentStmt:              q.getAttributeAdjustmentStmt,
+		getAttributeAdjustmentsByCharacterStmt:  q.getAttributeAdjustmentsByCharacterStmt,
 		getBardDruidSpellsStmt:                  q.getBardDruidSpellsStmt,
 		getBardIllusionistSpellsStmt:            q.getBardIllusionistSpellsStmt,
-		getBerserkerAbilitiesStmt:               q.getBerserkerAbilitiesStmt,
-		getBerserkerNaturalACStmt:               q.getBerserkerNaturalACStmt,
-		getCataphractAbilitiesStmt:              q.getCataphractAbilitiesStmt,
 		getCharacterStmt:                        q.getCharacterStmt,
+		getCharacterConditionStmt:               q.getCharacterConditionStmt,
+		getCharacterConditionsStmt:              q.getCharacterConditionsStmt,
 		getCharacterForSpellcastingStmt:         q.getCharacterForSpellcastingStmt,
+		getCharacterGrantStmt:                   q.getCharacterGrantStmt,
+		getCharacterGrantsStmt:                  q.getCharacterGrantsStmt,
+		getCharacterGrantsByUserStmt:            q.getCharacterGrantsByUserStmt,
+		getCharacterInventoryItemStmt:           q.getCharacterInventoryItemStmt,
+		getCharacterSpellScrollItemStmt:         q.getCharacterSpellScrollItemStmt,
 		getCharactersByUserStmt:                 q.getCharactersByUserStmt,
 		getClassAbilitiesStmt:                   q.getClassAbilitiesStmt,
 		getClassAbilitiesByLevelStmt:            q.getClassAbilitiesByLevelStmt,
+		getClassBackstabMultiplierStmt:          q.getClassBackstabMultiplierStmt,
 		getClassDataStmt:                        q.getClassDataStmt,
 		getClassDataForSpellcastingStmt:         q.getClassDataForSpellcastingStmt,
-		getClericAbilitiesStmt:                  q.getClericAbilitiesStmt,
-		getClericTurningAbilityStmt:             q.getClericTurningAbilityStmt,
+		getClassExtraSpellSlotsStmt:             q.getClassExtraSpellSlotsStmt,
+		getClassMartialArtsStmt:                 q.getClassMartialArtsStmt,
+		getClassNaturalACStmt:                   q.getClassNaturalACStmt,
+		getClassRulesStmt:                       q.getClassRulesStmt,
+		getClassRunesPerDayStmt:                 q.getClassRunesPerDayStmt,
+		getClassTurningAbilityStmt:              q.getClassTurningAbilityStmt,
+		getConditionStmt:                        q.getConditionStmt,
+		getConditionByNameStmt:                  q.getConditionByNameStmt,
 		getContainerStmt:                        q.getContainerStmt,
 		getContainerByNameStmt:                  q.getContainerByNameStmt,
-		getCryomancerAbilitiesStmt:              q.getCryomancerAbilitiesStmt,
-		getDruidAbilitiesStmt:                   q.getDruidAbilitiesStmt,
+		getContentPackStmt:                      q.getContentPackStmt,
+		getContentPackByNamespaceStmt:           q.getContentPackByNamespaceStmt,
+		getEncounterStmt:                        q.getEncounterStmt,
+		getEncounterParticipantsStmt:            q.getEncounterParticipantsStmt,
+		getEncountersByUserStmt:                 q.getEncountersByUserStmt,
 		getEquipmentStmt:                        q.getEquipmentStmt,
 		getEquipmentByNameStmt:                  q.getEquipmentByNameStmt,
 		getEquippedItemsStmt:                    q.getEquippedItemsStmt,
-		getFighterAbilitiesStmt:                 q.getFighterAbilitiesStmt,
+		getFailedSpellLearningAttemptStmt:       q.getFailedSpellLearningAttemptStmt,
 		getFullUserByEmailStmt:                  q.getFullUserByEmailStmt,
-		getHuntsmanAbilitiesStmt:                q.getHuntsmanAbilitiesStmt,
-		getIllusionistAbilitiesStmt:             q.getIllusionistAbilitiesStmt,
 		getInventoryStmt:                        q.getInventoryStmt,
 		getInventoryByCharacterStmt:             q.getInventoryByCharacterStmt,
 		getInventoryItemStmt:                    q.getInventoryItemStmt,
 		getInventoryItemByTypeAndItemIDStmt:     q.getInventoryItemByTypeAndItemIDStmt,
 		getInventoryItemsStmt:                   q.getInventoryItemsStmt,
 		getInventoryItemsByTypeStmt:             q.getInventoryItemsByTypeStmt,
+		getItemUseStmt:                          q.getItemUseStmt,
+		getItemUsesByCharacterStmt:              q.getItemUsesByCharacterStmt,
 		getItemsBySlotStmt:                      q.getItemsBySlotStmt,
+		getKindredStmt:                          q.getKindredStmt,
+		getKindredByNameStmt:                    q.getKindredByNameStmt,
 		getKnownSpellByCharacterAndSpellStmt:    q.getKnownSpellByCharacterAndSpellStmt,
 		getKnownSpellsStmt:                      q.getKnownSpellsStmt,
 		getKnownSpellsByClassStmt:               q.getKnownSpellsByClassStmt,
-		getLegerdemainistAbilitiesStmt:          q.getLegerdemainistAbilitiesStmt,
+		getLevelUpStmt:                          q.getLevelUpStmt,
+		getLevelUpsByCharacterStmt:              q.getLevelUpsByCharacterStmt,
 		getMagicItemStmt:                        q.getMagicItemStmt,
 		getMagicItemByNameStmt:                  q.getMagicItemByNameStmt,
-		getMagicianAbilitiesStmt:                q.getMagicianAbilitiesStmt,
-		getMonkACBonusStmt:                      q.getMonkACBonusStmt,
-		getMonkAbilitiesStmt:                    q.getMonkAbilitiesStmt,
-		getMonkEmptyHandDamageStmt:              q.getMonkEmptyHandDamageStmt,
-		getNecromancerAbilitiesStmt:             q.getNecromancerAbilitiesStmt,
-		getNecromancerTurningAbilityStmt:        q.getNecromancerTurningAbilityStmt,
+		getMonsterStmt:                          q.getMonsterStmt,
+		getMonsterByNameStmt:                    q.getMonsterByNameStmt,
 		getNextAvailableSlotIndexStmt:           q.getNextAvailableSlotIndexStmt,
 		getNextLevelDataStmt:                    q.getNextLevelDataStmt,
-		getPaladinAbilitiesStmt:                 q.getPaladinAbilitiesStmt,
-		getPaladinTurningAbilityStmt:            q.getPaladinTurningAbilityStmt,
+		getNextUnexpendedPreparedSpellStmt:      q.getNextUnexpendedPreparedSpellStmt,
 		getPotionStmt:                           q.getPotionStmt,
 		getPotionByNameStmt:                     q.getPotionByNameStmt,
+		getPreparedSpellStmt:                    q.getPreparedSpellStmt,
 		getPreparedSpellByCharacterAndSpellStmt: q.getPreparedSpellByCharacterAndSpellStmt,
+		getPreparedSpellBySlotStmt:              q.getPreparedSpellBySlotStmt,
 		getPreparedSpellsStmt:                   q.getPreparedSpellsStmt,
 		getPreparedSpellsByClassStmt:            q.getPreparedSpellsByClassStmt,
-		getPriestAbilitiesStmt:                  q.getPriestAbilitiesStmt,
-		getPurloinerAbilitiesStmt:               q.getPurloinerAbilitiesStmt,
-		getPyromancerAbilitiesStmt:              q.getPyromancerAbilitiesStmt,
-		getRangerAbilitiesStmt:                  q.getRangerAbilitiesStmt,
 		getRangerDruidSpellSlotsStmt:            q.getRangerDruidSpellSlotsStmt,
 		getRangerMagicianSpellSlotsStmt:         q.getRangerMagicianSpellSlotsStmt,
 		getRingStmt:                             q.getRingStmt,
 		getRingByNameStmt:                       q.getRingByNameStmt,
-		getRunegraverAbilitiesStmt:              q.getRunegraverAbilitiesStmt,
-		getRunesPerDayStmt:                      q.getRunesPerDayStmt,
-		getScoutAbilitiesStmt:                   q.getScoutAbilitiesStmt,
-		getShamanAbilitiesStmt:                  q.getShamanAbilitiesStmt,
 		getShamanArcaneSpellsStmt:               q.getShamanArcaneSpellsStmt,
 		getShamanDivineSpellsStmt:               q.getShamanDivineSpellsStmt,
 		getShieldStmt:                           q.getShieldStmt,
 		getShieldByNameStmt:                     q.getShieldByNameStmt,
 		getSpellStmt:                            q.getSpellStmt,
+		getSpellCastStmt:                        q.getSpellCastStmt,
+		getSpellCastsByCharacterStmt:            q.getSpellCastsByCharacterStmt,
 		getSpellForSpellcastingStmt:             q.getSpellForSpellcastingStmt,
+		getSpellLearningAttemptStmt:             q.getSpellLearningAttemptStmt,
+		getSpellLearningAttemptsByCharacterStmt: q.getSpellLearningAttemptsByCharacterStmt,
 		getSpellScrollStmt:                      q.getSpellScrollStmt,
 		getSpellScrollsBySpellStmt:              q.getSpellScrollsBySpellStmt,
+		getSpellbookStmt:                        q.getSpellbookStmt,
+		getSpellbookForCharacterStmt:            q.getSpellbookForCharacterStmt,
+		getSpellbookOwnerStmt:                   q.getSpellbookOwnerStmt,
+		getSpellbookSpellStmt:                   q.getSpellbookSpellStmt,
+		getSpellbookSpellsStmt:                  q.getSpellbookSpellsStmt,
+		getSpellbooksByCharacterStmt:            q.getSpellbooksByCharacterStmt,
 		getSpellsByClassLevelStmt:               q.getSpellsByClassLevelStmt,
-		getThiefAbilitiesStmt:                   q.getThiefAbilitiesStmt,
+		getThiefSkillCheckStmt:                  q.getThiefSkillCheckStmt,
+		getThiefSkillChecksByCharacterStmt:      q.getThiefSkillChecksByCharacterStmt,
 		getThiefSkillsByLevelStmt:               q.getThiefSkillsByLevelStmt,
 		getTreasureStmt:                         q.getTreasureStmt,
 		getTreasureByCharacterStmt:              q.getTreasureByCharacterStmt,
+		getTurnUndeadAttemptStmt:                q.getTurnUndeadAttemptStmt,
+		getTurnUndeadAttemptsByCharacterStmt:    q.getTurnUndeadAttemptsByCharacterStmt,
 		getUserStmt:                             q.getUserStmt,
-		getWarlockAbilitiesStmt:                 q.getWarlockAbilitiesStmt,
 		getWeaponStmt:                           q.getWeaponStmt,
 		getWeaponByNameStmt:                     q.getWeaponByNameStmt,
 		getWeaponMasteriesByCharacterStmt:       q.getWeaponMasteriesByCharacterStmt,
 		getWeaponMasteryByBaseNameStmt:          q.getWeaponMasteryByBaseNameStmt,
 		getWeaponMasteryByIDStmt:                q.getWeaponMasteryByIDStmt,
-		getWitchAbilitiesStmt:                   q.getWitchAbilitiesStmt,
+		getXPAwardStmt:                          q.getXPAwardStmt,
+		getXPAwardReversalStmt:                  q.getXPAwardReversalStmt,
+		getXPAwardsByCharacterStmt:              q.getXPAwardsByCharacterStmt,
 		listAmmoStmt:                            q.listAmmoStmt,
 		listArmorsStmt:                          q.listArmorsStmt,
 		listCharactersStmt:                      q.listCharactersStmt,
+		listClassRulesStmt:                      q.listClassRulesStmt,
+		listConditionsStmt:                      q.listConditionsStmt,
 		listContainersStmt:                      q.listContainersStmt,
+		listContentPackEntriesStmt:              q.listContentPackEntriesStmt,
+		listContentPacksStmt:                    q.listContentPacksStmt,
 		listEquipmentStmt:                       q.listEquipmentStmt,
 		listInventoriesStmt:                     q.listInventoriesStmt,
+		listKindredsStmt:                        q.listKindredsStmt,
 		listMagicItemsStmt:                      q.listMagicItemsStmt,
 		listMagicItemsByTypeStmt:                q.listMagicItemsByTypeStmt,
+		listMonstersStmt:                        q.listMonstersStmt,
 		listPotionsStmt:                         q.listPotionsStmt,
 		listRingsStmt:                           q.listRingsStmt,
 		listShieldsStmt:                         q.listShieldsStmt,
@@ -1790,29 +2917,52 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		markSpellAsMemorizedBySpellIDStmt:       q.markSpellAsMemorizedBySpellIDStmt,
 		prepareSpellStmt:                        q.prepareSpellStmt,
 		recalculateInventoryWeightStmt:          q.recalculateInventoryWeightStmt,
+		recoverTurnUndeadAttemptsStmt:           q.recoverTurnUndeadAttemptsStmt,
+		releaseAbilityRollStmt:                  q.releaseAbilityRollStmt,
 		removeAllInventoryItemsStmt:             q.removeAllInventoryItemsStmt,
 		removeInventoryItemStmt:                 q.removeInventoryItemStmt,
 		removeKnownSpellStmt:                    q.removeKnownSpellStmt,
+		removeSpellbookSpellStmt:                q.removeSpellbookSpellStmt,
 		resetAllMemorizedSpellsStmt:             q.resetAllMemorizedSpellsStmt,
+		restoreAttributeAdjustmentsStmt:         q.restoreAttributeAdjustmentsStmt,
+		restorePreparedSpellStmt:                q.restorePreparedSpellStmt,
+		retireCharacterStmt:                     q.retireCharacterStmt,
+		searchMonstersStmt:                      q.searchMonstersStmt,
+		setAbilityRollCharacterStmt:             q.setAbilityRollCharacterStmt,
+		sumXPAwardsByCharacterStmt:              q.sumXPAwardsByCharacterStmt,
 		unprepareSpellStmt:                      q.unprepareSpellStmt,
 		updateAmmoStmt:                          q.updateAmmoStmt,
 		updateArmorStmt:                         q.updateArmorStmt,
 		updateCharacterStmt:                     q.updateCharacterStmt,
+		updateCharacterExperienceStmt:           q.updateCharacterExperienceStmt,
+		updateCharacterHitPointsStmt:            q.updateCharacterHitPointsStmt,
+		updateConditionStmt:                     q.updateConditionStmt,
 		updateContainerStmt:                     q.updateContainerStmt,
+		updateEncounterInitiativeModeStmt:       q.updateEncounterInitiativeModeStmt,
+		updateEncounterTurnStmt:                 q.updateEncounterTurnStmt,
 		updateEquipmentStmt:                     q.updateEquipmentStmt,
 		updateInventoryStmt:                     q.updateInventoryStmt,
 		updateInventoryItemStmt:                 q.updateInventoryItemStmt,
+		updateInventoryItemChargesStmt:          q.updateInventoryItemChargesStmt,
+		updateInventoryItemUsesStmt:             q.updateInventoryItemUsesStmt,
 		updateInventoryWeightStmt:               q.updateInventoryWeightStmt,
+		updateKindredStmt:                       q.updateKindredStmt,
 		updateMagicItemStmt:                     q.updateMagicItemStmt,
+		updateMonsterStmt:                       q.updateMonsterStmt,
+		updateParticipantHPStmt:                 q.updateParticipantHPStmt,
+		updateParticipantInitiativeStmt:         q.updateParticipantInitiativeStmt,
 		updatePotionStmt:                        q.updatePotionStmt,
 		updateRingStmt:                          q.updateRingStmt,
 		updateShieldStmt:                        q.updateShieldStmt,
 		updateSpellStmt:                         q.updateSpellStmt,
 		updateSpellScrollStmt:                   q.updateSpellScrollStmt,
+		updateSpellbookUsedPagesStmt:            q.updateSpellbookUsedPagesStmt,
 		updateTreasureStmt:                      q.updateTreasureStmt,
 		updateUserStmt:                          q.updateUserStmt,
 		updateUserPasswordStmt:                  q.updateUserPasswordStmt,
+		updateUserRoleStmt:                      q.updateUserRoleStmt,
 		updateWeaponStmt:                        q.updateWeaponStmt,
 		updateWeaponMasteryLevelStmt:            q.updateWeaponMasteryLevelStmt,
+		upsertCharacterGrantStmt:                q.upsertCharacterGrantStmt,
 	}
 }
@@ -12,9 +12,9 @@ import (
 
 const createEquipment = `-- name: CreateEquipment :execresult
 INSERT INTO equipment (
-  name, description, cost, weight
+  name, description, cost, weight, save_bonus
 ) VALUES (
-  ?, ?, ?, ?
+  ?, ?, ?, ?, ?
 )
 `
 
@@ -23,6 +23,7 @@ type CreateEquipmentParams struct {
 	Description string
 	Cost        float64
 	Weight      int64
+	SaveBonus   int64
 }
 
 func (q *Queries) CreateEquipment(ctx context.Context, arg CreateEquipmentParams) (sql.Result, error) {
@@ -31,6 +32,7 @@ func (q *Queries) CreateEquipment(ctx context.Context, arg CreateEquipmentParams
 		arg.Description,
 		arg.Cost,
 		arg.Weight,
+		arg.SaveBonus,
 	)
 }
 
@@ -44,7 +46,7 @@ func (q *Queries) DeleteEquipment(ctx context.Context, id int64) (sql.Result, er
 }
 
 const getEquipment = `-- name: GetEquipment :one
-SELECT id, name, description, cost, weight, created_at, updated_at FROM equipment
+SELECT id, name, description, cost, weight, created_at, updated_at, save_bonus FROM equipment
 WHERE id = ? LIMIT 1
 `
 
@@ -59,12 +61,13 @@ func (q *Queries) GetEquipment(ctx context.Context, id int64) (Equipment, error)
 		&i.Weight,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SaveBonus,
 	)
 	return i, err
 }
 
 const getEquipmentByName = `-- name: GetEquipmentByName :one
-SELECT id, name, description, cost, weight, created_at, updated_at FROM equipment
+SELECT id, name, description, cost, weight, created_at, updated_at, save_bonus FROM equipment
 WHERE name = ? LIMIT 1
 `
 
@@ -79,12 +82,13 @@ func (q *Queries) GetEquipmentByName(ctx context.Context, name string) (Equipmen
 		&i.Weight,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SaveBonus,
 	)
 	return i, err
 }
 
 const listEquipment = `-- name: ListEquipment :many
-SELECT id, name, description, cost, weight, created_at, updated_at FROM equipment
+SELECT id, name, description, cost, weight, created_at, updated_at, save_bonus FROM equipment
 ORDER BY name
 `
 
@@ -105,6 +109,7 @@ func (q *Queries) ListEquipment(ctx context.Context) ([]Equipment, error) {
 			&i.Weight,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SaveBonus,
 		); err != nil {
 			return nil, err
 		}
@@ -125,6 +130,7 @@ SET name = ?,
     description = ?,
     cost = ?,
     weight = ?,
+    save_bonus = ?,
     updated_at = datetime('now')
 WHERE id = ?
 `
@@ -134,6 +140,7 @@ type UpdateEquipmentParams struct {
 	Description string
 	Cost        float64
 	Weight      int64
+	SaveBonus   int64
 	ID          int64
 }
 
@@ -143,6 +150,7 @@ func (q *Queries) UpdateEquipment(ctx context.Context, arg UpdateEquipmentParams
 		arg.Description,
 		arg.Cost,
 		arg.Weight,
+		arg.SaveBonus,
 		arg.ID,
 	)
 }
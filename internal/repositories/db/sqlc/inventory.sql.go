@@ -18,7 +18,9 @@ INSERT INTO inventory_items (
     quantity,
     is_equipped,
     slot,
-    notes
+    notes,
+    charges,
+    uses_remaining
 ) VALUES (
     ?,
     ?,
@@ -26,18 +28,22 @@ INSERT INTO inventory_items (
     ?,
     ?,
     ?,
+    ?,
+    ?,
     ?
 )
 `
 
 type AddInventoryItemParams struct {
-	InventoryID int64
-	ItemType    string
-	ItemID      int64
-	Quantity    int64
-	IsEquipped  bool
-	Slot        sql.NullString
-	Notes       sql.NullString
+	InventoryID   int64
+	ItemType      string
+	ItemID        int64
+	Quantity      int64
+	IsEquipped    bool
+	Slot          sql.NullString
+	Notes         sql.NullString
+	Charges       sql.NullInt64
+	UsesRemaining sql.NullInt64
 }
 
 func (q *Queries) AddInventoryItem(ctx context.Context, arg AddInventoryItemParams) (sql.Result, error) {
@@ -49,6 +55,8 @@ func (q *Queries) AddInventoryItem(ctx context.Context, arg AddInventoryItemPara
 		arg.IsEquipped,
 		arg.Slot,
 		arg.Notes,
+		arg.Charges,
+		arg.UsesRemaining,
 	)
 }
 
@@ -84,7 +92,7 @@ func (q *Queries) DeleteInventory(ctx context.Context, id int64) error {
 }
 
 const getEquippedItems = `-- name: GetEquippedItems :many
-SELECT id, inventory_id, item_type, item_id, quantity, is_equipped, slot, notes, created_at, updated_at FROM inventory_items
+SELECT id, inventory_id, item_type, item_id, quantity, is_equipped, slot, notes, created_at, updated_at, charges, uses_remaining FROM inventory_items
 WHERE inventory_id = ? AND is_equipped = 1
 ORDER BY id
 `
@@ -109,6 +117,8 @@ func (q *Queries) GetEquippedItems(ctx context.Context, inventoryID int64) ([]In
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Charges,
+			&i.UsesRemaining,
 		); err != nil {
 			return nil, err
 		}
@@ -174,7 +184,7 @@ func (q *Queries) GetInventoryByCharacter(ctx context.Context, characterID int64
 }
 
 const getInventoryItem = `-- name: GetInventoryItem :one
-SELECT id, inventory_id, item_type, item_id, quantity, is_equipped, slot, notes, created_at, updated_at FROM inventory_items
+SELECT id, inventory_id, item_type, item_id, quantity, is_equipped, slot, notes, created_at, updated_at, charges, uses_remaining FROM inventory_items
 WHERE id = ? LIMIT 1
 `
 
@@ -192,12 +202,14 @@ func (q *Queries) GetInventoryItem(ctx context.Context, id int64) (InventoryItem
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Charges,
+		&i.UsesRemaining,
 	)
 	return i, err
 }
 
 const getInventoryItemByTypeAndItemID = `-- name: GetInventoryItemByTypeAndItemID :one
-SELECT id, inventory_id, item_type, item_id, quantity, is_equipped, slot, notes, created_at, updated_at FROM inventory_items
+SELECT id, inventory_id, item_type, item_id, quantity, is_equipped, slot, notes, created_at, updated_at, charges, uses_remaining FROM inventory_items
 WHERE inventory_id = ? AND item_type = ? AND item_id = ?
 LIMIT 1
 `
@@ -222,12 +234,14 @@ func (q *Queries) GetInventoryItemByTypeAndItemID(ctx context.Context, arg GetIn
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Charges,
+		&i.UsesRemaining,
 	)
 	return i, err
 }
 
 const getInventoryItems = `-- name: GetInventoryItems :many
-SELECT id, inventory_id, item_type, item_id, quantity, is_equipped, slot, notes, created_at, updated_at FROM inventory_items
+SELECT id, inventory_id, item_type, item_id, quantity, is_equipped, slot, notes, created_at, updated_at, charges, uses_remaining FROM inventory_items
 WHERE inventory_id = ?
 ORDER BY id
 `
@@ -252,6 +266,8 @@ func (q *Queries) GetInventoryItems(ctx context.Context, inventoryID int64) ([]I
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Charges,
+			&i.UsesRemaining,
 		); err != nil {
 			return nil, err
 		}
@@ -267,7 +283,7 @@ func (q *Queries) GetInventoryItems(ctx context.Context, inventoryID int64) ([]I
 }
 
 const getInventoryItemsByType = `-- name: GetInventoryItemsByType :many
-SELECT id, inventory_id, item_type, item_id, quantity, is_equipped, slot, notes, created_at, updated_at FROM inventory_items
+SELECT id, inventory_id, item_type, item_id, quantity, is_equipped, slot, notes, created_at, updated_at, charges, uses_remaining FROM inventory_items
 WHERE inventory_id = ? AND item_type = ?
 ORDER BY id
 `
@@ -297,6 +313,8 @@ func (q *Queries) GetInventoryItemsByType(ctx context.Context, arg GetInventoryI
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Charges,
+			&i.UsesRemaining,
 		); err != nil {
 			return nil, err
 		}
@@ -312,7 +330,7 @@ func (q *Queries) GetInventoryItemsByType(ctx context.Context, arg GetInventoryI
 }
 
 const getItemsBySlot = `-- name: GetItemsBySlot :many
-SELECT id, inventory_id, item_type, item_id, quantity, is_equipped, slot, notes, created_at, updated_at FROM inventory_items
+SELECT id, inventory_id, item_type, item_id, quantity, is_equipped, slot, notes, created_at, updated_at, charges, uses_remaining FROM inventory_items
 WHERE inventory_id = ? AND slot = ? AND is_equipped = 1
 ORDER BY id
 `
@@ -342,6 +360,8 @@ func (q *Queries) GetItemsBySlot(ctx context.Context, arg GetItemsBySlotParams)
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Charges,
+			&i.UsesRemaining,
 		); err != nil {
 			return nil, err
 		}
@@ -412,6 +432,7 @@ SET current_weight = (
             WHEN 'spell_scroll' THEN (SELECT weight FROM spell_scrolls WHERE spell_scrolls.id = ii.item_id)
             WHEN 'container' THEN (SELECT weight FROM containers WHERE containers.id = ii.item_id)
             WHEN 'equipment' THEN (SELECT weight FROM equipment WHERE equipment.id = ii.item_id)
+            WHEN 'spellbook' THEN (SELECT weight FROM spellbooks WHERE spellbooks.id = ii.item_id)
             ELSE 0.1
         END, 0.1)
     ), 0) FROM inventory_items ii WHERE ii.inventory_id = inventories.id)
@@ -490,6 +511,38 @@ func (q *Queries) UpdateInventoryItem(ctx context.Context, arg UpdateInventoryIt
 	)
 }
 
+const updateInventoryItemCharges = `-- name: UpdateInventoryItemCharges :exec
+UPDATE inventory_items
+SET charges = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type UpdateInventoryItemChargesParams struct {
+	Charges sql.NullInt64
+	ID      int64
+}
+
+func (q *Queries) UpdateInventoryItemCharges(ctx context.Context, arg UpdateInventoryItemChargesParams) error {
+	_, err := q.exec(ctx, q.updateInventoryItemChargesStmt, updateInventoryItemCharges, arg.Charges, arg.ID)
+	return err
+}
+
+const updateInventoryItemUses = `-- name: UpdateInventoryItemUses :exec
+UPDATE inventory_items
+SET uses_remaining = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ?
+`
+
+type UpdateInventoryItemUsesParams struct {
+	UsesRemaining sql.NullInt64
+	ID            int64
+}
+
+func (q *Queries) UpdateInventoryItemUses(ctx context.Context, arg UpdateInventoryItemUsesParams) error {
+	_, err := q.exec(ctx, q.updateInventoryItemUsesStmt, updateInventoryItemUses, arg.UsesRemaining, arg.ID)
+	return err
+}
+
 const updateInventoryWeight = `-- name: UpdateInventoryWeight :exec
 UPDATE inventories
 SET current_weight = ?
@@ -15,6 +15,33 @@ type Ability struct {
 	Description string
 }
 
+type AbilityRoll struct {
+	ID          int64
+	UserID      int64
+	Method      string
+	Scores      string
+	Rolls       string
+	Seed        int64
+	Nonce       string
+	Signature   string
+	CharacterID sql.NullInt64
+	UsedAt      sql.NullTime
+	CreatedAt   time.Time
+}
+
+type ActiveEffect struct {
+	ID              int64
+	CharacterID     int64
+	SourceType      string
+	SourceID        int64
+	Name            string
+	Attribute       sql.NullString
+	Score           sql.NullInt64
+	DurationRounds  int64
+	RemainingRounds int64
+	CreatedAt       time.Time
+}
+
 type Ammo struct {
 	ID        int64
 	Name      string
@@ -45,6 +72,17 @@ type AssassinAbility struct {
 	MinLevel    int64
 }
 
+type AttributeAdjustment struct {
+	ID          int64
+	CharacterID int64
+	Attribute   string
+	Kind        string
+	Amount      int64
+	Score       sql.NullInt64
+	Source      string
+	CreatedAt   time.Time
+}
+
 type BarbarianAbility struct {
 	ID          int64
 	Name        string
@@ -82,13 +120,6 @@ type BerserkerAbility struct {
 	MinLevel    int64
 }
 
-type BerserkerNaturalAc struct {
-	ID        int64
-	ClassName string
-	Level     int64
-	NaturalAc int64
-}
-
 type CataphractAbility struct {
 	ID          int64
 	Name        string
@@ -114,6 +145,35 @@ type Character struct {
 	TemporaryHitPoints int64
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
+	Kindred            string
+	Stabilized         bool
+	RetiredAt          sql.NullTime
+}
+
+type CharacterCondition struct {
+	ID              int64
+	CharacterID     int64
+	ConditionID     int64
+	Source          string
+	DurationRounds  sql.NullInt64
+	RemainingRounds sql.NullInt64
+	CreatedAt       time.Time
+}
+
+type CharacterGrant struct {
+	ID          int64
+	CharacterID int64
+	UserID      int64
+	GrantType   string
+	CreatedAt   time.Time
+}
+
+type ClassAbility struct {
+	ClassName   string
+	ID          int64
+	Name        string
+	Description string
+	MinLevel    int64
 }
 
 type ClassAbilityMapping struct {
@@ -139,6 +199,62 @@ type ClassDatum struct {
 	SpellSlotsLevel6 sql.NullInt64
 }
 
+type ClassExtraSpellSlot struct {
+	ClassName string
+	Level     int64
+	SlotKey   string
+	Slots     int64
+}
+
+type ClassMartialArt struct {
+	ClassName       string
+	Level           int64
+	AcBonus         int64
+	EmptyHandDamage string
+}
+
+type ClassNaturalAc struct {
+	ID        int64
+	ClassName string
+	Level     int64
+	NaturalAc int64
+}
+
+type ClassRule struct {
+	ClassName               string
+	DeathSaveBonus          int64
+	TransformationSaveBonus int64
+	DeviceSaveBonus         int64
+	SorcerySaveBonus        int64
+	AvoidanceSaveBonus      int64
+	SurpriseChance          sql.NullInt64
+	MaxArmor                string
+	ShieldsAllowed          bool
+	Hooks                   string
+	PrimeAttributes         string
+	CasterType              string
+	CastingClass            string
+	CastingAttribute        string
+}
+
+type ClassRunesPerDay struct {
+	ID        int64
+	ClassName string
+	Level     int64
+	Level1    sql.NullInt64
+	Level2    sql.NullInt64
+	Level3    sql.NullInt64
+	Level4    sql.NullInt64
+	Level5    sql.NullInt64
+	Level6    sql.NullInt64
+}
+
+type ClassTurningAbility struct {
+	ClassName      string
+	Level          int64
+	TurningAbility int64
+}
+
 type ClericAbility struct {
 	ID          int64
 	Name        string
@@ -153,6 +269,19 @@ type ClericTurningAbility struct {
 	TurningAbility int64
 }
 
+type Condition struct {
+	ID               int64
+	Name             string
+	Description      string
+	ToHitModifier    int64
+	AcBonus          int64
+	SaveModifier     int64
+	MovementModifier int64
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	CheckModifier    int64
+}
+
 type Container struct {
 	ID           int64
 	Name         string
@@ -164,6 +293,25 @@ type Container struct {
 	UpdatedAt    time.Time
 }
 
+type ContentPack struct {
+	ID          int64
+	Namespace   string
+	Name        string
+	Version     string
+	Author      string
+	Description string
+	InstalledBy sql.NullInt64
+	CreatedAt   time.Time
+}
+
+type ContentPackEntry struct {
+	ID        int64
+	PackID    int64
+	EntryType string
+	EntryID   int64
+	EntryName string
+}
+
 type CryomancerAbility struct {
 	ID          int64
 	Name        string
@@ -178,6 +326,32 @@ type DruidAbility struct {
 	MinLevel    int64
 }
 
+type Encounter struct {
+	ID             int64
+	UserID         int64
+	Name           string
+	InitiativeMode string
+	Round          int64
+	Turn           int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type EncounterParticipant struct {
+	ID          int64
+	EncounterID int64
+	Side        string
+	CharacterID sql.NullInt64
+	MonsterID   sql.NullInt64
+	Name        string
+	ArmorClass  sql.NullInt64
+	MaxHp       sql.NullInt64
+	CurrentHp   sql.NullInt64
+	Initiative  sql.NullInt64
+	Surprised   bool
+	CreatedAt   time.Time
+}
+
 type Equipment struct {
 	ID          int64
 	Name        string
@@ -186,6 +360,7 @@ type Equipment struct {
 	Weight      int64
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	SaveBonus   int64
 }
 
 type FighterAbility struct {
@@ -225,16 +400,53 @@ type Inventory struct {
 }
 
 type InventoryItem struct {
-	ID          int64
-	InventoryID int64
-	ItemType    string
-	ItemID      int64
-	Quantity    int64
-	IsEquipped  bool
-	Slot        sql.NullString
-	Notes       sql.NullString
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID            int64
+	InventoryID   int64
+	ItemType      string
+	ItemID        int64
+	Quantity      int64
+	IsEquipped    bool
+	Slot          sql.NullString
+	Notes         sql.NullString
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Charges       sql.NullInt64
+	UsesRemaining sql.NullInt64
+}
+
+type ItemUse struct {
+	ID              int64
+	CharacterID     int64
+	InventoryItemID int64
+	ItemType        string
+	ItemID          int64
+	ItemName        string
+	Method          string
+	Die             int64
+	Roll            int64
+	Chance          int64
+	Success         bool
+	Mishap          bool
+	Effect          string
+	Target          string
+	UsedAt          time.Time
+}
+
+type Kindred struct {
+	ID                   int64
+	Name                 string
+	Description          string
+	StrengthModifier     int64
+	DexterityModifier    int64
+	ConstitutionModifier int64
+	IntelligenceModifier int64
+	WisdomModifier       int64
+	CharismaModifier     int64
+	Languages            string
+	AllowedClasses       string
+	SpecialAbilities     string
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
 }
 
 type KnownSpell struct {
@@ -257,6 +469,21 @@ type LegerdemainistAbility struct {
 	MinLevel    int64
 }
 
+type LevelUp struct {
+	ID          int64
+	CharacterID int64
+	FromLevel   int64
+	ToLevel     int64
+	HitDice     string
+	HpRoll      sql.NullString
+	Seed        int64
+	HpModifier  int64
+	HpGained    int64
+	FixedHp     bool
+	Details     string
+	CreatedAt   time.Time
+}
+
 type MagicItem struct {
 	ID          int64
 	Name        string
@@ -283,14 +510,24 @@ type MonkAbility struct {
 	MinLevel    int64
 }
 
-type MonkAcBonu struct {
-	Level   int64
-	AcBonus int64
-}
-
-type MonkEmptyHandDamage struct {
-	Level  int64
-	Damage string
+type Monster struct {
+	ID               int64
+	Name             string
+	Description      string
+	HitDice          string
+	HitDiceLevel     int64
+	ArmorClass       int64
+	Movement         string
+	Attacks          string
+	Damage           string
+	SavingThrow      int64
+	Morale           int64
+	XpValue          int64
+	TreasureClass    string
+	SpecialAbilities string
+	Terrain          string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
 }
 
 type NecromancerAbility struct {
@@ -321,13 +558,16 @@ type PaladinTurningAbility struct {
 }
 
 type Potion struct {
-	ID          int64
-	Name        string
-	Description string
-	Uses        int64
-	Weight      int64
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID              int64
+	Name            string
+	Description     string
+	Uses            int64
+	Weight          int64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	EffectAttribute sql.NullString
+	EffectScore     sql.NullInt64
+	EffectDuration  sql.NullString
 }
 
 type PreparedSpell struct {
@@ -340,6 +580,7 @@ type PreparedSpell struct {
 	SlotIndex   int64
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	ExpendedAt  sql.NullTime
 }
 
 type PriestAbility struct {
@@ -400,6 +641,7 @@ type Ring struct {
 	Weight      int64
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	SaveBonus   int64
 }
 
 type RunegraverAbility struct {
@@ -409,18 +651,6 @@ type RunegraverAbility struct {
 	MinLevel    int64
 }
 
-type RunesPerDay struct {
-	ID        int64
-	ClassName string
-	Level     int64
-	Level1    sql.NullInt64
-	Level2    sql.NullInt64
-	Level3    sql.NullInt64
-	Level4    sql.NullInt64
-	Level5    sql.NullInt64
-	Level6    sql.NullInt64
-}
-
 type ScoutAbility struct {
 	ID          int64
 	Name        string
@@ -496,6 +726,36 @@ type Spell struct {
 	UpdatedAt    time.Time
 }
 
+type SpellCast struct {
+	ID              int64
+	CharacterID     int64
+	PreparedSpellID sql.NullInt64
+	SpellID         int64
+	SpellName       string
+	SpellLevel      int64
+	SpellClass      string
+	Target          string
+	Round           sql.NullInt64
+	Notes           string
+	CastAt          time.Time
+}
+
+type SpellLearningAttempt struct {
+	ID             int64
+	CharacterID    int64
+	SpellID        int64
+	SpellName      string
+	SpellLevel     int64
+	SpellClass     string
+	SourceType     string
+	SourceID       int64
+	CharacterLevel int64
+	Roll           int64
+	Chance         int64
+	Success        bool
+	CreatedAt      time.Time
+}
+
 type SpellScroll struct {
 	ID           int64
 	SpellID      int64
@@ -508,15 +768,27 @@ type SpellScroll struct {
 }
 
 type Spellbook struct {
+	ID           int64
+	Name         string
+	Description  sql.NullString
+	TotalPages   int64
+	UsedPages    int64
+	Value        int64
+	Weight       float64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	IsTravelling bool
+}
+
+type SpellbookSpell struct {
 	ID          int64
-	Name        string
-	Description sql.NullString
-	TotalPages  int64
-	UsedPages   int64
-	Value       int64
-	Weight      float64
+	SpellbookID int64
+	SpellID     int64
+	SpellName   string
+	SpellLevel  int64
+	SpellClass  string
+	Pages       int64
 	CreatedAt   time.Time
-	UpdatedAt   time.Time
 }
 
 type ThiefAbility struct {
@@ -534,6 +806,19 @@ type ThiefSkill struct {
 	SuccessChance string
 }
 
+type ThiefSkillCheck struct {
+	ID          int64
+	CharacterID int64
+	SkillName   string
+	BaseChance  int64
+	Modifiers   string
+	Chance      int64
+	Roll        int64
+	Success     bool
+	Notes       string
+	CreatedAt   time.Time
+}
+
 type Treasure struct {
 	ID             int64
 	CharacterID    sql.NullInt64
@@ -550,6 +835,21 @@ type Treasure struct {
 	UpdatedAt      time.Time
 }
 
+type TurnUndeadAttempt struct {
+	ID             int64
+	CharacterID    int64
+	UndeadType     int64
+	TurningAbility int64
+	Command        bool
+	Entry          string
+	Roll           int64
+	Chance         int64
+	Result         string
+	Affected       int64
+	Recovered      bool
+	CreatedAt      time.Time
+}
+
 type User struct {
 	ID           int64
 	Username     string
@@ -557,6 +857,7 @@ type User struct {
 	PasswordHash string
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+	Role         string
 }
 
 type WarlockAbility struct {
@@ -599,3 +900,18 @@ type WitchAbility struct {
 	Description string
 	MinLevel    int64
 }
+
+type XpAward struct {
+	ID              int64
+	CharacterID     int64
+	Amount          int64
+	BonusPercent    int64
+	BonusAmount     int64
+	Total           int64
+	Source          string
+	Session         string
+	Notes           string
+	AwardedBy       sql.NullInt64
+	ReversesAwardID sql.NullInt64
+	CreatedAt       time.Time
+}
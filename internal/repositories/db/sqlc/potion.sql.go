@@ -12,17 +12,20 @@ import (
 
 const createPotion = `-- name: CreatePotion :execresult
 INSERT INTO potions (
-  name, description, uses, weight
+  name, description, uses, weight, effect_attribute, effect_score, effect_duration
 ) VALUES (
-  ?, ?, ?, ?
+  ?, ?, ?, ?, ?, ?, ?
 )
 `
 
 type CreatePotionParams struct {
-	Name        string
-	Description string
-	Uses        int64
-	Weight      int64
+	Name            string
+	Description     string
+	Uses            int64
+	Weight          int64
+	EffectAttribute sql.NullString
+	EffectScore     sql.NullInt64
+	EffectDuration  sql.NullString
 }
 
 func (q *Queries) CreatePotion(ctx context.Context, arg CreatePotionParams) (sql.Result, error) {
@@ -31,6 +34,9 @@ func (q *Queries) CreatePotion(ctx context.Context, arg CreatePotionParams) (sql
 		arg.Description,
 		arg.Uses,
 		arg.Weight,
+		arg.EffectAttribute,
+		arg.EffectScore,
+		arg.EffectDuration,
 	)
 }
 
@@ -44,7 +50,7 @@ func (q *Queries) DeletePotion(ctx context.Context, id int64) (sql.Result, error
 }
 
 const getPotion = `-- name: GetPotion :one
-SELECT id, name, description, uses, weight, created_at, updated_at FROM potions
+SELECT id, name, description, uses, weight, created_at, updated_at, effect_attribute, effect_score, effect_duration FROM potions
 WHERE id = ? LIMIT 1
 `
 
@@ -59,12 +65,15 @@ func (q *Queries) GetPotion(ctx context.Context, id int64) (Potion, error) {
 		&i.Weight,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.EffectAttribute,
+		&i.EffectScore,
+		&i.EffectDuration,
 	)
 	return i, err
 }
 
 const getPotionByName = `-- name: GetPotionByName :one
-SELECT id, name, description, uses, weight, created_at, updated_at FROM potions
+SELECT id, name, description, uses, weight, created_at, updated_at, effect_attribute, effect_score, effect_duration FROM potions
 WHERE name = ? LIMIT 1
 `
 
@@ -79,12 +88,15 @@ func (q *Queries) GetPotionByName(ctx context.Context, name string) (Potion, err
 		&i.Weight,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.EffectAttribute,
+		&i.EffectScore,
+		&i.EffectDuration,
 	)
 	return i, err
 }
 
 const listPotions = `-- name: ListPotions :many
-SELECT id, name, description, uses, weight, created_at, updated_at FROM potions
+SELECT id, name, description, uses, weight, created_at, updated_at, effect_attribute, effect_score, effect_duration FROM potions
 ORDER BY name
 `
 
@@ -105,6 +117,9 @@ func (q *Queries) ListPotions(ctx context.Context) ([]Potion, error) {
 			&i.Weight,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.EffectAttribute,
+			&i.EffectScore,
+			&i.EffectDuration,
 		); err != nil {
 			return nil, err
 		}
@@ -125,16 +140,22 @@ SET name = ?,
     description = ?,
     uses = ?,
     weight = ?,
+    effect_attribute = ?,
+    effect_score = ?,
+    effect_duration = ?,
     updated_at = datetime('now')
 WHERE id = ?
 `
 
 type UpdatePotionParams struct {
-	Name        string
-	Description string
-	Uses        int64
-	Weight      int64
-	ID          int64
+	Name            string
+	Description     string
+	Uses            int64
+	Weight          int64
+	EffectAttribute sql.NullString
+	EffectScore     sql.NullInt64
+	EffectDuration  sql.NullString
+	ID              int64
 }
 
 func (q *Queries) UpdatePotion(ctx context.Context, arg UpdatePotionParams) (sql.Result, error) {
@@ -143,6 +164,9 @@ func (q *Queries) UpdatePotion(ctx context.Context, arg UpdatePotionParams) (sql
 		arg.Description,
 		arg.Uses,
 		arg.Weight,
+		arg.EffectAttribute,
+		arg.EffectScore,
+		arg.EffectDuration,
 		arg.ID,
 	)
 }
@@ -10,34 +10,94 @@ import (
 )
 
 type Querier interface {
+	AddCharacterCondition(ctx context.Context, arg AddCharacterConditionParams) (sql.Result, error)
+	AddEncounterParticipant(ctx context.Context, arg AddEncounterParticipantParams) (sql.Result, error)
 	AddInventoryItem(ctx context.Context, arg AddInventoryItemParams) (sql.Result, error)
 	AddKnownSpell(ctx context.Context, arg AddKnownSpellParams) (sql.Result, error)
+	AddSpellbookSpell(ctx context.Context, arg AddSpellbookSpellParams) (sql.Result, error)
 	AddWeaponMastery(ctx context.Context, arg AddWeaponMasteryParams) error
+	AdvanceActiveEffects(ctx context.Context, arg AdvanceActiveEffectsParams) error
+	AdvanceCharacterConditions(ctx context.Context, arg AdvanceCharacterConditionsParams) error
+	ClaimAbilityRoll(ctx context.Context, id int64) (sql.Result, error)
 	ClearPreparedSpells(ctx context.Context, characterID int64) error
+	CountCarriedSpellbooksWithSpell(ctx context.Context, arg CountCarriedSpellbooksWithSpellParams) (int64, error)
+	CountCharactersByClass(ctx context.Context, class string) (int64, error)
+	CountInventoryItemsByItem(ctx context.Context, arg CountInventoryItemsByItemParams) (int64, error)
+	CountPreparedSpellInstances(ctx context.Context, arg CountPreparedSpellInstancesParams) (int64, error)
 	CountPreparedSpellsByLevelAndClass(ctx context.Context, arg CountPreparedSpellsByLevelAndClassParams) (int64, error)
+	CountSpellReferences(ctx context.Context, spellID int64) (int64, error)
+	CountUnrecoveredTurnUndeadAttempts(ctx context.Context, characterID int64) (int64, error)
+	CountUnusedDiceAbilityRolls(ctx context.Context, userID int64) (int64, error)
+	CountUsersByRole(ctx context.Context, role string) (int64, error)
 	CountWeaponMasteries(ctx context.Context, arg CountWeaponMasteriesParams) (int64, error)
+	CreateAbility(ctx context.Context, arg CreateAbilityParams) (sql.Result, error)
+	CreateAbilityRoll(ctx context.Context, arg CreateAbilityRollParams) (sql.Result, error)
+	CreateActiveEffect(ctx context.Context, arg CreateActiveEffectParams) (sql.Result, error)
 	CreateAmmo(ctx context.Context, arg CreateAmmoParams) (sql.Result, error)
 	CreateArmor(ctx context.Context, arg CreateArmorParams) (sql.Result, error)
+	CreateAttributeAdjustment(ctx context.Context, arg CreateAttributeAdjustmentParams) (sql.Result, error)
 	CreateCharacter(ctx context.Context, arg CreateCharacterParams) (sql.Result, error)
+	CreateClassAbilityMapping(ctx context.Context, arg CreateClassAbilityMappingParams) error
+	CreateClassBackstabMultiplier(ctx context.Context, arg CreateClassBackstabMultiplierParams) error
+	CreateClassLevel(ctx context.Context, arg CreateClassLevelParams) error
+	CreateClassMartialArts(ctx context.Context, arg CreateClassMartialArtsParams) error
+	CreateClassNaturalAC(ctx context.Context, arg CreateClassNaturalACParams) error
+	CreateClassRules(ctx context.Context, arg CreateClassRulesParams) error
+	CreateClassRunesPerDay(ctx context.Context, arg CreateClassRunesPerDayParams) error
+	CreateCondition(ctx context.Context, arg CreateConditionParams) (sql.Result, error)
 	CreateContainer(ctx context.Context, arg CreateContainerParams) (sql.Result, error)
+	CreateContentPack(ctx context.Context, arg CreateContentPackParams) (sql.Result, error)
+	CreateContentPackEntry(ctx context.Context, arg CreateContentPackEntryParams) error
+	CreateEncounter(ctx context.Context, arg CreateEncounterParams) (sql.Result, error)
 	CreateEquipment(ctx context.Context, arg CreateEquipmentParams) (sql.Result, error)
 	CreateInventory(ctx context.Context, arg CreateInventoryParams) (sql.Result, error)
+	CreateItemUse(ctx context.Context, arg CreateItemUseParams) (sql.Result, error)
+	CreateKindred(ctx context.Context, arg CreateKindredParams) (sql.Result, error)
+	CreateLevelUp(ctx context.Context, arg CreateLevelUpParams) (sql.Result, error)
 	CreateMagicItem(ctx context.Context, arg CreateMagicItemParams) (sql.Result, error)
+	CreateMonster(ctx context.Context, arg CreateMonsterParams) (sql.Result, error)
 	CreatePotion(ctx context.Context, arg CreatePotionParams) (sql.Result, error)
 	CreateRing(ctx context.Context, arg CreateRingParams) (sql.Result, error)
 	CreateShield(ctx context.Context, arg CreateShieldParams) (sql.Result, error)
 	CreateSpell(ctx context.Context, arg CreateSpellParams) (sql.Result, error)
+	CreateSpellCast(ctx context.Context, arg CreateSpellCastParams) (sql.Result, error)
+	CreateSpellLearningAttempt(ctx context.Context, arg CreateSpellLearningAttemptParams) (sql.Result, error)
 	CreateSpellScroll(ctx context.Context, arg CreateSpellScrollParams) (sql.Result, error)
+	CreateSpellbook(ctx context.Context, arg CreateSpellbookParams) (sql.Result, error)
+	CreateThiefSkillCheck(ctx context.Context, arg CreateThiefSkillCheckParams) (sql.Result, error)
 	CreateTreasure(ctx context.Context, arg CreateTreasureParams) (sql.Result, error)
+	CreateTurnUndeadAttempt(ctx context.Context, arg CreateTurnUndeadAttemptParams) (sql.Result, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (sql.Result, error)
 	CreateWeapon(ctx context.Context, arg CreateWeaponParams) (sql.Result, error)
+	CreateXPAward(ctx context.Context, arg CreateXPAwardParams) (sql.Result, error)
+	DeleteAbility(ctx context.Context, id int64) error
+	DeleteActiveEffect(ctx context.Context, arg DeleteActiveEffectParams) error
 	DeleteAmmo(ctx context.Context, id int64) (sql.Result, error)
 	DeleteArmor(ctx context.Context, id int64) (sql.Result, error)
+	DeleteAttributeAdjustment(ctx context.Context, arg DeleteAttributeAdjustmentParams) error
 	DeleteCharacter(ctx context.Context, id int64) (sql.Result, error)
+	DeleteCharacterCondition(ctx context.Context, arg DeleteCharacterConditionParams) error
+	DeleteCharacterGrant(ctx context.Context, arg DeleteCharacterGrantParams) error
+	DeleteClass(ctx context.Context, className string) error
+	DeleteClassAbilityMappings(ctx context.Context, className string) error
+	DeleteClassBackstabMultipliers(ctx context.Context, className string) error
+	DeleteClassMartialArts(ctx context.Context, className string) error
+	DeleteClassNaturalAC(ctx context.Context, className string) error
+	DeleteClassRules(ctx context.Context, className string) error
+	DeleteClassRunesPerDay(ctx context.Context, className string) error
+	DeleteCondition(ctx context.Context, id int64) (sql.Result, error)
 	DeleteContainer(ctx context.Context, id int64) (sql.Result, error)
+	DeleteContentPack(ctx context.Context, id int64) (sql.Result, error)
+	DeleteContentPackEntries(ctx context.Context, packID int64) error
+	DeleteEncounter(ctx context.Context, id int64) error
+	DeleteEncounterParticipant(ctx context.Context, arg DeleteEncounterParticipantParams) error
 	DeleteEquipment(ctx context.Context, id int64) (sql.Result, error)
+	DeleteExpiredActiveEffects(ctx context.Context, characterID int64) error
+	DeleteExpiredCharacterConditions(ctx context.Context, characterID int64) error
 	DeleteInventory(ctx context.Context, id int64) error
+	DeleteKindred(ctx context.Context, id int64) (sql.Result, error)
 	DeleteMagicItem(ctx context.Context, id int64) (sql.Result, error)
+	DeleteMonster(ctx context.Context, id int64) (sql.Result, error)
 	DeletePotion(ctx context.Context, id int64) (sql.Result, error)
 	DeleteRing(ctx context.Context, id int64) (sql.Result, error)
 	DeleteShield(ctx context.Context, id int64) (sql.Result, error)
@@ -47,132 +107,140 @@ type Querier interface {
 	DeleteUser(ctx context.Context, id int64) (sql.Result, error)
 	DeleteWeapon(ctx context.Context, id int64) (sql.Result, error)
 	DeleteWeaponMastery(ctx context.Context, arg DeleteWeaponMasteryParams) error
+	ExpendPreparedSpell(ctx context.Context, id int64) error
+	GetAbilityRoll(ctx context.Context, id int64) (AbilityRoll, error)
+	GetAbilityRollByCharacter(ctx context.Context, characterID sql.NullInt64) (AbilityRoll, error)
+	GetAbilityRollsByUser(ctx context.Context, userID int64) ([]AbilityRoll, error)
+	GetActiveEffect(ctx context.Context, arg GetActiveEffectParams) (ActiveEffect, error)
+	GetActiveEffectsByCharacter(ctx context.Context, characterID int64) ([]ActiveEffect, error)
 	GetAllClassData(ctx context.Context, className string) ([]ClassDatum, error)
 	GetAmmo(ctx context.Context, id int64) (Ammo, error)
 	GetAmmoByName(ctx context.Context, name string) (Ammo, error)
 	GetArmor(ctx context.Context, id int64) (Armor, error)
 	GetArmorByName(ctx context.Context, name string) (Armor, error)
-	// Gets all barbarian abilities available to a character based on their level
-	GetBarbarianAbilities(ctx context.Context, characterLevel int64) ([]BarbarianAbility, error)
-	// Gets all bard abilities available to a character based on their level
-	GetBardAbilities(ctx context.Context, characterLevel int64) ([]BardAbility, error)
+	GetAttributeAdjustment(ctx context.Context, arg GetAttributeAdjustmentParams) (AttributeAdjustment, error)
+	GetAttributeAdjustmentsByCharacter(ctx context.Context, characterID int64) ([]AttributeAdjustment, error)
 	GetBardDruidSpells(ctx context.Context, level int64) (BardDruidSpell, error)
 	GetBardIllusionistSpells(ctx context.Context, level int64) (BardIllusionistSpell, error)
-	// Gets all berserker abilities available to a character based on their level
-	GetBerserkerAbilities(ctx context.Context, characterLevel int64) ([]BerserkerAbility, error)
-	GetBerserkerNaturalAC(ctx context.Context, arg GetBerserkerNaturalACParams) (int64, error)
-	// Gets all cataphract abilities available to a character based on their level
-	GetCataphractAbilities(ctx context.Context, characterLevel int64) ([]CataphractAbility, error)
 	GetCharacter(ctx context.Context, id int64) (GetCharacterRow, error)
+	GetCharacterCondition(ctx context.Context, arg GetCharacterConditionParams) (GetCharacterConditionRow, error)
+	GetCharacterConditions(ctx context.Context, characterID int64) ([]GetCharacterConditionsRow, error)
 	GetCharacterForSpellcasting(ctx context.Context, id int64) (Character, error)
+	GetCharacterGrant(ctx context.Context, arg GetCharacterGrantParams) (CharacterGrant, error)
+	GetCharacterGrants(ctx context.Context, characterID int64) ([]CharacterGrant, error)
+	GetCharacterGrantsByUser(ctx context.Context, userID int64) ([]CharacterGrant, error)
+	GetCharacterInventoryItem(ctx context.Context, arg GetCharacterInventoryItemParams) (InventoryItem, error)
+	GetCharacterSpellScrollItem(ctx context.Context, arg GetCharacterSpellScrollItemParams) (InventoryItem, error)
 	GetCharactersByUser(ctx context.Context, userID int64) ([]GetCharactersByUserRow, error)
 	GetClassAbilities(ctx context.Context, className string) ([]GetClassAbilitiesRow, error)
 	GetClassAbilitiesByLevel(ctx context.Context, arg GetClassAbilitiesByLevelParams) ([]GetClassAbilitiesByLevelRow, error)
+	GetClassBackstabMultiplier(ctx context.Context, arg GetClassBackstabMultiplierParams) (int64, error)
 	GetClassData(ctx context.Context, arg GetClassDataParams) (ClassDatum, error)
 	GetClassDataForSpellcasting(ctx context.Context, arg GetClassDataForSpellcastingParams) (ClassDatum, error)
-	// Gets all cleric abilities available to a character based on their level
-	GetClericAbilities(ctx context.Context, characterLevel int64) ([]ClericAbility, error)
-	GetClericTurningAbility(ctx context.Context, level int64) (int64, error)
+	GetClassExtraSpellSlots(ctx context.Context, arg GetClassExtraSpellSlotsParams) ([]GetClassExtraSpellSlotsRow, error)
+	GetClassMartialArts(ctx context.Context, arg GetClassMartialArtsParams) (GetClassMartialArtsRow, error)
+	GetClassNaturalAC(ctx context.Context, arg GetClassNaturalACParams) (int64, error)
+	GetClassRules(ctx context.Context, className string) (ClassRule, error)
+	GetClassRunesPerDay(ctx context.Context, arg GetClassRunesPerDayParams) (GetClassRunesPerDayRow, error)
+	GetClassTurningAbility(ctx context.Context, arg GetClassTurningAbilityParams) (int64, error)
+	GetCondition(ctx context.Context, id int64) (Condition, error)
+	GetConditionByName(ctx context.Context, name string) (Condition, error)
 	GetContainer(ctx context.Context, id int64) (Container, error)
 	GetContainerByName(ctx context.Context, name string) (Container, error)
-	// Gets all cryomancer abilities available to a character based on their level
-	GetCryomancerAbilities(ctx context.Context, characterLevel int64) ([]CryomancerAbility, error)
-	// Gets all druid abilities available to a character based on their level
-	GetDruidAbilities(ctx context.Context, characterLevel int64) ([]DruidAbility, error)
+	GetContentPack(ctx context.Context, id int64) (ContentPack, error)
+	GetContentPackByNamespace(ctx context.Context, namespace string) (ContentPack, error)
+	GetEncounter(ctx context.Context, id int64) (Encounter, error)
+	GetEncounterParticipants(ctx context.Context, encounterID int64) ([]GetEncounterParticipantsRow, error)
+	GetEncountersByUser(ctx context.Context, userID int64) ([]Encounter, error)
 	GetEquipment(ctx context.Context, id int64) (Equipment, error)
 	GetEquipmentByName(ctx context.Context, name string) (Equipment, error)
 	GetEquippedItems(ctx context.Context, inventoryID int64) ([]InventoryItem, error)
-	// Gets all fighter abilities available to a character based on their level
-	GetFighterAbilities(ctx context.Context, characterLevel int64) ([]FighterAbility, error)
+	GetFailedSpellLearningAttempt(ctx context.Context, arg GetFailedSpellLearningAttemptParams) (SpellLearningAttempt, error)
 	GetFullUserByEmail(ctx context.Context, email string) (User, error)
-	// Gets all huntsman abilities available to a character based on their level
-	GetHuntsmanAbilities(ctx context.Context, characterLevel int64) ([]HuntsmanAbility, error)
-	// Gets all illusionist abilities available to a character based on their level
-	GetIllusionistAbilities(ctx context.Context, characterLevel int64) ([]IllusionistAbility, error)
 	GetInventory(ctx context.Context, id int64) (Inventory, error)
 	GetInventoryByCharacter(ctx context.Context, characterID int64) (Inventory, error)
 	GetInventoryItem(ctx context.Context, id int64) (InventoryItem, error)
 	GetInventoryItemByTypeAndItemID(ctx context.Context, arg GetInventoryItemByTypeAndItemIDParams) (InventoryItem, error)
 	GetInventoryItems(ctx context.Context, inventoryID int64) ([]InventoryItem, error)
 	GetInventoryItemsByType(ctx context.Context, arg GetInventoryItemsByTypeParams) ([]InventoryItem, error)
+	GetItemUse(ctx context.Context, id int64) (ItemUse, error)
+	GetItemUsesByCharacter(ctx context.Context, characterID int64) ([]ItemUse, error)
 	GetItemsBySlot(ctx context.Context, arg GetItemsBySlotParams) ([]InventoryItem, error)
+	GetKindred(ctx context.Context, id int64) (Kindred, error)
+	GetKindredByName(ctx context.Context, name string) (Kindred, error)
 	GetKnownSpellByCharacterAndSpell(ctx context.Context, arg GetKnownSpellByCharacterAndSpellParams) (KnownSpell, error)
 	GetKnownSpells(ctx context.Context, characterID int64) ([]KnownSpell, error)
 	GetKnownSpellsByClass(ctx context.Context, arg GetKnownSpellsByClassParams) ([]KnownSpell, error)
-	// Gets all legerdemainist abilities available to a character based on their level
-	GetLegerdemainistAbilities(ctx context.Context, characterLevel int64) ([]LegerdemainistAbility, error)
+	GetLevelUp(ctx context.Context, id int64) (LevelUp, error)
+	GetLevelUpsByCharacter(ctx context.Context, characterID int64) ([]LevelUp, error)
 	GetMagicItem(ctx context.Context, id int64) (MagicItem, error)
 	GetMagicItemByName(ctx context.Context, name string) (MagicItem, error)
-	// Gets all magician abilities available to a character based on their level
-	GetMagicianAbilities(ctx context.Context, characterLevel int64) ([]MagicianAbility, error)
-	GetMonkACBonus(ctx context.Context, level int64) (int64, error)
-	// Gets all monk abilities available to a character based on their level
-	GetMonkAbilities(ctx context.Context, characterLevel int64) ([]MonkAbility, error)
-	GetMonkEmptyHandDamage(ctx context.Context, level int64) (string, error)
-	// Gets all necromancer abilities available to a character based on their level
-	GetNecromancerAbilities(ctx context.Context, characterLevel int64) ([]NecromancerAbility, error)
-	GetNecromancerTurningAbility(ctx context.Context, level int64) (int64, error)
+	GetMonster(ctx context.Context, id int64) (Monster, error)
+	GetMonsterByName(ctx context.Context, name string) (Monster, error)
+	// Returns the lowest free slot so slots freed by unpreparing are reused
 	GetNextAvailableSlotIndex(ctx context.Context, arg GetNextAvailableSlotIndexParams) (int64, error)
 	GetNextLevelData(ctx context.Context, arg GetNextLevelDataParams) (ClassDatum, error)
-	// Gets all paladin abilities available to a character based on their level
-	GetPaladinAbilities(ctx context.Context, characterLevel int64) ([]PaladinAbility, error)
-	GetPaladinTurningAbility(ctx context.Context, level int64) (int64, error)
+	GetNextUnexpendedPreparedSpell(ctx context.Context, arg GetNextUnexpendedPreparedSpellParams) (PreparedSpell, error)
 	GetPotion(ctx context.Context, id int64) (Potion, error)
 	GetPotionByName(ctx context.Context, name string) (Potion, error)
+	GetPreparedSpell(ctx context.Context, arg GetPreparedSpellParams) (PreparedSpell, error)
 	GetPreparedSpellByCharacterAndSpell(ctx context.Context, arg GetPreparedSpellByCharacterAndSpellParams) (PreparedSpell, error)
+	GetPreparedSpellBySlot(ctx context.Context, arg GetPreparedSpellBySlotParams) (PreparedSpell, error)
 	GetPreparedSpells(ctx context.Context, characterID int64) ([]PreparedSpell, error)
 	GetPreparedSpellsByClass(ctx context.Context, arg GetPreparedSpellsByClassParams) ([]PreparedSpell, error)
-	// Gets all priest abilities available to a character based on their level
-	GetPriestAbilities(ctx context.Context, characterLevel int64) ([]PriestAbility, error)
-	// Gets all purloiner abilities available to a character based on their level
-	GetPurloinerAbilities(ctx context.Context, characterLevel int64) ([]PurloinerAbility, error)
-	// Gets all pyromancer abilities available to a character based on their level
-	GetPyromancerAbilities(ctx context.Context, characterLevel int64) ([]PyromancerAbility, error)
-	// Gets all ranger abilities available to a character based on their level
-	GetRangerAbilities(ctx context.Context, characterLevel int64) ([]RangerAbility, error)
 	GetRangerDruidSpellSlots(ctx context.Context, classLevel int64) ([]GetRangerDruidSpellSlotsRow, error)
 	GetRangerMagicianSpellSlots(ctx context.Context, classLevel int64) ([]GetRangerMagicianSpellSlotsRow, error)
 	GetRing(ctx context.Context, id int64) (Ring, error)
 	GetRingByName(ctx context.Context, name string) (Ring, error)
-	// Gets all runegraver abilities available to a character based on their level
-	GetRunegraverAbilities(ctx context.Context, characterLevel int64) ([]RunegraverAbility, error)
-	GetRunesPerDay(ctx context.Context, level int64) (GetRunesPerDayRow, error)
-	// Gets all scout abilities available to a character based on their level
-	GetScoutAbilities(ctx context.Context, characterLevel int64) ([]ScoutAbility, error)
-	// Gets all shaman abilities available to a character based on their level
-	GetShamanAbilities(ctx context.Context, characterLevel int64) ([]ShamanAbility, error)
 	GetShamanArcaneSpells(ctx context.Context, level int64) (ShamanArcaneSpell, error)
 	GetShamanDivineSpells(ctx context.Context, level int64) (ShamanDivineSpell, error)
 	GetShield(ctx context.Context, id int64) (Shield, error)
 	GetShieldByName(ctx context.Context, name string) (Shield, error)
 	GetSpell(ctx context.Context, id int64) (Spell, error)
+	GetSpellCast(ctx context.Context, id int64) (SpellCast, error)
+	GetSpellCastsByCharacter(ctx context.Context, characterID int64) ([]SpellCast, error)
 	GetSpellForSpellcasting(ctx context.Context, id int64) (Spell, error)
+	GetSpellLearningAttempt(ctx context.Context, id int64) (SpellLearningAttempt, error)
+	GetSpellLearningAttemptsByCharacter(ctx context.Context, characterID int64) ([]SpellLearningAttempt, error)
 	GetSpellScroll(ctx context.Context, id int64) (GetSpellScrollRow, error)
 	GetSpellScrollsBySpell(ctx context.Context, spellID int64) ([]GetSpellScrollsBySpellRow, error)
+	GetSpellbook(ctx context.Context, id int64) (Spellbook, error)
+	GetSpellbookForCharacter(ctx context.Context, arg GetSpellbookForCharacterParams) (GetSpellbookForCharacterRow, error)
+	GetSpellbookOwner(ctx context.Context, itemID int64) (int64, error)
+	GetSpellbookSpell(ctx context.Context, arg GetSpellbookSpellParams) (SpellbookSpell, error)
+	GetSpellbookSpells(ctx context.Context, spellbookID int64) ([]SpellbookSpell, error)
+	GetSpellbooksByCharacter(ctx context.Context, characterID int64) ([]GetSpellbooksByCharacterRow, error)
 	GetSpellsByClassLevel(ctx context.Context, arg GetSpellsByClassLevelParams) ([]Spell, error)
-	// Gets all thief abilities available to a character based on their level
-	GetThiefAbilities(ctx context.Context, characterLevel int64) ([]ThiefAbility, error)
+	GetThiefSkillCheck(ctx context.Context, id int64) (ThiefSkillCheck, error)
+	GetThiefSkillChecksByCharacter(ctx context.Context, characterID int64) ([]ThiefSkillCheck, error)
 	GetThiefSkillsByLevel(ctx context.Context, level int64) ([]ThiefSkill, error)
 	GetTreasure(ctx context.Context, id int64) (Treasure, error)
 	GetTreasureByCharacter(ctx context.Context, characterID sql.NullInt64) (Treasure, error)
+	GetTurnUndeadAttempt(ctx context.Context, id int64) (TurnUndeadAttempt, error)
+	GetTurnUndeadAttemptsByCharacter(ctx context.Context, characterID int64) ([]TurnUndeadAttempt, error)
 	GetUser(ctx context.Context, id int64) (GetUserRow, error)
-	// Gets all warlock abilities available to a character based on their level
-	GetWarlockAbilities(ctx context.Context, characterLevel int64) ([]WarlockAbility, error)
 	GetWeapon(ctx context.Context, id int64) (Weapon, error)
 	GetWeaponByName(ctx context.Context, name string) (Weapon, error)
 	GetWeaponMasteriesByCharacter(ctx context.Context, characterID int64) ([]WeaponMastery, error)
 	GetWeaponMasteryByBaseName(ctx context.Context, arg GetWeaponMasteryByBaseNameParams) (WeaponMastery, error)
 	GetWeaponMasteryByID(ctx context.Context, id int64) (WeaponMastery, error)
-	// Gets all witch abilities available to a character based on their level
-	GetWitchAbilities(ctx context.Context, characterLevel int64) ([]WitchAbility, error)
+	GetXPAward(ctx context.Context, id int64) (XpAward, error)
+	GetXPAwardReversal(ctx context.Context, reversesAwardID sql.NullInt64) (XpAward, error)
+	GetXPAwardsByCharacter(ctx context.Context, characterID int64) ([]XpAward, error)
 	ListAmmo(ctx context.Context) ([]Ammo, error)
 	ListArmors(ctx context.Context) ([]Armor, error)
 	ListCharacters(ctx context.Context) ([]ListCharactersRow, error)
+	ListClassRules(ctx context.Context) ([]ClassRule, error)
+	ListConditions(ctx context.Context) ([]Condition, error)
 	ListContainers(ctx context.Context) ([]Container, error)
+	ListContentPackEntries(ctx context.Context, packID int64) ([]ContentPackEntry, error)
+	ListContentPacks(ctx context.Context) ([]ContentPack, error)
 	ListEquipment(ctx context.Context) ([]Equipment, error)
 	ListInventories(ctx context.Context) ([]Inventory, error)
+	ListKindreds(ctx context.Context) ([]Kindred, error)
 	ListMagicItems(ctx context.Context) ([]MagicItem, error)
 	ListMagicItemsByType(ctx context.Context, itemType string) ([]MagicItem, error)
+	ListMonsters(ctx context.Context) ([]Monster, error)
 	ListPotions(ctx context.Context) ([]Potion, error)
 	ListRings(ctx context.Context) ([]Ring, error)
 	ListShields(ctx context.Context) ([]Shield, error)
@@ -185,30 +253,53 @@ type Querier interface {
 	MarkSpellAsMemorizedBySpellID(ctx context.Context, arg MarkSpellAsMemorizedBySpellIDParams) error
 	PrepareSpell(ctx context.Context, arg PrepareSpellParams) (sql.Result, error)
 	RecalculateInventoryWeight(ctx context.Context, id int64) error
+	RecoverTurnUndeadAttempts(ctx context.Context, characterID int64) (sql.Result, error)
+	ReleaseAbilityRoll(ctx context.Context, id int64) error
 	RemoveAllInventoryItems(ctx context.Context, inventoryID int64) error
 	RemoveInventoryItem(ctx context.Context, id int64) error
 	RemoveKnownSpell(ctx context.Context, id int64) error
+	RemoveSpellbookSpell(ctx context.Context, arg RemoveSpellbookSpellParams) error
 	ResetAllMemorizedSpells(ctx context.Context, characterID int64) error
+	RestoreAttributeAdjustments(ctx context.Context, arg RestoreAttributeAdjustmentsParams) (sql.Result, error)
+	RestorePreparedSpell(ctx context.Context, id int64) error
+	RetireCharacter(ctx context.Context, id int64) error
+	SearchMonsters(ctx context.Context, arg SearchMonstersParams) ([]Monster, error)
+	SetAbilityRollCharacter(ctx context.Context, arg SetAbilityRollCharacterParams) error
+	SumXPAwardsByCharacter(ctx context.Context, characterID int64) (int64, error)
 	UnprepareSpell(ctx context.Context, id int64) error
 	UpdateAmmo(ctx context.Context, arg UpdateAmmoParams) (sql.Result, error)
 	UpdateArmor(ctx context.Context, arg UpdateArmorParams) (sql.Result, error)
 	UpdateCharacter(ctx context.Context, arg UpdateCharacterParams) (sql.Result, error)
+	UpdateCharacterExperience(ctx context.Context, arg UpdateCharacterExperienceParams) error
+	UpdateCharacterHitPoints(ctx context.Context, arg UpdateCharacterHitPointsParams) error
+	UpdateCondition(ctx context.Context, arg UpdateConditionParams) (sql.Result, error)
 	UpdateContainer(ctx context.Context, arg UpdateContainerParams) (sql.Result, error)
+	UpdateEncounterInitiativeMode(ctx context.Context, arg UpdateEncounterInitiativeModeParams) error
+	UpdateEncounterTurn(ctx context.Context, arg UpdateEncounterTurnParams) error
 	UpdateEquipment(ctx context.Context, arg UpdateEquipmentParams) (sql.Result, error)
 	UpdateInventory(ctx context.Context, arg UpdateInventoryParams) (sql.Result, error)
 	UpdateInventoryItem(ctx context.Context, arg UpdateInventoryItemParams) (sql.Result, error)
+	UpdateInventoryItemCharges(ctx context.Context, arg UpdateInventoryItemChargesParams) error
+	UpdateInventoryItemUses(ctx context.Context, arg UpdateInventoryItemUsesParams) error
 	UpdateInventoryWeight(ctx context.Context, arg UpdateInventoryWeightParams) error
+	UpdateKindred(ctx context.Context, arg UpdateKindredParams) (sql.Result, error)
 	UpdateMagicItem(ctx context.Context, arg UpdateMagicItemParams) (sql.Result, error)
+	UpdateMonster(ctx context.Context, arg UpdateMonsterParams) (sql.Result, error)
+	UpdateParticipantHP(ctx context.Context, arg UpdateParticipantHPParams) error
+	UpdateParticipantInitiative(ctx context.Context, arg UpdateParticipantInitiativeParams) error
 	UpdatePotion(ctx context.Context, arg UpdatePotionParams) (sql.Result, error)
 	UpdateRing(ctx context.Context, arg UpdateRingParams) (sql.Result, error)
 	UpdateShield(ctx context.Context, arg UpdateShieldParams) (sql.Result, error)
 	UpdateSpell(ctx context.Context, arg UpdateSpellParams) (sql.Result, error)
 	UpdateSpellScroll(ctx context.Context, arg UpdateSpellScrollParams) (sql.Result, error)
+	UpdateSpellbookUsedPages(ctx context.Context, arg UpdateSpellbookUsedPagesParams) error
 	UpdateTreasure(ctx context.Context, arg UpdateTreasureParams) (sql.Result, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (sql.Result, error)
 	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error
+	UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) error
 	UpdateWeapon(ctx context.Context, arg UpdateWeaponParams) (sql.Result, error)
 	UpdateWeaponMasteryLevel(ctx context.Context, arg UpdateWeaponMasteryLevelParams) error
+	UpsertCharacterGrant(ctx context.Context, arg UpsertCharacterGrantParams) error
 }
 
 var _ Querier = (*Queries)(nil)
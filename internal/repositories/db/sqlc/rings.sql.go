@@ -12,9 +12,9 @@ import (
 
 const createRing = `-- name: CreateRing :execresult
 INSERT INTO rings (
-  name, description, cost, weight
+  name, description, cost, weight, save_bonus
 ) VALUES (
-  ?, ?, ?, ?
+  ?, ?, ?, ?, ?
 )
 `
 
@@ -23,6 +23,7 @@ type CreateRingParams struct {
 	Description string
 	Cost        float64
 	Weight      int64
+	SaveBonus   int64
 }
 
 func (q *Queries) CreateRing(ctx context.Context, arg CreateRingParams) (sql.Result, error) {
@@ -31,6 +32,7 @@ func (q *Queries) CreateRing(ctx context.Context, arg CreateRingParams) (sql.Res
 		arg.Description,
 		arg.Cost,
 		arg.Weight,
+		arg.SaveBonus,
 	)
 }
 
@@ -44,7 +46,7 @@ func (q *Queries) DeleteRing(ctx context.Context, id int64) (sql.Result, error)
 }
 
 const getRing = `-- name: GetRing :one
-SELECT id, name, description, cost, weight, created_at, updated_at FROM rings
+SELECT id, name, description, cost, weight, created_at, updated_at, save_bonus FROM rings
 WHERE id = ? LIMIT 1
 `
 
@@ -59,12 +61,13 @@ func (q *Queries) GetRing(ctx context.Context, id int64) (Ring, error) {
 		&i.Weight,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SaveBonus,
 	)
 	return i, err
 }
 
 const getRingByName = `-- name: GetRingByName :one
-SELECT id, name, description, cost, weight, created_at, updated_at FROM rings
+SELECT id, name, description, cost, weight, created_at, updated_at, save_bonus FROM rings
 WHERE name = ? LIMIT 1
 `
 
@@ -79,12 +82,13 @@ func (q *Queries) GetRingByName(ctx context.Context, name string) (Ring, error)
 		&i.Weight,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SaveBonus,
 	)
 	return i, err
 }
 
 const listRings = `-- name: ListRings :many
-SELECT id, name, description, cost, weight, created_at, updated_at FROM rings
+SELECT id, name, description, cost, weight, created_at, updated_at, save_bonus FROM rings
 ORDER BY name
 `
 
@@ -105,6 +109,7 @@ func (q *Queries) ListRings(ctx context.Context) ([]Ring, error) {
 			&i.Weight,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SaveBonus,
 		); err != nil {
 			return nil, err
 		}
@@ -125,6 +130,7 @@ SET name = ?,
     description = ?,
     cost = ?,
     weight = ?,
+    save_bonus = ?,
     updated_at = datetime('now')
 WHERE id = ?
 `
@@ -134,6 +140,7 @@ type UpdateRingParams struct {
 	Description string
 	Cost        float64
 	Weight      int64
+	SaveBonus   int64
 	ID          int64
 }
 
@@ -143,6 +150,7 @@ func (q *Queries) UpdateRing(ctx context.Context, arg UpdateRingParams) (sql.Res
 		arg.Description,
 		arg.Cost,
 		arg.Weight,
+		arg.SaveBonus,
 		arg.ID,
 	)
 }
@@ -48,6 +48,23 @@ func (q *Queries) ClearPreparedSpells(ctx context.Context, characterID int64) er
 	return err
 }
 
+const countPreparedSpellInstances = `-- name: CountPreparedSpellInstances :one
+SELECT COUNT(*) as count FROM prepared_spells
+WHERE character_id = ? AND spell_id = ?
+`
+
+type CountPreparedSpellInstancesParams struct {
+	CharacterID int64
+	SpellID     int64
+}
+
+func (q *Queries) CountPreparedSpellInstances(ctx context.Context, arg CountPreparedSpellInstancesParams) (int64, error) {
+	row := q.queryRow(ctx, q.countPreparedSpellInstancesStmt, countPreparedSpellInstances, arg.CharacterID, arg.SpellID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countPreparedSpellsByLevelAndClass = `-- name: CountPreparedSpellsByLevelAndClass :one
 SELECT COUNT(*) as count FROM prepared_spells
 WHERE character_id = ? AND spell_level = ? AND spell_class = ?
@@ -242,9 +259,18 @@ func (q *Queries) GetKnownSpellsByClass(ctx context.Context, arg GetKnownSpellsB
 }
 
 const getNextAvailableSlotIndex = `-- name: GetNextAvailableSlotIndex :one
-SELECT COALESCE(MAX(slot_index), 0) + 1 as next_slot_index
-FROM prepared_spells
-WHERE character_id = ? AND spell_level = ? AND spell_class = ?
+SELECT CAST(MIN(used.slot_index) + 1 AS INTEGER) as next_slot_index
+FROM (
+    SELECT 0 AS slot_index
+    UNION ALL
+    SELECT slot_index FROM prepared_spells
+    WHERE character_id = ?1 AND spell_level = ?2 AND spell_class = ?3
+) used
+WHERE NOT EXISTS (
+    SELECT 1 FROM prepared_spells p
+    WHERE p.character_id = ?1 AND p.spell_level = ?2 AND p.spell_class = ?3
+      AND p.slot_index = used.slot_index + 1
+)
 `
 
 type GetNextAvailableSlotIndexParams struct {
@@ -253,6 +279,7 @@ type GetNextAvailableSlotIndexParams struct {
 	SpellClass  string
 }
 
+// Returns the lowest free slot so slots freed by unpreparing are reused
 func (q *Queries) GetNextAvailableSlotIndex(ctx context.Context, arg GetNextAvailableSlotIndexParams) (int64, error) {
 	row := q.queryRow(ctx, q.getNextAvailableSlotIndexStmt, getNextAvailableSlotIndex, arg.CharacterID, arg.SpellLevel, arg.SpellClass)
 	var next_slot_index int64
@@ -261,8 +288,10 @@ func (q *Queries) GetNextAvailableSlotIndex(ctx context.Context, arg GetNextAvai
 }
 
 const getPreparedSpellByCharacterAndSpell = `-- name: GetPreparedSpellByCharacterAndSpell :one
-SELECT id, character_id, spell_id, spell_name, spell_level, spell_class, slot_index, created_at, updated_at FROM prepared_spells
+SELECT id, character_id, spell_id, spell_name, spell_level, spell_class, slot_index, created_at, updated_at, expended_at FROM prepared_spells
 WHERE character_id = ? AND spell_id = ?
+ORDER BY slot_index DESC
+LIMIT 1
 `
 
 type GetPreparedSpellByCharacterAndSpellParams struct {
@@ -283,12 +312,42 @@ func (q *Queries) GetPreparedSpellByCharacterAndSpell(ctx context.Context, arg G
 		&i.SlotIndex,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ExpendedAt,
+	)
+	return i, err
+}
+
+const getPreparedSpellBySlot = `-- name: GetPreparedSpellBySlot :one
+SELECT id, character_id, spell_id, spell_name, spell_level, spell_class, slot_index, created_at, updated_at, expended_at FROM prepared_spells
+WHERE character_id = ? AND spell_id = ? AND slot_index = ?
+`
+
+type GetPreparedSpellBySlotParams struct {
+	CharacterID int64
+	SpellID     int64
+	SlotIndex   int64
+}
+
+func (q *Queries) GetPreparedSpellBySlot(ctx context.Context, arg GetPreparedSpellBySlotParams) (PreparedSpell, error) {
+	row := q.queryRow(ctx, q.getPreparedSpellBySlotStmt, getPreparedSpellBySlot, arg.CharacterID, arg.SpellID, arg.SlotIndex)
+	var i PreparedSpell
+	err := row.Scan(
+		&i.ID,
+		&i.CharacterID,
+		&i.SpellID,
+		&i.SpellName,
+		&i.SpellLevel,
+		&i.SpellClass,
+		&i.SlotIndex,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExpendedAt,
 	)
 	return i, err
 }
 
 const getPreparedSpells = `-- name: GetPreparedSpells :many
-SELECT id, character_id, spell_id, spell_name, spell_level, spell_class, slot_index, created_at, updated_at FROM prepared_spells
+SELECT id, character_id, spell_id, spell_name, spell_level, spell_class, slot_index, created_at, updated_at, expended_at FROM prepared_spells
 WHERE character_id = ?
 ORDER BY spell_level, slot_index
 `
@@ -312,6 +371,7 @@ func (q *Queries) GetPreparedSpells(ctx context.Context, characterID int64) ([]P
 			&i.SlotIndex,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ExpendedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -327,7 +387,7 @@ func (q *Queries) GetPreparedSpells(ctx context.Context, characterID int64) ([]P
 }
 
 const getPreparedSpellsByClass = `-- name: GetPreparedSpellsByClass :many
-SELECT id, character_id, spell_id, spell_name, spell_level, spell_class, slot_index, created_at, updated_at FROM prepared_spells
+SELECT id, character_id, spell_id, spell_name, spell_level, spell_class, slot_index, created_at, updated_at, expended_at FROM prepared_spells
 WHERE character_id = ? AND spell_class = ?
 ORDER BY spell_level, slot_index
 `
@@ -356,6 +416,7 @@ func (q *Queries) GetPreparedSpellsByClass(ctx context.Context, arg GetPreparedS
 			&i.SlotIndex,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ExpendedAt,
 		); err != nil {
 			return nil, err
 		}
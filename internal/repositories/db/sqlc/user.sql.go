@@ -11,6 +11,18 @@ import (
 	"time"
 )
 
+const countUsersByRole = `-- name: CountUsersByRole :one
+SELECT COUNT(*) AS count FROM users
+WHERE role = ?
+`
+
+func (q *Queries) CountUsersByRole(ctx context.Context, role string) (int64, error) {
+	row := q.queryRow(ctx, q.countUsersByRoleStmt, countUsersByRole, role)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createUser = `-- name: CreateUser :execresult
 INSERT INTO users (
   username, email, password_hash
@@ -39,7 +51,7 @@ func (q *Queries) DeleteUser(ctx context.Context, id int64) (sql.Result, error)
 }
 
 const getFullUserByEmail = `-- name: GetFullUserByEmail :one
-SELECT id, username, email, password_hash, created_at, updated_at FROM users
+SELECT id, username, email, password_hash, created_at, updated_at, role FROM users
 WHERE email = ? LIMIT 1
 `
 
@@ -53,12 +65,13 @@ func (q *Queries) GetFullUserByEmail(ctx context.Context, email string) (User, e
 		&i.PasswordHash,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Role,
 	)
 	return i, err
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, username, email, created_at, updated_at FROM users
+SELECT id, username, email, role, created_at, updated_at FROM users
 WHERE id = ? LIMIT 1
 `
 
@@ -66,6 +79,7 @@ type GetUserRow struct {
 	ID        int64
 	Username  string
 	Email     string
+	Role      string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -77,6 +91,7 @@ func (q *Queries) GetUser(ctx context.Context, id int64) (GetUserRow, error) {
 		&i.ID,
 		&i.Username,
 		&i.Email,
+		&i.Role,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -84,7 +99,7 @@ func (q *Queries) GetUser(ctx context.Context, id int64) (GetUserRow, error) {
 }
 
 const listUsers = `-- name: ListUsers :many
-SELECT id, username, email, created_at, updated_at FROM users
+SELECT id, username, email, role, created_at, updated_at FROM users
 ORDER BY username
 `
 
@@ -92,6 +107,7 @@ type ListUsersRow struct {
 	ID        int64
 	Username  string
 	Email     string
+	Role      string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -109,6 +125,7 @@ func (q *Queries) ListUsers(ctx context.Context) ([]ListUsersRow, error) {
 			&i.ID,
 			&i.Username,
 			&i.Email,
+			&i.Role,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -127,7 +144,7 @@ func (q *Queries) ListUsers(ctx context.Context) ([]ListUsersRow, error) {
 
 const updateUser = `-- name: UpdateUser :execresult
 UPDATE users
-SET username = ?, email = ?, updated_at = datetime('now')
+SET username = ?, email = ?, updated_at = strftime('%Y-%m-%d %H:%M:%f', 'now')
 WHERE id = ?
 `
 
@@ -157,3 +174,20 @@ func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPassword
 	_, err := q.exec(ctx, q.updateUserPasswordStmt, updateUserPassword, arg.PasswordHash, arg.ID)
 	return err
 }
+
+const updateUserRole = `-- name: UpdateUserRole :exec
+UPDATE users
+SET role = ?,
+    updated_at = strftime('%Y-%m-%d %H:%M:%f', 'now')
+WHERE id = ?
+`
+
+type UpdateUserRoleParams struct {
+	Role string
+	ID   int64
+}
+
+func (q *Queries) UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) error {
+	_, err := q.exec(ctx, q.updateUserRoleStmt, updateUserRole, arg.Role, arg.ID)
+	return err
+}
@@ -0,0 +1,252 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	sqlcdb "mordezzanV4/internal/repositories/db/sqlc"
+)
+
+type EncounterRepository interface {
+	// GetEncounter retrieves an encounter with its participants in initiative order
+	GetEncounter(ctx context.Context, id int64) (*models.Encounter, error)
+	GetEncountersByUser(ctx context.Context, userID int64) ([]*models.Encounter, error)
+	CreateEncounter(ctx context.Context, userID int64, input *models.CreateEncounterInput) (int64, error)
+	DeleteEncounter(ctx context.Context, id int64) error
+	// AddParticipants adds characters or monsters to an encounter together
+	AddParticipants(ctx context.Context, encounterID int64, participants []*models.EncounterParticipant) error
+	RemoveParticipant(ctx context.Context, encounterID, participantID int64) error
+	// SetInitiative stores the rolled initiative and surprise of each
+	// participant and starts the first round
+	SetInitiative(ctx context.Context, encounterID int64, mode string, participants []*models.EncounterParticipant) error
+	UpdateTurn(ctx context.Context, encounterID int64, round, turn int) error
+	UpdateParticipantHP(ctx context.Context, participantID int64, currentHP int) error
+}
+
+type SQLCEncounterRepository struct {
+	db *sql.DB
+	q  *sqlcdb.Queries
+}
+
+func NewSQLCEncounterRepository(db *sql.DB) *SQLCEncounterRepository {
+	return &SQLCEncounterRepository{
+		db: db,
+		q:  sqlcdb.New(db),
+	}
+}
+
+func (r *SQLCEncounterRepository) GetEncounter(ctx context.Context, id int64) (*models.Encounter, error) {
+	encounter, err := r.q.GetEncounter(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("encounter", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	participants, err := r.q.GetEncounterParticipants(ctx, id)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := mapDbEncounterToModel(encounter)
+	result.Participants = make([]*models.EncounterParticipant, len(participants))
+	for i, participant := range participants {
+		result.Participants[i] = mapDbEncounterParticipantToModel(participant)
+	}
+	return result, nil
+}
+
+func (r *SQLCEncounterRepository) GetEncountersByUser(ctx context.Context, userID int64) ([]*models.Encounter, error) {
+	encounters, err := r.q.GetEncountersByUser(ctx, userID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := make([]*models.Encounter, len(encounters))
+	for i, encounter := range encounters {
+		result[i] = mapDbEncounterToModel(encounter)
+	}
+	return result, nil
+}
+
+func (r *SQLCEncounterRepository) CreateEncounter(ctx context.Context, userID int64, input *models.CreateEncounterInput) (int64, error) {
+	result, err := r.q.CreateEncounter(ctx, sqlcdb.CreateEncounterParams{
+		UserID: userID,
+		Name:   input.Name,
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return id, nil
+}
+
+func (r *SQLCEncounterRepository) DeleteEncounter(ctx context.Context, id int64) error {
+	if err := r.q.DeleteEncounter(ctx, id); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *SQLCEncounterRepository) AddParticipants(ctx context.Context, encounterID int64, participants []*models.EncounterParticipant) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	for _, participant := range participants {
+		params := sqlcdb.AddEncounterParticipantParams{
+			EncounterID: encounterID,
+			Side:        participant.Side,
+			CharacterID: nullInt64FromInt64Ptr(participant.CharacterID),
+			MonsterID:   nullInt64FromInt64Ptr(participant.MonsterID),
+			Name:        participant.Name,
+			ArmorClass:  nullInt64FromPtr(participant.ArmorClass),
+		}
+		// Characters keep their hit points on the character
+		if !participant.IsCharacter() {
+			params.MaxHp = sql.NullInt64{Int64: int64(participant.MaxHP), Valid: true}
+			params.CurrentHp = sql.NullInt64{Int64: int64(participant.CurrentHP), Valid: true}
+		}
+		if _, err := qtx.AddEncounterParticipant(ctx, params); err != nil {
+			return apperrors.NewDatabaseError(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *SQLCEncounterRepository) RemoveParticipant(ctx context.Context, encounterID, participantID int64) error {
+	err := r.q.DeleteEncounterParticipant(ctx, sqlcdb.DeleteEncounterParticipantParams{
+		ID:          participantID,
+		EncounterID: encounterID,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *SQLCEncounterRepository) SetInitiative(ctx context.Context, encounterID int64, mode string, participants []*models.EncounterParticipant) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	err = qtx.UpdateEncounterInitiativeMode(ctx, sqlcdb.UpdateEncounterInitiativeModeParams{
+		InitiativeMode: mode,
+		ID:             encounterID,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+
+	for _, participant := range participants {
+		err = qtx.UpdateParticipantInitiative(ctx, sqlcdb.UpdateParticipantInitiativeParams{
+			Initiative: nullInt64FromPtr(participant.Initiative),
+			Surprised:  participant.Surprised,
+			ID:         participant.ID,
+		})
+		if err != nil {
+			return apperrors.NewDatabaseError(err)
+		}
+	}
+
+	err = qtx.UpdateEncounterTurn(ctx, sqlcdb.UpdateEncounterTurnParams{
+		Round: 1,
+		Turn:  0,
+		ID:    encounterID,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *SQLCEncounterRepository) UpdateTurn(ctx context.Context, encounterID int64, round, turn int) error {
+	err := r.q.UpdateEncounterTurn(ctx, sqlcdb.UpdateEncounterTurnParams{
+		Round: int64(round),
+		Turn:  int64(turn),
+		ID:    encounterID,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *SQLCEncounterRepository) UpdateParticipantHP(ctx context.Context, participantID int64, currentHP int) error {
+	err := r.q.UpdateParticipantHP(ctx, sqlcdb.UpdateParticipantHPParams{
+		CurrentHp: sql.NullInt64{Int64: int64(currentHP), Valid: true},
+		ID:        participantID,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func nullInt64FromInt64Ptr(value *int64) sql.NullInt64 {
+	if value == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: *value, Valid: true}
+}
+
+func int64PtrFromNullInt64(value sql.NullInt64) *int64 {
+	if !value.Valid {
+		return nil
+	}
+	v := value.Int64
+	return &v
+}
+
+func mapDbEncounterToModel(encounter sqlcdb.Encounter) *models.Encounter {
+	return &models.Encounter{
+		ID:             encounter.ID,
+		UserID:         encounter.UserID,
+		Name:           encounter.Name,
+		InitiativeMode: encounter.InitiativeMode,
+		Round:          int(encounter.Round),
+		Turn:           int(encounter.Turn),
+		CreatedAt:      encounter.CreatedAt,
+		UpdatedAt:      encounter.UpdatedAt,
+	}
+}
+
+func mapDbEncounterParticipantToModel(participant sqlcdb.GetEncounterParticipantsRow) *models.EncounterParticipant {
+	return &models.EncounterParticipant{
+		ID:          participant.ID,
+		EncounterID: participant.EncounterID,
+		Side:        participant.Side,
+		CharacterID: int64PtrFromNullInt64(participant.CharacterID),
+		MonsterID:   int64PtrFromNullInt64(participant.MonsterID),
+		Name:        participant.Name,
+		ArmorClass:  intPtrFromNullInt64(participant.ArmorClass),
+		MaxHP:       int(participant.MaxHp),
+		CurrentHP:   int(participant.CurrentHp),
+		Initiative:  intPtrFromNullInt64(participant.Initiative),
+		Surprised:   participant.Surprised,
+		CreatedAt:   participant.CreatedAt,
+	}
+}
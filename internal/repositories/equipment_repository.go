@@ -70,6 +70,7 @@ func (r *SQLCEquipmentRepository) CreateEquipment(ctx context.Context, input *mo
 		Description: input.Description,
 		Cost:        input.Cost,
 		Weight:      int64(input.Weight),
+		SaveBonus:   int64(input.SaveBonus),
 	})
 	if err != nil {
 		return 0, apperrors.NewDatabaseError(err)
@@ -91,6 +92,7 @@ func (r *SQLCEquipmentRepository) UpdateEquipment(ctx context.Context, id int64,
 		Description: input.Description,
 		Cost:        input.Cost,
 		Weight:      int64(input.Weight),
+		SaveBonus:   int64(input.SaveBonus),
 		ID:          id,
 	})
 	if err != nil {
@@ -118,6 +120,7 @@ func mapDbEquipmentToModel(equipment sqlcdb.Equipment) *models.Equipment {
 		Description: equipment.Description,
 		Cost:        equipment.Cost,
 		Weight:      int(equipment.Weight),
+		SaveBonus:   int(equipment.SaveBonus),
 		CreatedAt:   equipment.CreatedAt,
 		UpdatedAt:   equipment.UpdatedAt,
 	}
@@ -264,15 +264,17 @@ func (r *SQLCInventoryRepository) GetInventoryItems(ctx context.Context, invento
 	result := make([]models.InventoryItem, len(items))
 	for i, item := range items {
 		result[i] = models.InventoryItem{
-			ID:          item.ID,
-			InventoryID: item.InventoryID,
-			ItemType:    item.ItemType,
-			ItemID:      item.ItemID,
-			Quantity:    int(item.Quantity),
-			IsEquipped:  item.IsEquipped,
-			Notes:       item.Notes.String,
-			CreatedAt:   item.CreatedAt,
-			UpdatedAt:   item.UpdatedAt,
+			ID:            item.ID,
+			InventoryID:   item.InventoryID,
+			ItemType:      item.ItemType,
+			ItemID:        item.ItemID,
+			Quantity:      int(item.Quantity),
+			IsEquipped:    item.IsEquipped,
+			Notes:         item.Notes.String,
+			CreatedAt:     item.CreatedAt,
+			UpdatedAt:     item.UpdatedAt,
+			Charges:       intPtrFromNullInt64(item.Charges),
+			UsesRemaining: intPtrFromNullInt64(item.UsesRemaining),
 		}
 	}
 
@@ -289,15 +291,17 @@ func (r *SQLCInventoryRepository) GetInventoryItem(ctx context.Context, id int64
 	}
 
 	return &models.InventoryItem{
-		ID:          item.ID,
-		InventoryID: item.InventoryID,
-		ItemType:    item.ItemType,
-		ItemID:      item.ItemID,
-		Quantity:    int(item.Quantity),
-		IsEquipped:  item.IsEquipped,
-		Notes:       item.Notes.String,
-		CreatedAt:   item.CreatedAt,
-		UpdatedAt:   item.UpdatedAt,
+		ID:            item.ID,
+		InventoryID:   item.InventoryID,
+		ItemType:      item.ItemType,
+		ItemID:        item.ItemID,
+		Quantity:      int(item.Quantity),
+		IsEquipped:    item.IsEquipped,
+		Notes:         item.Notes.String,
+		CreatedAt:     item.CreatedAt,
+		UpdatedAt:     item.UpdatedAt,
+		Charges:       intPtrFromNullInt64(item.Charges),
+		UsesRemaining: intPtrFromNullInt64(item.UsesRemaining),
 	}, nil
 }
 
@@ -313,15 +317,17 @@ func (r *SQLCInventoryRepository) GetInventoryItemsByType(ctx context.Context, i
 	result := make([]models.InventoryItem, len(items))
 	for i, item := range items {
 		result[i] = models.InventoryItem{
-			ID:          item.ID,
-			InventoryID: item.InventoryID,
-			ItemType:    item.ItemType,
-			ItemID:      item.ItemID,
-			Quantity:    int(item.Quantity),
-			IsEquipped:  item.IsEquipped,
-			Notes:       item.Notes.String,
-			CreatedAt:   item.CreatedAt,
-			UpdatedAt:   item.UpdatedAt,
+			ID:            item.ID,
+			InventoryID:   item.InventoryID,
+			ItemType:      item.ItemType,
+			ItemID:        item.ItemID,
+			Quantity:      int(item.Quantity),
+			IsEquipped:    item.IsEquipped,
+			Notes:         item.Notes.String,
+			CreatedAt:     item.CreatedAt,
+			UpdatedAt:     item.UpdatedAt,
+			Charges:       intPtrFromNullInt64(item.Charges),
+			UsesRemaining: intPtrFromNullInt64(item.UsesRemaining),
 		}
 	}
 
@@ -342,15 +348,17 @@ func (r *SQLCInventoryRepository) GetInventoryItemByTypeAndItemID(ctx context.Co
 	}
 
 	return &models.InventoryItem{
-		ID:          item.ID,
-		InventoryID: item.InventoryID,
-		ItemType:    item.ItemType,
-		ItemID:      item.ItemID,
-		Quantity:    int(item.Quantity),
-		IsEquipped:  item.IsEquipped,
-		Notes:       item.Notes.String,
-		CreatedAt:   item.CreatedAt,
-		UpdatedAt:   item.UpdatedAt,
+		ID:            item.ID,
+		InventoryID:   item.InventoryID,
+		ItemType:      item.ItemType,
+		ItemID:        item.ItemID,
+		Quantity:      int(item.Quantity),
+		IsEquipped:    item.IsEquipped,
+		Notes:         item.Notes.String,
+		CreatedAt:     item.CreatedAt,
+		UpdatedAt:     item.UpdatedAt,
+		Charges:       intPtrFromNullInt64(item.Charges),
+		UsesRemaining: intPtrFromNullInt64(item.UsesRemaining),
 	}, nil
 }
 
@@ -368,13 +376,15 @@ func (r *SQLCInventoryRepository) AddInventoryItem(ctx context.Context, inventor
 	}
 
 	params := sqlcdb.AddInventoryItemParams{
-		InventoryID: inventoryID,
-		ItemType:    input.ItemType,
-		ItemID:      input.ItemID,
-		Quantity:    int64(input.Quantity),
-		IsEquipped:  input.IsEquipped,
-		Slot:        slotParam, // Add the slot parameter here
-		Notes:       notesParam,
+		InventoryID:   inventoryID,
+		ItemType:      input.ItemType,
+		ItemID:        input.ItemID,
+		Quantity:      int64(input.Quantity),
+		IsEquipped:    input.IsEquipped,
+		Slot:          slotParam, // Add the slot parameter here
+		Notes:         notesParam,
+		Charges:       nullInt64FromPtr(input.Charges),
+		UsesRemaining: nullInt64FromPtr(input.UsesRemaining),
 	}
 
 	result, err := r.q.AddInventoryItem(ctx, params)
@@ -481,16 +491,18 @@ func (r *SQLCInventoryRepository) GetEquippedItems(ctx context.Context, inventor
 	result := make([]models.InventoryItem, len(items))
 	for i, item := range items {
 		result[i] = models.InventoryItem{
-			ID:          item.ID,
-			InventoryID: item.InventoryID,
-			ItemType:    item.ItemType,
-			ItemID:      item.ItemID,
-			Quantity:    int(item.Quantity),
-			IsEquipped:  item.IsEquipped,
-			Slot:        item.Slot.String,
-			Notes:       item.Notes.String,
-			CreatedAt:   item.CreatedAt,
-			UpdatedAt:   item.UpdatedAt,
+			ID:            item.ID,
+			InventoryID:   item.InventoryID,
+			ItemType:      item.ItemType,
+			ItemID:        item.ItemID,
+			Quantity:      int(item.Quantity),
+			IsEquipped:    item.IsEquipped,
+			Slot:          item.Slot.String,
+			Notes:         item.Notes.String,
+			CreatedAt:     item.CreatedAt,
+			UpdatedAt:     item.UpdatedAt,
+			Charges:       intPtrFromNullInt64(item.Charges),
+			UsesRemaining: intPtrFromNullInt64(item.UsesRemaining),
 		}
 	}
 
@@ -514,16 +526,18 @@ func (r *SQLCInventoryRepository) GetItemsBySlot(ctx context.Context, inventoryI
 	result := make([]models.InventoryItem, len(items))
 	for i, item := range items {
 		result[i] = models.InventoryItem{
-			ID:          item.ID,
-			InventoryID: item.InventoryID,
-			ItemType:    item.ItemType,
-			ItemID:      item.ItemID,
-			Quantity:    int(item.Quantity),
-			IsEquipped:  item.IsEquipped,
-			Slot:        item.Slot.String,
-			Notes:       item.Notes.String,
-			CreatedAt:   item.CreatedAt,
-			UpdatedAt:   item.UpdatedAt,
+			ID:            item.ID,
+			InventoryID:   item.InventoryID,
+			ItemType:      item.ItemType,
+			ItemID:        item.ItemID,
+			Quantity:      int(item.Quantity),
+			IsEquipped:    item.IsEquipped,
+			Slot:          item.Slot.String,
+			Notes:         item.Notes.String,
+			CreatedAt:     item.CreatedAt,
+			UpdatedAt:     item.UpdatedAt,
+			Charges:       intPtrFromNullInt64(item.Charges),
+			UsesRemaining: intPtrFromNullInt64(item.UsesRemaining),
 		}
 	}
 
@@ -548,3 +562,32 @@ func (r *SQLCInventoryRepository) RecalculateInventoryWeight(ctx context.Context
 	}
 	return nil
 }
+
+// consumeInventoryItem uses up one of a stacked inventory item, removing the
+// row when the last is gone, and recalculates the inventory's weight
+func consumeInventoryItem(ctx context.Context, qtx *sqlcdb.Queries, itemID int64) error {
+	item, err := qtx.GetInventoryItem(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.NewNotFound("inventory item", itemID)
+		}
+		return apperrors.NewDatabaseError(err)
+	}
+
+	if item.Quantity > 1 {
+		_, err = qtx.UpdateInventoryItem(ctx, sqlcdb.UpdateInventoryItemParams{
+			Quantity: sql.NullInt64{Int64: item.Quantity - 1, Valid: true},
+			ID:       itemID,
+		})
+	} else {
+		err = qtx.RemoveInventoryItem(ctx, itemID)
+	}
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+
+	if err := qtx.RecalculateInventoryWeight(ctx, item.InventoryID); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	sqlcdb "mordezzanV4/internal/repositories/db/sqlc"
+)
+
+type ItemUseRepository interface {
+	// GetCharacterInventoryItem retrieves an item only if it is in the character's inventory
+	GetCharacterInventoryItem(ctx context.Context, characterID, itemID int64) (*models.InventoryItem, error)
+	// RecordUse logs the use and, when consume is set, uses up one of the item
+	RecordUse(ctx context.Context, use *models.ItemUse, consume bool) (int64, error)
+	// RecordChargeUse logs the use and sets the charges left in the item
+	RecordChargeUse(ctx context.Context, use *models.ItemUse, charges int) (int64, error)
+	// RecordPotionUse logs a drink, starts the potion's effect when there is
+	// one and leaves usesLeft in the open potion. An emptied potion is thrown
+	// away and the next in the stack opened with fullUses. The new effect's
+	// ID is set on effect.
+	RecordPotionUse(ctx context.Context, use *models.ItemUse, effect *models.ActiveEffect, usesLeft, fullUses int) (int64, error)
+	GetItemUse(ctx context.Context, id int64) (*models.ItemUse, error)
+	GetItemUses(ctx context.Context, characterID int64) ([]*models.ItemUse, error)
+}
+
+type SQLCItemUseRepository struct {
+	db *sql.DB
+	q  *sqlcdb.Queries
+}
+
+func NewSQLCItemUseRepository(db *sql.DB) *SQLCItemUseRepository {
+	return &SQLCItemUseRepository{
+		db: db,
+		q:  sqlcdb.New(db),
+	}
+}
+
+func (r *SQLCItemUseRepository) GetCharacterInventoryItem(ctx context.Context, characterID, itemID int64) (*models.InventoryItem, error) {
+	item, err := r.q.GetCharacterInventoryItem(ctx, sqlcdb.GetCharacterInventoryItemParams{
+		ID:          itemID,
+		CharacterID: characterID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("inventory item", itemID)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	return &models.InventoryItem{
+		ID:            item.ID,
+		InventoryID:   item.InventoryID,
+		ItemType:      item.ItemType,
+		ItemID:        item.ItemID,
+		Quantity:      int(item.Quantity),
+		IsEquipped:    item.IsEquipped,
+		Slot:          item.Slot.String,
+		Notes:         item.Notes.String,
+		CreatedAt:     item.CreatedAt,
+		UpdatedAt:     item.UpdatedAt,
+		Charges:       intPtrFromNullInt64(item.Charges),
+		UsesRemaining: intPtrFromNullInt64(item.UsesRemaining),
+	}, nil
+}
+
+func (r *SQLCItemUseRepository) RecordUse(ctx context.Context, use *models.ItemUse, consume bool) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	id, err := createItemUse(ctx, qtx, use)
+	if err != nil {
+		return 0, err
+	}
+
+	if consume {
+		if err := consumeInventoryItem(ctx, qtx, use.InventoryItemID); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return id, nil
+}
+
+func (r *SQLCItemUseRepository) RecordChargeUse(ctx context.Context, use *models.ItemUse, charges int) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	id, err := createItemUse(ctx, qtx, use)
+	if err != nil {
+		return 0, err
+	}
+
+	err = qtx.UpdateInventoryItemCharges(ctx, sqlcdb.UpdateInventoryItemChargesParams{
+		Charges: sql.NullInt64{Int64: int64(charges), Valid: true},
+		ID:      use.InventoryItemID,
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return id, nil
+}
+
+func (r *SQLCItemUseRepository) RecordPotionUse(ctx context.Context, use *models.ItemUse, effect *models.ActiveEffect, usesLeft, fullUses int) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	id, err := createItemUse(ctx, qtx, use)
+	if err != nil {
+		return 0, err
+	}
+
+	if effect != nil {
+		effect.ID, err = createActiveEffect(ctx, qtx, effect)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if usesLeft == 0 {
+		if err := consumeInventoryItem(ctx, qtx, use.InventoryItemID); err != nil {
+			return 0, err
+		}
+		usesLeft = fullUses
+	}
+
+	// A no-op when the last potion of the stack was just removed
+	err = qtx.UpdateInventoryItemUses(ctx, sqlcdb.UpdateInventoryItemUsesParams{
+		UsesRemaining: sql.NullInt64{Int64: int64(usesLeft), Valid: true},
+		ID:            use.InventoryItemID,
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return id, nil
+}
+
+func (r *SQLCItemUseRepository) GetItemUse(ctx context.Context, id int64) (*models.ItemUse, error) {
+	use, err := r.q.GetItemUse(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("item use", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	return mapDbItemUseToModel(use), nil
+}
+
+func (r *SQLCItemUseRepository) GetItemUses(ctx context.Context, characterID int64) ([]*models.ItemUse, error) {
+	uses, err := r.q.GetItemUsesByCharacter(ctx, characterID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := make([]*models.ItemUse, len(uses))
+	for i, use := range uses {
+		result[i] = mapDbItemUseToModel(use)
+	}
+	return result, nil
+}
+
+// createItemUse inserts a use log entry within the caller's transaction
+func createItemUse(ctx context.Context, qtx *sqlcdb.Queries, use *models.ItemUse) (int64, error) {
+	result, err := qtx.CreateItemUse(ctx, sqlcdb.CreateItemUseParams{
+		CharacterID:     use.CharacterID,
+		InventoryItemID: use.InventoryItemID,
+		ItemType:        use.ItemType,
+		ItemID:          use.ItemID,
+		ItemName:        use.ItemName,
+		Method:          use.Method,
+		Die:             int64(use.Die),
+		Roll:            int64(use.Roll),
+		Chance:          int64(use.Chance),
+		Success:         use.Success,
+		Mishap:          use.Mishap,
+		Effect:          use.Effect,
+		Target:          use.Target,
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+
+	return id, nil
+}
+
+func mapDbItemUseToModel(use sqlcdb.ItemUse) *models.ItemUse {
+	return &models.ItemUse{
+		ID:              use.ID,
+		CharacterID:     use.CharacterID,
+		InventoryItemID: use.InventoryItemID,
+		ItemType:        use.ItemType,
+		ItemID:          use.ItemID,
+		ItemName:        use.ItemName,
+		Method:          use.Method,
+		Die:             int(use.Die),
+		Roll:            int(use.Roll),
+		Chance:          int(use.Chance),
+		Success:         use.Success,
+		Mishap:          use.Mishap,
+		Effect:          use.Effect,
+		Target:          use.Target,
+		UsedAt:          use.UsedAt,
+	}
+}
@@ -0,0 +1,187 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	sqlcdb "mordezzanV4/internal/repositories/db/sqlc"
+)
+
+type KindredRepository interface {
+	GetKindred(ctx context.Context, id int64) (*models.Kindred, error)
+	GetKindredByName(ctx context.Context, name string) (*models.Kindred, error)
+	ListKindreds(ctx context.Context) ([]*models.Kindred, error)
+	CreateKindred(ctx context.Context, input *models.CreateKindredInput) (int64, error)
+	UpdateKindred(ctx context.Context, id int64, input *models.UpdateKindredInput) error
+	DeleteKindred(ctx context.Context, id int64) error
+}
+
+type SQLCKindredRepository struct {
+	db *sql.DB
+	q  *sqlcdb.Queries
+}
+
+func NewSQLCKindredRepository(db *sql.DB) *SQLCKindredRepository {
+	return &SQLCKindredRepository{
+		db: db,
+		q:  sqlcdb.New(db),
+	}
+}
+
+func (r *SQLCKindredRepository) GetKindred(ctx context.Context, id int64) (*models.Kindred, error) {
+	kindred, err := r.q.GetKindred(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("kindred", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	return mapDbKindredToModel(kindred)
+}
+
+func (r *SQLCKindredRepository) GetKindredByName(ctx context.Context, name string) (*models.Kindred, error) {
+	kindred, err := r.q.GetKindredByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("kindred", name)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	return mapDbKindredToModel(kindred)
+}
+
+func (r *SQLCKindredRepository) ListKindreds(ctx context.Context) ([]*models.Kindred, error) {
+	kindreds, err := r.q.ListKindreds(ctx)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	result := make([]*models.Kindred, len(kindreds))
+	for i, kindred := range kindreds {
+		result[i], err = mapDbKindredToModel(kindred)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (r *SQLCKindredRepository) CreateKindred(ctx context.Context, input *models.CreateKindredInput) (int64, error) {
+	languages, allowedClasses, specialAbilities, err := marshalKindredLists(input)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := r.q.CreateKindred(ctx, sqlcdb.CreateKindredParams{
+		Name:                 input.Name,
+		Description:          input.Description,
+		StrengthModifier:     int64(input.StrengthModifier),
+		DexterityModifier:    int64(input.DexterityModifier),
+		ConstitutionModifier: int64(input.ConstitutionModifier),
+		IntelligenceModifier: int64(input.IntelligenceModifier),
+		WisdomModifier:       int64(input.WisdomModifier),
+		CharismaModifier:     int64(input.CharismaModifier),
+		Languages:            languages,
+		AllowedClasses:       allowedClasses,
+		SpecialAbilities:     specialAbilities,
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return id, nil
+}
+
+func (r *SQLCKindredRepository) UpdateKindred(ctx context.Context, id int64, input *models.UpdateKindredInput) error {
+	_, err := r.GetKindred(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	languages, allowedClasses, specialAbilities, err := marshalKindredLists(input)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.q.UpdateKindred(ctx, sqlcdb.UpdateKindredParams{
+		Name:                 input.Name,
+		Description:          input.Description,
+		StrengthModifier:     int64(input.StrengthModifier),
+		DexterityModifier:    int64(input.DexterityModifier),
+		ConstitutionModifier: int64(input.ConstitutionModifier),
+		IntelligenceModifier: int64(input.IntelligenceModifier),
+		WisdomModifier:       int64(input.WisdomModifier),
+		CharismaModifier:     int64(input.CharismaModifier),
+		Languages:            languages,
+		AllowedClasses:       allowedClasses,
+		SpecialAbilities:     specialAbilities,
+		ID:                   id,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *SQLCKindredRepository) DeleteKindred(ctx context.Context, id int64) error {
+	_, err := r.GetKindred(ctx, id)
+	if err != nil {
+		return err
+	}
+	_, err = r.q.DeleteKindred(ctx, id)
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+// marshalKindredLists encodes the list columns, which are stored as JSON arrays
+func marshalKindredLists(input *models.CreateKindredInput) (string, string, string, error) {
+	lists := [][]string{input.Languages, input.AllowedClasses, input.SpecialAbilities}
+	encoded := make([]string, len(lists))
+	for i, list := range lists {
+		if list == nil {
+			list = []string{}
+		}
+		data, err := json.Marshal(list)
+		if err != nil {
+			return "", "", "", apperrors.NewInternalError(err)
+		}
+		encoded[i] = string(data)
+	}
+	return encoded[0], encoded[1], encoded[2], nil
+}
+
+func mapDbKindredToModel(kindred sqlcdb.Kindred) (*models.Kindred, error) {
+	result := &models.Kindred{
+		ID:                   kindred.ID,
+		Name:                 kindred.Name,
+		Description:          kindred.Description,
+		StrengthModifier:     int(kindred.StrengthModifier),
+		DexterityModifier:    int(kindred.DexterityModifier),
+		ConstitutionModifier: int(kindred.ConstitutionModifier),
+		IntelligenceModifier: int(kindred.IntelligenceModifier),
+		WisdomModifier:       int(kindred.WisdomModifier),
+		CharismaModifier:     int(kindred.CharismaModifier),
+		CreatedAt:            kindred.CreatedAt,
+		UpdatedAt:            kindred.UpdatedAt,
+	}
+
+	if err := json.Unmarshal([]byte(kindred.Languages), &result.Languages); err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	if err := json.Unmarshal([]byte(kindred.AllowedClasses), &result.AllowedClasses); err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	if err := json.Unmarshal([]byte(kindred.SpecialAbilities), &result.SpecialAbilities); err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"mordezzanV4/internal/dice"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	sqlcdb "mordezzanV4/internal/repositories/db/sqlc"
+)
+
+type LevelUpRepository interface {
+	GetLevelUp(ctx context.Context, id int64) (*models.LevelUp, error)
+	GetLevelUpsByCharacter(ctx context.Context, characterID int64) ([]*models.LevelUp, error)
+	CreateLevelUp(ctx context.Context, levelUp *models.LevelUp) (int64, error)
+}
+
+type SQLCLevelUpRepository struct {
+	db *sql.DB
+	q  *sqlcdb.Queries
+}
+
+func NewSQLCLevelUpRepository(db *sql.DB) *SQLCLevelUpRepository {
+	return &SQLCLevelUpRepository{
+		db: db,
+		q:  sqlcdb.New(db),
+	}
+}
+
+// levelUpDetails holds the parts of a level-up stored as JSON
+type levelUpDetails struct {
+	Changes      []models.StatChange    `json:"changes"`
+	NewAbilities []*models.ClassAbility `json:"new_abilities"`
+}
+
+func (r *SQLCLevelUpRepository) GetLevelUp(ctx context.Context, id int64) (*models.LevelUp, error) {
+	levelUp, err := r.q.GetLevelUp(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("level up", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	return mapDbLevelUpToModel(levelUp)
+}
+
+func (r *SQLCLevelUpRepository) GetLevelUpsByCharacter(ctx context.Context, characterID int64) ([]*models.LevelUp, error) {
+	levelUps, err := r.q.GetLevelUpsByCharacter(ctx, characterID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := make([]*models.LevelUp, len(levelUps))
+	for i, levelUp := range levelUps {
+		result[i], err = mapDbLevelUpToModel(levelUp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (r *SQLCLevelUpRepository) CreateLevelUp(ctx context.Context, levelUp *models.LevelUp) (int64, error) {
+	var hpRoll sql.NullString
+	if levelUp.HPRoll != nil {
+		data, err := json.Marshal(levelUp.HPRoll)
+		if err != nil {
+			return 0, apperrors.NewInternalError(err)
+		}
+		hpRoll = sql.NullString{String: string(data), Valid: true}
+	}
+
+	details, err := json.Marshal(levelUpDetails{
+		Changes:      levelUp.Changes,
+		NewAbilities: levelUp.NewAbilities,
+	})
+	if err != nil {
+		return 0, apperrors.NewInternalError(err)
+	}
+
+	result, err := r.q.CreateLevelUp(ctx, sqlcdb.CreateLevelUpParams{
+		CharacterID: levelUp.CharacterID,
+		FromLevel:   int64(levelUp.FromLevel),
+		ToLevel:     int64(levelUp.ToLevel),
+		HitDice:     levelUp.HitDice,
+		HpRoll:      hpRoll,
+		Seed:        levelUp.Seed,
+		HpModifier:  int64(levelUp.HPModifier),
+		HpGained:    int64(levelUp.HPGained),
+		FixedHp:     levelUp.FixedHP,
+		Details:     string(details),
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return id, nil
+}
+
+func mapDbLevelUpToModel(levelUp sqlcdb.LevelUp) (*models.LevelUp, error) {
+	result := &models.LevelUp{
+		ID:          levelUp.ID,
+		CharacterID: levelUp.CharacterID,
+		FromLevel:   int(levelUp.FromLevel),
+		ToLevel:     int(levelUp.ToLevel),
+		HitDice:     levelUp.HitDice,
+		Seed:        levelUp.Seed,
+		HPModifier:  int(levelUp.HpModifier),
+		HPGained:    int(levelUp.HpGained),
+		FixedHP:     levelUp.FixedHp,
+		CreatedAt:   levelUp.CreatedAt,
+	}
+
+	if levelUp.HpRoll.Valid {
+		var hpRoll dice.Result
+		if err := json.Unmarshal([]byte(levelUp.HpRoll.String), &hpRoll); err != nil {
+			return nil, apperrors.NewInternalError(err)
+		}
+		result.HPRoll = &hpRoll
+	}
+
+	var details levelUpDetails
+	if err := json.Unmarshal([]byte(levelUp.Details), &details); err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	result.Changes = details.Changes
+	result.NewAbilities = details.NewAbilities
+
+	return result, nil
+}
@@ -0,0 +1,190 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	sqlcdb "mordezzanV4/internal/repositories/db/sqlc"
+)
+
+type MonsterRepository interface {
+	GetMonster(ctx context.Context, id int64) (*models.Monster, error)
+	GetMonsterByName(ctx context.Context, name string) (*models.Monster, error)
+	ListMonsters(ctx context.Context) ([]*models.Monster, error)
+	// SearchMonsters lists monsters whose name and terrain contain the
+	// filter's text and whose hit dice fall in its range
+	SearchMonsters(ctx context.Context, filter *models.MonsterFilter) ([]*models.Monster, error)
+	CreateMonster(ctx context.Context, input *models.CreateMonsterInput) (int64, error)
+	UpdateMonster(ctx context.Context, id int64, input *models.UpdateMonsterInput) error
+	DeleteMonster(ctx context.Context, id int64) error
+}
+
+type SQLCMonsterRepository struct {
+	db *sql.DB
+	q  *sqlcdb.Queries
+}
+
+func NewSQLCMonsterRepository(db *sql.DB) *SQLCMonsterRepository {
+	return &SQLCMonsterRepository{
+		db: db,
+		q:  sqlcdb.New(db),
+	}
+}
+
+func (r *SQLCMonsterRepository) GetMonster(ctx context.Context, id int64) (*models.Monster, error) {
+	monster, err := r.q.GetMonster(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("monster", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	return mapDbMonsterToModel(monster), nil
+}
+
+func (r *SQLCMonsterRepository) GetMonsterByName(ctx context.Context, name string) (*models.Monster, error) {
+	monster, err := r.q.GetMonsterByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("monster", name)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	return mapDbMonsterToModel(monster), nil
+}
+
+func (r *SQLCMonsterRepository) ListMonsters(ctx context.Context) ([]*models.Monster, error) {
+	monsters, err := r.q.ListMonsters(ctx)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	return mapDbMonstersToModels(monsters), nil
+}
+
+func (r *SQLCMonsterRepository) SearchMonsters(ctx context.Context, filter *models.MonsterFilter) ([]*models.Monster, error) {
+	maxHD := filter.MaxHD
+	if maxHD == 0 {
+		maxHD = models.MaxMonsterHitDice
+	}
+	monsters, err := r.q.SearchMonsters(ctx, sqlcdb.SearchMonstersParams{
+		Name:    "%" + filter.Name + "%",
+		Terrain: "%" + filter.Terrain + "%",
+		MinHd:   int64(filter.MinHD),
+		MaxHd:   int64(maxHD),
+	})
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	return mapDbMonstersToModels(monsters), nil
+}
+
+func (r *SQLCMonsterRepository) CreateMonster(ctx context.Context, input *models.CreateMonsterInput) (int64, error) {
+	hitDiceLevel, err := models.HitDiceLevel(input.HitDice)
+	if err != nil {
+		return 0, apperrors.NewValidationError("hit_dice", err.Error())
+	}
+
+	result, err := r.q.CreateMonster(ctx, sqlcdb.CreateMonsterParams{
+		Name:             input.Name,
+		Description:      input.Description,
+		HitDice:          input.HitDice,
+		HitDiceLevel:     int64(hitDiceLevel),
+		ArmorClass:       int64(input.ArmorClass),
+		Movement:         input.Movement,
+		Attacks:          input.Attacks,
+		Damage:           input.Damage,
+		SavingThrow:      int64(input.SavingThrow),
+		Morale:           int64(input.Morale),
+		XpValue:          int64(input.XPValue),
+		TreasureClass:    input.TreasureClass,
+		SpecialAbilities: input.SpecialAbilities,
+		Terrain:          input.Terrain,
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return id, nil
+}
+
+func (r *SQLCMonsterRepository) UpdateMonster(ctx context.Context, id int64, input *models.UpdateMonsterInput) error {
+	_, err := r.GetMonster(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	hitDiceLevel, err := models.HitDiceLevel(input.HitDice)
+	if err != nil {
+		return apperrors.NewValidationError("hit_dice", err.Error())
+	}
+
+	_, err = r.q.UpdateMonster(ctx, sqlcdb.UpdateMonsterParams{
+		Name:             input.Name,
+		Description:      input.Description,
+		HitDice:          input.HitDice,
+		HitDiceLevel:     int64(hitDiceLevel),
+		ArmorClass:       int64(input.ArmorClass),
+		Movement:         input.Movement,
+		Attacks:          input.Attacks,
+		Damage:           input.Damage,
+		SavingThrow:      int64(input.SavingThrow),
+		Morale:           int64(input.Morale),
+		XpValue:          int64(input.XPValue),
+		TreasureClass:    input.TreasureClass,
+		SpecialAbilities: input.SpecialAbilities,
+		Terrain:          input.Terrain,
+		ID:               id,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *SQLCMonsterRepository) DeleteMonster(ctx context.Context, id int64) error {
+	_, err := r.GetMonster(ctx, id)
+	if err != nil {
+		return err
+	}
+	_, err = r.q.DeleteMonster(ctx, id)
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func mapDbMonstersToModels(monsters []sqlcdb.Monster) []*models.Monster {
+	result := make([]*models.Monster, len(monsters))
+	for i, monster := range monsters {
+		result[i] = mapDbMonsterToModel(monster)
+	}
+	return result
+}
+
+func mapDbMonsterToModel(monster sqlcdb.Monster) *models.Monster {
+	return &models.Monster{
+		ID:               monster.ID,
+		Name:             monster.Name,
+		Description:      monster.Description,
+		HitDice:          monster.HitDice,
+		HitDiceLevel:     int(monster.HitDiceLevel),
+		ArmorClass:       int(monster.ArmorClass),
+		Movement:         monster.Movement,
+		Attacks:          monster.Attacks,
+		Damage:           monster.Damage,
+		SavingThrow:      int(monster.SavingThrow),
+		Morale:           int(monster.Morale),
+		XPValue:          int(monster.XpValue),
+		TreasureClass:    monster.TreasureClass,
+		SpecialAbilities: monster.SpecialAbilities,
+		Terrain:          monster.Terrain,
+		CreatedAt:        monster.CreatedAt,
+		UpdatedAt:        monster.UpdatedAt,
+	}
+}
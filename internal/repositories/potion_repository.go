@@ -66,10 +66,13 @@ func (r *SQLCPotionRepository) ListPotions(ctx context.Context) ([]*models.Potio
 
 func (r *SQLCPotionRepository) CreatePotion(ctx context.Context, input *models.CreatePotionInput) (int64, error) {
 	result, err := r.q.CreatePotion(ctx, sqlcdb.CreatePotionParams{
-		Name:        input.Name,
-		Description: input.Description,
-		Uses:        int64(input.Uses),
-		Weight:      int64(input.Weight),
+		Name:            input.Name,
+		Description:     input.Description,
+		Uses:            int64(input.Uses),
+		Weight:          int64(input.Weight),
+		EffectAttribute: sql.NullString{String: input.EffectAttribute, Valid: input.EffectAttribute != ""},
+		EffectScore:     nullInt64FromPtr(input.EffectScore),
+		EffectDuration:  sql.NullString{String: input.EffectDuration, Valid: input.EffectDuration != ""},
 	})
 	if err != nil {
 		return 0, apperrors.NewDatabaseError(err)
@@ -87,11 +90,14 @@ func (r *SQLCPotionRepository) UpdatePotion(ctx context.Context, id int64, input
 		return err
 	}
 	_, err = r.q.UpdatePotion(ctx, sqlcdb.UpdatePotionParams{
-		Name:        input.Name,
-		Description: input.Description,
-		Uses:        int64(input.Uses),
-		Weight:      int64(input.Weight),
-		ID:          id,
+		Name:            input.Name,
+		Description:     input.Description,
+		Uses:            int64(input.Uses),
+		Weight:          int64(input.Weight),
+		EffectAttribute: sql.NullString{String: input.EffectAttribute, Valid: input.EffectAttribute != ""},
+		EffectScore:     nullInt64FromPtr(input.EffectScore),
+		EffectDuration:  sql.NullString{String: input.EffectDuration, Valid: input.EffectDuration != ""},
+		ID:              id,
 	})
 	if err != nil {
 		return apperrors.NewDatabaseError(err)
@@ -113,12 +119,15 @@ func (r *SQLCPotionRepository) DeletePotion(ctx context.Context, id int64) error
 
 func mapDbPotionToModel(potion sqlcdb.Potion) *models.Potion {
 	return &models.Potion{
-		ID:          potion.ID,
-		Name:        potion.Name,
-		Description: potion.Description,
-		Uses:        int(potion.Uses),
-		Weight:      int(potion.Weight),
-		CreatedAt:   potion.CreatedAt,
-		UpdatedAt:   potion.UpdatedAt,
+		ID:              potion.ID,
+		Name:            potion.Name,
+		Description:     potion.Description,
+		Uses:            int(potion.Uses),
+		Weight:          int(potion.Weight),
+		EffectAttribute: potion.EffectAttribute.String,
+		EffectScore:     intPtrFromNullInt64(potion.EffectScore),
+		EffectDuration:  potion.EffectDuration.String,
+		CreatedAt:       potion.CreatedAt,
+		UpdatedAt:       potion.UpdatedAt,
 	}
 }
@@ -70,6 +70,7 @@ func (r *SQLCRingRepository) CreateRing(ctx context.Context, input *models.Creat
 		Description: input.Description,
 		Cost:        input.Cost,
 		Weight:      int64(input.Weight),
+		SaveBonus:   int64(input.SaveBonus),
 	})
 	if err != nil {
 		return 0, apperrors.NewDatabaseError(err)
@@ -91,6 +92,7 @@ func (r *SQLCRingRepository) UpdateRing(ctx context.Context, id int64, input *mo
 		Description: input.Description,
 		Cost:        input.Cost,
 		Weight:      int64(input.Weight),
+		SaveBonus:   int64(input.SaveBonus),
 		ID:          id,
 	})
 	if err != nil {
@@ -118,6 +120,7 @@ func mapDbRingToModel(ring sqlcdb.Ring) *models.Ring {
 		Description: ring.Description,
 		Cost:        ring.Cost,
 		Weight:      int(ring.Weight),
+		SaveBonus:   int(ring.SaveBonus),
 		CreatedAt:   ring.CreatedAt,
 		UpdatedAt:   ring.UpdatedAt,
 	}
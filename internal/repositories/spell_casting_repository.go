@@ -24,9 +24,15 @@ type SpellCastingRepository interface {
 	GetPreparedSpells(ctx context.Context, characterID int64) ([]models.PreparedSpell, error)
 	GetPreparedSpellsByClass(ctx context.Context, characterID int64, spellClass string) ([]models.PreparedSpell, error)
 	PrepareSpell(ctx context.Context, input *models.PrepareSpellInput) (int64, error)
-	UnprepareSpell(ctx context.Context, characterID, spellID int64) error
+	UnprepareSpell(ctx context.Context, input *models.UnprepareSpellInput) error
 	ClearPreparedSpells(ctx context.Context, characterID int64) error
 
+	// Casting and recovery
+	CastSpell(ctx context.Context, input *models.CastSpellInput) (int64, error)
+	GetSpellCast(ctx context.Context, id int64) (*models.SpellCast, error)
+	GetSpellCasts(ctx context.Context, characterID int64) ([]*models.SpellCast, error)
+	RestorePreparedSpells(ctx context.Context, characterID int64, preparedSpellIDs []int64) error
+
 	// Spell slot and limit information
 	GetSpellSlots(ctx context.Context, characterID int64) (map[string]int, error)
 	GetMaxKnownSpells(ctx context.Context, characterID int64, spellClass string) (map[string]int, error)
@@ -211,17 +217,7 @@ func (r *SQLCSpellCastingRepository) GetPreparedSpells(ctx context.Context, char
 
 	result := make([]models.PreparedSpell, len(preparedSpells))
 	for i, spell := range preparedSpells {
-		result[i] = models.PreparedSpell{
-			ID:          spell.ID,
-			CharacterID: spell.CharacterID,
-			SpellID:     spell.SpellID,
-			SpellName:   spell.SpellName,
-			SpellLevel:  int(spell.SpellLevel),
-			SpellClass:  spell.SpellClass,
-			SlotIndex:   int(spell.SlotIndex),
-			CreatedAt:   spell.CreatedAt,
-			UpdatedAt:   spell.UpdatedAt,
-		}
+		result[i] = mapDbPreparedSpellToModel(spell)
 	}
 
 	return result, nil
@@ -239,23 +235,14 @@ func (r *SQLCSpellCastingRepository) GetPreparedSpellsByClass(ctx context.Contex
 
 	result := make([]models.PreparedSpell, len(preparedSpells))
 	for i, spell := range preparedSpells {
-		result[i] = models.PreparedSpell{
-			ID:          spell.ID,
-			CharacterID: spell.CharacterID,
-			SpellID:     spell.SpellID,
-			SpellName:   spell.SpellName,
-			SpellLevel:  int(spell.SpellLevel),
-			SpellClass:  spell.SpellClass,
-			SlotIndex:   int(spell.SlotIndex),
-			CreatedAt:   spell.CreatedAt,
-			UpdatedAt:   spell.UpdatedAt,
-		}
+		result[i] = mapDbPreparedSpellToModel(spell)
 	}
 
 	return result, nil
 }
 
-// PrepareSpell prepares a spell for a character
+// PrepareSpell prepares a spell in the next free slot of its level. Slot
+// counts are enforced by the caller.
 func (r *SQLCSpellCastingRepository) PrepareSpell(ctx context.Context, input *models.PrepareSpellInput) (int64, error) {
 	// Begin transaction
 	tx, err := r.db.BeginTx(ctx, nil)
@@ -278,100 +265,10 @@ func (r *SQLCSpellCastingRepository) PrepareSpell(ctx context.Context, input *mo
 		return 0, apperrors.NewDatabaseError(err)
 	}
 
-	// Check if character has available slots for this spell level and class
-	preparedCount, err := qtx.CountPreparedSpellsByLevelAndClass(ctx, sqlcdb.CountPreparedSpellsByLevelAndClassParams{
-		CharacterID: input.CharacterID,
-		SpellLevel:  int64(input.SpellLevel),
-		SpellClass:  input.SpellClass,
-	})
-	if err != nil {
-		return 0, apperrors.NewDatabaseError(err)
-	}
-
-	// Get the character's spell slots from class_data or merged from multiple sources
-	character, err := qtx.GetCharacter(ctx, input.CharacterID)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return 0, apperrors.NewNotFound("character", input.CharacterID)
-		}
-		return 0, apperrors.NewDatabaseError(err)
-	}
-
-	classData, err := qtx.GetClassData(ctx, sqlcdb.GetClassDataParams{
-		ClassName: character.Class,
-		Level:     int64(character.Level),
-	})
-	if err != nil {
-		return 0, apperrors.NewDatabaseError(err)
-	}
-
-	var availableSlots int64
-	switch input.SpellLevel {
-	case 1:
-		if classData.SpellSlotsLevel1.Valid {
-			availableSlots = classData.SpellSlotsLevel1.Int64
-		}
-	case 2:
-		if classData.SpellSlotsLevel2.Valid {
-			availableSlots = classData.SpellSlotsLevel2.Int64
-		}
-	case 3:
-		if classData.SpellSlotsLevel3.Valid {
-			availableSlots = classData.SpellSlotsLevel3.Int64
-		}
-	case 4:
-		if classData.SpellSlotsLevel4.Valid {
-			availableSlots = classData.SpellSlotsLevel4.Int64
-		}
-	case 5:
-		if classData.SpellSlotsLevel5.Valid {
-			availableSlots = classData.SpellSlotsLevel5.Int64
-		}
-	case 6:
-		if classData.SpellSlotsLevel6.Valid {
-			availableSlots = classData.SpellSlotsLevel6.Int64
-		}
-	default:
-		return 0, apperrors.NewValidationError("spell_level", "Invalid spell level")
-	}
-
-	// Add bonus slots based on relevant attribute (Wisdom for clerics, Intelligence for magicians)
-	bonusSlots := int64(0)
-	if input.SpellClass == "Cleric" || input.SpellClass == "Druid" || input.SpellClass == "Priest" {
-		// Apply Wisdom bonus for divine casters
-		if character.Wisdom >= 13 && character.Wisdom <= 14 && input.SpellLevel == 1 {
-			bonusSlots = 1
-		} else if character.Wisdom >= 15 && character.Wisdom <= 16 && input.SpellLevel <= 2 {
-			bonusSlots = 1
-		} else if character.Wisdom == 17 && input.SpellLevel <= 3 {
-			bonusSlots = 1
-		} else if character.Wisdom == 18 && input.SpellLevel <= 4 {
-			bonusSlots = 1
-		}
-	} else if input.SpellClass == "Magician" || input.SpellClass == "Illusionist" || input.SpellClass == "Necromancer" {
-		// Apply Intelligence bonus for arcane casters
-		if character.Intelligence >= 13 && character.Intelligence <= 14 && input.SpellLevel == 1 {
-			bonusSlots = 1
-		} else if character.Intelligence >= 15 && character.Intelligence <= 16 && input.SpellLevel <= 2 {
-			bonusSlots = 1
-		} else if character.Intelligence == 17 && input.SpellLevel <= 3 {
-			bonusSlots = 1
-		} else if character.Intelligence == 18 && input.SpellLevel <= 4 {
-			bonusSlots = 1
-		}
-	}
-
-	// Total available slots is base slots plus bonus slots
-	totalAvailableSlots := availableSlots + bonusSlots
-
-	if preparedCount >= totalAvailableSlots {
-		return 0, apperrors.NewValidationError("spell_level", fmt.Sprintf("No available slots for level %d %s spells", input.SpellLevel, input.SpellClass))
-	}
-
-	// Find the next available slot index
+	// Find the lowest free slot; the same spell may already fill other slots
 	nextSlotIndex, err := qtx.GetNextAvailableSlotIndex(ctx, sqlcdb.GetNextAvailableSlotIndexParams{
 		CharacterID: input.CharacterID,
-		SpellLevel:  int64(input.SpellLevel),
+		SpellLevel:  knownSpell.SpellLevel,
 		SpellClass:  input.SpellClass,
 	})
 	if err != nil {
@@ -413,8 +310,9 @@ func (r *SQLCSpellCastingRepository) PrepareSpell(ctx context.Context, input *mo
 	return id, nil
 }
 
-// UnprepareSpell removes a prepared spell
-func (r *SQLCSpellCastingRepository) UnprepareSpell(ctx context.Context, characterID, spellID int64) error {
+// UnprepareSpell frees one prepared slot. A zero SlotIndex frees the most
+// recently filled slot holding the spell.
+func (r *SQLCSpellCastingRepository) UnprepareSpell(ctx context.Context, input *models.UnprepareSpellInput) error {
 	// Begin transaction
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -425,13 +323,22 @@ func (r *SQLCSpellCastingRepository) UnprepareSpell(ctx context.Context, charact
 	qtx := r.q.WithTx(tx)
 
 	// Get the prepared spell
-	preparedSpell, err := qtx.GetPreparedSpellByCharacterAndSpell(ctx, sqlcdb.GetPreparedSpellByCharacterAndSpellParams{
-		CharacterID: characterID,
-		SpellID:     spellID,
-	})
+	var preparedSpell sqlcdb.PreparedSpell
+	if input.SlotIndex > 0 {
+		preparedSpell, err = qtx.GetPreparedSpellBySlot(ctx, sqlcdb.GetPreparedSpellBySlotParams{
+			CharacterID: input.CharacterID,
+			SpellID:     input.SpellID,
+			SlotIndex:   int64(input.SlotIndex),
+		})
+	} else {
+		preparedSpell, err = qtx.GetPreparedSpellByCharacterAndSpell(ctx, sqlcdb.GetPreparedSpellByCharacterAndSpellParams{
+			CharacterID: input.CharacterID,
+			SpellID:     input.SpellID,
+		})
+	}
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return apperrors.NewNotFound("prepared spell", fmt.Sprintf("character %d spell %d", characterID, spellID))
+			return apperrors.NewNotFound("prepared spell", fmt.Sprintf("character %d spell %d", input.CharacterID, input.SpellID))
 		}
 		return apperrors.NewDatabaseError(err)
 	}
@@ -442,16 +349,26 @@ func (r *SQLCSpellCastingRepository) UnprepareSpell(ctx context.Context, charact
 		return apperrors.NewDatabaseError(err)
 	}
 
-	// Mark the spell as not memorized in known_spells
-	err = qtx.MarkSpellAsMemorizedBySpellID(ctx, sqlcdb.MarkSpellAsMemorizedBySpellIDParams{
-		IsMemorized: false,
-		CharacterID: characterID,
-		SpellID:     spellID,
+	// The spell stays memorized while another slot still holds it
+	remaining, err := qtx.CountPreparedSpellInstances(ctx, sqlcdb.CountPreparedSpellInstancesParams{
+		CharacterID: input.CharacterID,
+		SpellID:     input.SpellID,
 	})
 	if err != nil {
 		return apperrors.NewDatabaseError(err)
 	}
 
+	if remaining == 0 {
+		err = qtx.MarkSpellAsMemorizedBySpellID(ctx, sqlcdb.MarkSpellAsMemorizedBySpellIDParams{
+			IsMemorized: false,
+			CharacterID: input.CharacterID,
+			SpellID:     input.SpellID,
+		})
+		if err != nil {
+			return apperrors.NewDatabaseError(err)
+		}
+	}
+
 	// Commit transaction
 	if err := tx.Commit(); err != nil {
 		return apperrors.NewDatabaseError(err)
@@ -491,6 +408,134 @@ func (r *SQLCSpellCastingRepository) ClearPreparedSpells(ctx context.Context, ch
 	return nil
 }
 
+// CastSpell expends a prepared slot and logs the casting
+func (r *SQLCSpellCastingRepository) CastSpell(ctx context.Context, input *models.CastSpellInput) (int64, error) {
+	// Begin transaction
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	// Find the prepared slot to expend
+	var preparedSpell sqlcdb.PreparedSpell
+	if input.PreparedSpellID > 0 {
+		preparedSpell, err = qtx.GetPreparedSpell(ctx, sqlcdb.GetPreparedSpellParams{
+			ID:          input.PreparedSpellID,
+			CharacterID: input.CharacterID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, apperrors.NewNotFound("prepared spell", input.PreparedSpellID)
+		}
+	} else {
+		preparedSpell, err = qtx.GetNextUnexpendedPreparedSpell(ctx, sqlcdb.GetNextUnexpendedPreparedSpellParams{
+			CharacterID: input.CharacterID,
+			SpellID:     input.SpellID,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, apperrors.NewValidationError("spell_id", "No unexpended prepared slot holds this spell")
+		}
+	}
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	if preparedSpell.ExpendedAt.Valid {
+		return 0, apperrors.NewValidationError("prepared_spell_id", "This prepared spell has already been cast; rest to recover it")
+	}
+
+	err = qtx.ExpendPreparedSpell(ctx, preparedSpell.ID)
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+
+	result, err := qtx.CreateSpellCast(ctx, sqlcdb.CreateSpellCastParams{
+		CharacterID:     input.CharacterID,
+		PreparedSpellID: sql.NullInt64{Int64: preparedSpell.ID, Valid: true},
+		SpellID:         preparedSpell.SpellID,
+		SpellName:       preparedSpell.SpellName,
+		SpellLevel:      preparedSpell.SpellLevel,
+		SpellClass:      preparedSpell.SpellClass,
+		Target:          input.Target,
+		Round:           nullInt64FromPtr(input.Round),
+		Notes:           input.Notes,
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+
+	return id, nil
+}
+
+// GetSpellCast retrieves a logged casting
+func (r *SQLCSpellCastingRepository) GetSpellCast(ctx context.Context, id int64) (*models.SpellCast, error) {
+	cast, err := r.q.GetSpellCast(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("spell cast", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	return mapDbSpellCastToModel(cast), nil
+}
+
+// GetSpellCasts retrieves a character's castings, most recent first
+func (r *SQLCSpellCastingRepository) GetSpellCasts(ctx context.Context, characterID int64) ([]*models.SpellCast, error) {
+	casts, err := r.q.GetSpellCastsByCharacter(ctx, characterID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := make([]*models.SpellCast, len(casts))
+	for i, cast := range casts {
+		result[i] = mapDbSpellCastToModel(cast)
+	}
+	return result, nil
+}
+
+// RestorePreparedSpells makes expended prepared spells castable again
+func (r *SQLCSpellCastingRepository) RestorePreparedSpells(ctx context.Context, characterID int64, preparedSpellIDs []int64) error {
+	// Begin transaction
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	for _, id := range preparedSpellIDs {
+		// Make sure the slot belongs to the character
+		if _, err := qtx.GetPreparedSpell(ctx, sqlcdb.GetPreparedSpellParams{ID: id, CharacterID: characterID}); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return apperrors.NewNotFound("prepared spell", id)
+			}
+			return apperrors.NewDatabaseError(err)
+		}
+		if err := qtx.RestorePreparedSpell(ctx, id); err != nil {
+			return apperrors.NewDatabaseError(err)
+		}
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+
+	return nil
+}
+
 // GetSpellSlots retrieves the available spell slots for a character
 func (r *SQLCSpellCastingRepository) GetSpellSlots(ctx context.Context, characterID int64) (map[string]int, error) {
 	// Get character class and level
@@ -526,6 +571,49 @@ func (r *SQLCSpellCastingRepository) GetSpellSlots(ctx context.Context, characte
 	return spellSlots, nil
 }
 
+func mapDbPreparedSpellToModel(spell sqlcdb.PreparedSpell) models.PreparedSpell {
+	preparedSpell := models.PreparedSpell{
+		ID:          spell.ID,
+		CharacterID: spell.CharacterID,
+		SpellID:     spell.SpellID,
+		SpellName:   spell.SpellName,
+		SpellLevel:  int(spell.SpellLevel),
+		SpellClass:  spell.SpellClass,
+		SlotIndex:   int(spell.SlotIndex),
+		Expended:    spell.ExpendedAt.Valid,
+		CreatedAt:   spell.CreatedAt,
+		UpdatedAt:   spell.UpdatedAt,
+	}
+	if spell.ExpendedAt.Valid {
+		expendedAt := spell.ExpendedAt.Time
+		preparedSpell.ExpendedAt = &expendedAt
+	}
+	return preparedSpell
+}
+
+func mapDbSpellCastToModel(cast sqlcdb.SpellCast) *models.SpellCast {
+	spellCast := &models.SpellCast{
+		ID:          cast.ID,
+		CharacterID: cast.CharacterID,
+		SpellID:     cast.SpellID,
+		SpellName:   cast.SpellName,
+		SpellLevel:  int(cast.SpellLevel),
+		SpellClass:  cast.SpellClass,
+		Target:      cast.Target,
+		Notes:       cast.Notes,
+		CastAt:      cast.CastAt,
+	}
+	if cast.PreparedSpellID.Valid {
+		preparedSpellID := cast.PreparedSpellID.Int64
+		spellCast.PreparedSpellID = &preparedSpellID
+	}
+	if cast.Round.Valid {
+		round := int(cast.Round.Int64)
+		spellCast.Round = &round
+	}
+	return spellCast
+}
+
 // Helper function to safely extract int value from sql.NullInt64
 func getIntValue(n sql.NullInt64) int {
 	if n.Valid {
@@ -0,0 +1,195 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	sqlcdb "mordezzanV4/internal/repositories/db/sqlc"
+)
+
+type SpellLearningRepository interface {
+	// GetSpellScrollItem retrieves a spell scroll from the character's inventory
+	GetSpellScrollItem(ctx context.Context, characterID, itemID int64) (*models.InventoryItem, error)
+	// HasFailedAttempt reports whether the character already failed to learn
+	// the spell at the given level
+	HasFailedAttempt(ctx context.Context, characterID, spellID int64, characterLevel int) (bool, error)
+	// RecordAttempt stores an attempt. A successful one also adds the spell to
+	// the character's known spells, scribes it into spellbookID when non-zero
+	// and uses up one scroll from scrollItemID when non-zero.
+	RecordAttempt(ctx context.Context, attempt *models.SpellLearningAttempt, scrollItemID, spellbookID int64) (int64, error)
+	GetAttempt(ctx context.Context, id int64) (*models.SpellLearningAttempt, error)
+	GetAttempts(ctx context.Context, characterID int64) ([]*models.SpellLearningAttempt, error)
+}
+
+type SQLCSpellLearningRepository struct {
+	db *sql.DB
+	q  *sqlcdb.Queries
+}
+
+func NewSQLCSpellLearningRepository(db *sql.DB) *SQLCSpellLearningRepository {
+	return &SQLCSpellLearningRepository{
+		db: db,
+		q:  sqlcdb.New(db),
+	}
+}
+
+func (r *SQLCSpellLearningRepository) GetSpellScrollItem(ctx context.Context, characterID, itemID int64) (*models.InventoryItem, error) {
+	item, err := r.q.GetCharacterSpellScrollItem(ctx, sqlcdb.GetCharacterSpellScrollItemParams{
+		ID:          itemID,
+		CharacterID: characterID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("spell scroll", itemID)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	return &models.InventoryItem{
+		ID:            item.ID,
+		InventoryID:   item.InventoryID,
+		ItemType:      item.ItemType,
+		ItemID:        item.ItemID,
+		Quantity:      int(item.Quantity),
+		IsEquipped:    item.IsEquipped,
+		Notes:         item.Notes.String,
+		CreatedAt:     item.CreatedAt,
+		UpdatedAt:     item.UpdatedAt,
+		Charges:       intPtrFromNullInt64(item.Charges),
+		UsesRemaining: intPtrFromNullInt64(item.UsesRemaining),
+	}, nil
+}
+
+func (r *SQLCSpellLearningRepository) HasFailedAttempt(ctx context.Context, characterID, spellID int64, characterLevel int) (bool, error) {
+	_, err := r.q.GetFailedSpellLearningAttempt(ctx, sqlcdb.GetFailedSpellLearningAttemptParams{
+		CharacterID:    characterID,
+		SpellID:        spellID,
+		CharacterLevel: int64(characterLevel),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, apperrors.NewDatabaseError(err)
+	}
+	return true, nil
+}
+
+func (r *SQLCSpellLearningRepository) RecordAttempt(ctx context.Context, attempt *models.SpellLearningAttempt, scrollItemID, spellbookID int64) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	result, err := qtx.CreateSpellLearningAttempt(ctx, sqlcdb.CreateSpellLearningAttemptParams{
+		CharacterID:    attempt.CharacterID,
+		SpellID:        attempt.SpellID,
+		SpellName:      attempt.SpellName,
+		SpellLevel:     int64(attempt.SpellLevel),
+		SpellClass:     attempt.SpellClass,
+		SourceType:     attempt.SourceType,
+		SourceID:       attempt.SourceID,
+		CharacterLevel: int64(attempt.CharacterLevel),
+		Roll:           int64(attempt.Roll),
+		Chance:         int64(attempt.Chance),
+		Success:        attempt.Success,
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+
+	if attempt.Success {
+		_, err = qtx.AddKnownSpell(ctx, sqlcdb.AddKnownSpellParams{
+			CharacterID: attempt.CharacterID,
+			SpellID:     attempt.SpellID,
+			SpellName:   attempt.SpellName,
+			SpellLevel:  int64(attempt.SpellLevel),
+			SpellClass:  attempt.SpellClass,
+			Notes: sql.NullString{
+				String: fmt.Sprintf("Learned from %s at level %d", attempt.SourceType, attempt.CharacterLevel),
+				Valid:  true,
+			},
+		})
+		if err != nil {
+			return 0, apperrors.NewDatabaseError(err)
+		}
+
+		if spellbookID != 0 {
+			err = scribeSpell(ctx, qtx, &models.SpellbookSpell{
+				SpellbookID: spellbookID,
+				SpellID:     attempt.SpellID,
+				SpellName:   attempt.SpellName,
+				SpellLevel:  attempt.SpellLevel,
+				SpellClass:  attempt.SpellClass,
+				Pages:       models.SpellbookPageCost(attempt.SpellLevel),
+			})
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		if scrollItemID != 0 {
+			if err := consumeInventoryItem(ctx, qtx, scrollItemID); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return id, nil
+}
+
+func (r *SQLCSpellLearningRepository) GetAttempt(ctx context.Context, id int64) (*models.SpellLearningAttempt, error) {
+	attempt, err := r.q.GetSpellLearningAttempt(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("spell learning attempt", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	return mapDbSpellLearningAttemptToModel(attempt), nil
+}
+
+func (r *SQLCSpellLearningRepository) GetAttempts(ctx context.Context, characterID int64) ([]*models.SpellLearningAttempt, error) {
+	attempts, err := r.q.GetSpellLearningAttemptsByCharacter(ctx, characterID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := make([]*models.SpellLearningAttempt, len(attempts))
+	for i, attempt := range attempts {
+		result[i] = mapDbSpellLearningAttemptToModel(attempt)
+	}
+	return result, nil
+}
+
+func mapDbSpellLearningAttemptToModel(attempt sqlcdb.SpellLearningAttempt) *models.SpellLearningAttempt {
+	return &models.SpellLearningAttempt{
+		ID:             attempt.ID,
+		CharacterID:    attempt.CharacterID,
+		SpellID:        attempt.SpellID,
+		SpellName:      attempt.SpellName,
+		SpellLevel:     int(attempt.SpellLevel),
+		SpellClass:     attempt.SpellClass,
+		SourceType:     attempt.SourceType,
+		SourceID:       attempt.SourceID,
+		CharacterLevel: int(attempt.CharacterLevel),
+		Roll:           int(attempt.Roll),
+		Chance:         int(attempt.Chance),
+		Success:        attempt.Success,
+		CreatedAt:      attempt.CreatedAt,
+	}
+}
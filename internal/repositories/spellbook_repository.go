@@ -0,0 +1,352 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	sqlcdb "mordezzanV4/internal/repositories/db/sqlc"
+)
+
+type SpellbookRepository interface {
+	// GetSpellbook retrieves a book by id regardless of who carries it
+	GetSpellbook(ctx context.Context, id int64) (*models.Spellbook, error)
+	// GetSpellbookOwner returns the id of the character carrying the book
+	GetSpellbookOwner(ctx context.Context, id int64) (int64, error)
+	GetCharacterSpellbook(ctx context.Context, characterID, id int64) (*models.Spellbook, error)
+	ListCharacterSpellbooks(ctx context.Context, characterID int64) ([]*models.Spellbook, error)
+	// CreateSpellbook creates a book and adds it to the character's inventory
+	CreateSpellbook(ctx context.Context, characterID int64, input *models.CreateSpellbookInput) (int64, error)
+	// AddSpell scribes a spell into a book, failing if its pages are full
+	AddSpell(ctx context.Context, spell *models.SpellbookSpell) error
+	RemoveSpell(ctx context.Context, spellbookID, spellID int64) error
+	// HasSpellInCarriedSpellbook reports whether any book in the character's
+	// inventory contains the spell
+	HasSpellInCarriedSpellbook(ctx context.Context, characterID, spellID int64) (bool, error)
+}
+
+type SQLCSpellbookRepository struct {
+	db *sql.DB
+	q  *sqlcdb.Queries
+}
+
+func NewSQLCSpellbookRepository(db *sql.DB) *SQLCSpellbookRepository {
+	return &SQLCSpellbookRepository{
+		db: db,
+		q:  sqlcdb.New(db),
+	}
+}
+
+func (r *SQLCSpellbookRepository) GetSpellbook(ctx context.Context, id int64) (*models.Spellbook, error) {
+	book, err := r.q.GetSpellbook(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("spellbook", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := &models.Spellbook{
+		ID:           book.ID,
+		Name:         book.Name,
+		Description:  book.Description.String,
+		TotalPages:   int(book.TotalPages),
+		UsedPages:    int(book.UsedPages),
+		FreePages:    int(book.TotalPages - book.UsedPages),
+		Value:        int(book.Value),
+		Weight:       book.Weight,
+		IsTravelling: book.IsTravelling,
+		CreatedAt:    book.CreatedAt,
+		UpdatedAt:    book.UpdatedAt,
+	}
+	if err := r.loadSpells(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *SQLCSpellbookRepository) GetSpellbookOwner(ctx context.Context, id int64) (int64, error) {
+	characterID, err := r.q.GetSpellbookOwner(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, apperrors.NewNotFound("spellbook", id)
+		}
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return characterID, nil
+}
+
+func (r *SQLCSpellbookRepository) GetCharacterSpellbook(ctx context.Context, characterID, id int64) (*models.Spellbook, error) {
+	book, err := r.q.GetSpellbookForCharacter(ctx, sqlcdb.GetSpellbookForCharacterParams{
+		ID:          id,
+		CharacterID: characterID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("spellbook", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := mapDbCharacterSpellbookToModel(sqlcdb.GetSpellbooksByCharacterRow(book))
+	if err := r.loadSpells(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *SQLCSpellbookRepository) ListCharacterSpellbooks(ctx context.Context, characterID int64) ([]*models.Spellbook, error) {
+	books, err := r.q.GetSpellbooksByCharacter(ctx, characterID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := make([]*models.Spellbook, len(books))
+	for i, book := range books {
+		result[i] = mapDbCharacterSpellbookToModel(book)
+		if err := r.loadSpells(ctx, result[i]); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (r *SQLCSpellbookRepository) CreateSpellbook(ctx context.Context, characterID int64, input *models.CreateSpellbookInput) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	// Characters get an inventory the first time they need one
+	var inventoryID int64
+	inventory, err := qtx.GetInventoryByCharacter(ctx, characterID)
+	if err == nil {
+		inventoryID = inventory.ID
+	} else if errors.Is(err, sql.ErrNoRows) {
+		result, err := qtx.CreateInventory(ctx, sqlcdb.CreateInventoryParams{
+			CharacterID: characterID,
+			MaxWeight:   100.0, // Default capacity
+		})
+		if err != nil {
+			return 0, apperrors.NewDatabaseError(err)
+		}
+		inventoryID, err = result.LastInsertId()
+		if err != nil {
+			return 0, apperrors.NewDatabaseError(err)
+		}
+	} else {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+
+	result, err := qtx.CreateSpellbook(ctx, sqlcdb.CreateSpellbookParams{
+		Name:         input.Name,
+		Description:  sql.NullString{String: input.Description, Valid: input.Description != ""},
+		TotalPages:   int64(input.TotalPages),
+		Value:        int64(input.Value),
+		Weight:       input.Weight,
+		IsTravelling: input.IsTravelling,
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+
+	_, err = qtx.AddInventoryItem(ctx, sqlcdb.AddInventoryItemParams{
+		InventoryID: inventoryID,
+		ItemType:    models.SpellbookItemType,
+		ItemID:      id,
+		Quantity:    1,
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+
+	if err := qtx.RecalculateInventoryWeight(ctx, inventoryID); err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return id, nil
+}
+
+func (r *SQLCSpellbookRepository) AddSpell(ctx context.Context, spell *models.SpellbookSpell) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	defer tx.Rollback()
+
+	if err := scribeSpell(ctx, r.q.WithTx(tx), spell); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *SQLCSpellbookRepository) RemoveSpell(ctx context.Context, spellbookID, spellID int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	book, err := qtx.GetSpellbook(ctx, spellbookID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.NewNotFound("spellbook", spellbookID)
+		}
+		return apperrors.NewDatabaseError(err)
+	}
+
+	entry, err := qtx.GetSpellbookSpell(ctx, sqlcdb.GetSpellbookSpellParams{
+		SpellbookID: spellbookID,
+		SpellID:     spellID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.NewNotFound("spellbook spell", fmt.Sprintf("spellbook %d spell %d", spellbookID, spellID))
+		}
+		return apperrors.NewDatabaseError(err)
+	}
+
+	err = qtx.RemoveSpellbookSpell(ctx, sqlcdb.RemoveSpellbookSpellParams{
+		SpellbookID: spellbookID,
+		SpellID:     spellID,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+
+	usedPages := book.UsedPages - entry.Pages
+	if usedPages < 0 {
+		usedPages = 0
+	}
+	err = qtx.UpdateSpellbookUsedPages(ctx, sqlcdb.UpdateSpellbookUsedPagesParams{
+		UsedPages: usedPages,
+		ID:        spellbookID,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *SQLCSpellbookRepository) HasSpellInCarriedSpellbook(ctx context.Context, characterID, spellID int64) (bool, error) {
+	count, err := r.q.CountCarriedSpellbooksWithSpell(ctx, sqlcdb.CountCarriedSpellbooksWithSpellParams{
+		CharacterID: characterID,
+		SpellID:     spellID,
+	})
+	if err != nil {
+		return false, apperrors.NewDatabaseError(err)
+	}
+	return count > 0, nil
+}
+
+func (r *SQLCSpellbookRepository) loadSpells(ctx context.Context, book *models.Spellbook) error {
+	spells, err := r.q.GetSpellbookSpells(ctx, book.ID)
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+
+	book.Spells = make([]models.SpellbookSpell, len(spells))
+	for i, spell := range spells {
+		book.Spells[i] = models.SpellbookSpell{
+			ID:          spell.ID,
+			SpellbookID: spell.SpellbookID,
+			SpellID:     spell.SpellID,
+			SpellName:   spell.SpellName,
+			SpellLevel:  int(spell.SpellLevel),
+			SpellClass:  spell.SpellClass,
+			Pages:       int(spell.Pages),
+			CreatedAt:   spell.CreatedAt,
+		}
+	}
+	return nil
+}
+
+// scribeSpell adds a spell to a book and fills its pages within the caller's transaction
+func scribeSpell(ctx context.Context, qtx *sqlcdb.Queries, spell *models.SpellbookSpell) error {
+	book, err := qtx.GetSpellbook(ctx, spell.SpellbookID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.NewNotFound("spellbook", spell.SpellbookID)
+		}
+		return apperrors.NewDatabaseError(err)
+	}
+
+	_, err = qtx.GetSpellbookSpell(ctx, sqlcdb.GetSpellbookSpellParams{
+		SpellbookID: spell.SpellbookID,
+		SpellID:     spell.SpellID,
+	})
+	if err == nil {
+		return apperrors.NewConflict(fmt.Sprintf("%s is already scribed in %s", spell.SpellName, book.Name))
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return apperrors.NewDatabaseError(err)
+	}
+
+	usedPages := book.UsedPages + int64(spell.Pages)
+	if usedPages > book.TotalPages {
+		return apperrors.NewValidationError("spell_id", fmt.Sprintf("%s needs %d pages but %s has only %d free",
+			spell.SpellName, spell.Pages, book.Name, book.TotalPages-book.UsedPages))
+	}
+
+	_, err = qtx.AddSpellbookSpell(ctx, sqlcdb.AddSpellbookSpellParams{
+		SpellbookID: spell.SpellbookID,
+		SpellID:     spell.SpellID,
+		SpellName:   spell.SpellName,
+		SpellLevel:  int64(spell.SpellLevel),
+		SpellClass:  spell.SpellClass,
+		Pages:       int64(spell.Pages),
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+
+	err = qtx.UpdateSpellbookUsedPages(ctx, sqlcdb.UpdateSpellbookUsedPagesParams{
+		UsedPages: usedPages,
+		ID:        spell.SpellbookID,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func mapDbCharacterSpellbookToModel(book sqlcdb.GetSpellbooksByCharacterRow) *models.Spellbook {
+	return &models.Spellbook{
+		ID:              book.ID,
+		InventoryItemID: book.InventoryItemID,
+		CharacterID:     book.CharacterID,
+		Name:            book.Name,
+		Description:     book.Description.String,
+		TotalPages:      int(book.TotalPages),
+		UsedPages:       int(book.UsedPages),
+		FreePages:       int(book.TotalPages - book.UsedPages),
+		Value:           int(book.Value),
+		Weight:          book.Weight,
+		IsTravelling:    book.IsTravelling,
+		CreatedAt:       book.CreatedAt,
+		UpdatedAt:       book.UpdatedAt,
+	}
+}
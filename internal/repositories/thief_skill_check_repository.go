@@ -0,0 +1,106 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	sqlcdb "mordezzanV4/internal/repositories/db/sqlc"
+)
+
+type ThiefSkillCheckRepository interface {
+	RecordCheck(ctx context.Context, check *models.ThiefSkillCheck) (int64, error)
+	GetCheck(ctx context.Context, id int64) (*models.ThiefSkillCheck, error)
+	GetChecks(ctx context.Context, characterID int64) ([]*models.ThiefSkillCheck, error)
+}
+
+type SQLCThiefSkillCheckRepository struct {
+	db *sql.DB
+	q  *sqlcdb.Queries
+}
+
+func NewSQLCThiefSkillCheckRepository(db *sql.DB) *SQLCThiefSkillCheckRepository {
+	return &SQLCThiefSkillCheckRepository{
+		db: db,
+		q:  sqlcdb.New(db),
+	}
+}
+
+func (r *SQLCThiefSkillCheckRepository) RecordCheck(ctx context.Context, check *models.ThiefSkillCheck) (int64, error) {
+	modifiers := check.Modifiers
+	if modifiers == nil {
+		modifiers = []models.RollModifier{}
+	}
+	encoded, err := json.Marshal(modifiers)
+	if err != nil {
+		return 0, apperrors.NewInternalError(err)
+	}
+
+	result, err := r.q.CreateThiefSkillCheck(ctx, sqlcdb.CreateThiefSkillCheckParams{
+		CharacterID: check.CharacterID,
+		SkillName:   check.SkillName,
+		BaseChance:  int64(check.BaseChance),
+		Modifiers:   string(encoded),
+		Chance:      int64(check.Chance),
+		Roll:        int64(check.Roll),
+		Success:     check.Success,
+		Notes:       check.Notes,
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return id, nil
+}
+
+func (r *SQLCThiefSkillCheckRepository) GetCheck(ctx context.Context, id int64) (*models.ThiefSkillCheck, error) {
+	check, err := r.q.GetThiefSkillCheck(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("thief skill check", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	return mapDbThiefSkillCheckToModel(check)
+}
+
+func (r *SQLCThiefSkillCheckRepository) GetChecks(ctx context.Context, characterID int64) ([]*models.ThiefSkillCheck, error) {
+	checks, err := r.q.GetThiefSkillChecksByCharacter(ctx, characterID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := make([]*models.ThiefSkillCheck, len(checks))
+	for i, check := range checks {
+		result[i], err = mapDbThiefSkillCheckToModel(check)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func mapDbThiefSkillCheckToModel(check sqlcdb.ThiefSkillCheck) (*models.ThiefSkillCheck, error) {
+	result := &models.ThiefSkillCheck{
+		ID:          check.ID,
+		CharacterID: check.CharacterID,
+		SkillName:   check.SkillName,
+		BaseChance:  int(check.BaseChance),
+		Chance:      int(check.Chance),
+		Roll:        int(check.Roll),
+		Success:     check.Success,
+		Summary:     models.ThiefSkillCheckSummary(check.SkillName, check.Success),
+		Notes:       check.Notes,
+		CreatedAt:   check.CreatedAt,
+	}
+	if err := json.Unmarshal([]byte(check.Modifiers), &result.Modifiers); err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	return result, nil
+}
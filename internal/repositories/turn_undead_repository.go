@@ -0,0 +1,116 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	sqlcdb "mordezzanV4/internal/repositories/db/sqlc"
+)
+
+type TurnUndeadRepository interface {
+	RecordAttempt(ctx context.Context, attempt *models.TurnUndeadAttempt) (int64, error)
+	GetAttempt(ctx context.Context, id int64) (*models.TurnUndeadAttempt, error)
+	GetAttempts(ctx context.Context, characterID int64) ([]*models.TurnUndeadAttempt, error)
+	// CountUsesToday counts the attempts made since the character last rested
+	CountUsesToday(ctx context.Context, characterID int64) (int, error)
+	// RecoverUses restores the character's daily uses, returning how many
+	RecoverUses(ctx context.Context, characterID int64) (int, error)
+}
+
+type SQLCTurnUndeadRepository struct {
+	db *sql.DB
+	q  *sqlcdb.Queries
+}
+
+func NewSQLCTurnUndeadRepository(db *sql.DB) *SQLCTurnUndeadRepository {
+	return &SQLCTurnUndeadRepository{
+		db: db,
+		q:  sqlcdb.New(db),
+	}
+}
+
+func (r *SQLCTurnUndeadRepository) RecordAttempt(ctx context.Context, attempt *models.TurnUndeadAttempt) (int64, error) {
+	result, err := r.q.CreateTurnUndeadAttempt(ctx, sqlcdb.CreateTurnUndeadAttemptParams{
+		CharacterID:    attempt.CharacterID,
+		UndeadType:     int64(attempt.UndeadType),
+		TurningAbility: int64(attempt.TurningAbility),
+		Command:        attempt.Command,
+		Entry:          attempt.Entry,
+		Roll:           int64(attempt.Roll),
+		Chance:         int64(attempt.Chance),
+		Result:         attempt.Result,
+		Affected:       int64(attempt.Affected),
+	})
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return id, nil
+}
+
+func (r *SQLCTurnUndeadRepository) GetAttempt(ctx context.Context, id int64) (*models.TurnUndeadAttempt, error) {
+	attempt, err := r.q.GetTurnUndeadAttempt(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("turn undead attempt", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+	return mapDbTurnUndeadAttemptToModel(attempt), nil
+}
+
+func (r *SQLCTurnUndeadRepository) GetAttempts(ctx context.Context, characterID int64) ([]*models.TurnUndeadAttempt, error) {
+	attempts, err := r.q.GetTurnUndeadAttemptsByCharacter(ctx, characterID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := make([]*models.TurnUndeadAttempt, len(attempts))
+	for i, attempt := range attempts {
+		result[i] = mapDbTurnUndeadAttemptToModel(attempt)
+	}
+	return result, nil
+}
+
+func (r *SQLCTurnUndeadRepository) CountUsesToday(ctx context.Context, characterID int64) (int, error) {
+	count, err := r.q.CountUnrecoveredTurnUndeadAttempts(ctx, characterID)
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return int(count), nil
+}
+
+func (r *SQLCTurnUndeadRepository) RecoverUses(ctx context.Context, characterID int64) (int, error) {
+	result, err := r.q.RecoverTurnUndeadAttempts(ctx, characterID)
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	recovered, err := result.RowsAffected()
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return int(recovered), nil
+}
+
+func mapDbTurnUndeadAttemptToModel(attempt sqlcdb.TurnUndeadAttempt) *models.TurnUndeadAttempt {
+	return &models.TurnUndeadAttempt{
+		ID:             attempt.ID,
+		CharacterID:    attempt.CharacterID,
+		UndeadType:     int(attempt.UndeadType),
+		TurningAbility: int(attempt.TurningAbility),
+		Command:        attempt.Command,
+		Entry:          attempt.Entry,
+		Roll:           int(attempt.Roll),
+		Chance:         int(attempt.Chance),
+		Result:         attempt.Result,
+		Affected:       int(attempt.Affected),
+		Recovered:      attempt.Recovered,
+		CreatedAt:      attempt.CreatedAt,
+	}
+}
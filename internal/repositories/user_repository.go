@@ -22,6 +22,8 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, username, email, passwordHash string) (int64, error)
 	UpdateUser(ctx context.Context, id int64, username, email string) error
 	DeleteUser(ctx context.Context, id int64) error
+	UpdateUserRole(ctx context.Context, id int64, role string) error
+	CountUsersByRole(ctx context.Context, role string) (int64, error)
 }
 
 func NewSQLCUserRepository(db *sql.DB) *SQLCUserRepository {
@@ -45,6 +47,7 @@ func (r *SQLCUserRepository) GetUser(ctx context.Context, id int64) (*models.Use
 		ID:        user.ID,
 		Username:  user.Username,
 		Email:     user.Email,
+		Role:      user.Role,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 	}, nil
@@ -64,6 +67,7 @@ func (r *SQLCUserRepository) GetUserByEmail(ctx context.Context, email string) (
 		ID:           user.ID,
 		Username:     user.Username,
 		Email:        user.Email,
+		Role:         user.Role,
 		PasswordHash: user.PasswordHash,
 		CreatedAt:    user.CreatedAt,
 		UpdatedAt:    user.UpdatedAt,
@@ -81,6 +85,7 @@ func (r *SQLCUserRepository) ListUsers(ctx context.Context) ([]*models.User, err
 			ID:        user.ID,
 			Username:  user.Username,
 			Email:     user.Email,
+			Role:      user.Role,
 			CreatedAt: user.CreatedAt,
 			UpdatedAt: user.UpdatedAt,
 		}
@@ -143,3 +148,26 @@ func (r *SQLCUserRepository) DeleteUser(ctx context.Context, id int64) error {
 	}
 	return nil
 }
+
+func (r *SQLCUserRepository) UpdateUserRole(ctx context.Context, id int64, role string) error {
+	_, err := r.GetUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	err = r.q.UpdateUserRole(ctx, sqlcdb.UpdateUserRoleParams{
+		Role: role,
+		ID:   id,
+	})
+	if err != nil {
+		return apperrors.NewDatabaseError(err)
+	}
+	return nil
+}
+
+func (r *SQLCUserRepository) CountUsersByRole(ctx context.Context, role string) (int64, error) {
+	count, err := r.q.CountUsersByRole(ctx, role)
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return count, nil
+}
@@ -27,7 +27,8 @@ func setupTestDB(t *testing.T) (*sql.DB, func()) {
 			email TEXT NOT NULL UNIQUE,
 			password_hash TEXT NOT NULL,
 			created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+			updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			role TEXT NOT NULL DEFAULT 'player'
 		);
 	`)
 	if err != nil {
@@ -198,6 +199,43 @@ func TestUserRepositoryCRUD(t *testing.T) {
 		}
 	})
 
+	t.Run("Update user role", func(t *testing.T) {
+		id, err := repo.CreateUser(ctx, "roleuser", "role@example.com", testPassword)
+		if err != nil {
+			t.Fatalf("Failed to create user for role test: %v", err)
+		}
+
+		// New users start as players
+		user, err := repo.GetUser(ctx, id)
+		if err != nil {
+			t.Fatalf("Failed to get user: %v", err)
+		}
+		if user.Role != "player" {
+			t.Errorf("Expected default role 'player', got '%s'", user.Role)
+		}
+
+		err = repo.UpdateUserRole(ctx, id, "admin")
+		if err != nil {
+			t.Fatalf("Failed to update user role: %v", err)
+		}
+
+		user, err = repo.GetUser(ctx, id)
+		if err != nil {
+			t.Fatalf("Failed to get updated user: %v", err)
+		}
+		if user.Role != "admin" {
+			t.Errorf("Expected role 'admin', got '%s'", user.Role)
+		}
+
+		count, err := repo.CountUsersByRole(ctx, "admin")
+		if err != nil {
+			t.Fatalf("Failed to count admins: %v", err)
+		}
+		if count != 1 {
+			t.Errorf("Expected 1 admin, got %d", count)
+		}
+	})
+
 	t.Run("Get non-existent user", func(t *testing.T) {
 		_, err := repo.GetUser(ctx, 9999)
 		if err == nil {
@@ -0,0 +1,184 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	sqlcdb "mordezzanV4/internal/repositories/db/sqlc"
+)
+
+type XPAwardRepository interface {
+	GetXPAward(ctx context.Context, id int64) (*models.XPAward, error)
+	GetXPAwardsByCharacter(ctx context.Context, characterID int64) ([]*models.XPAward, error)
+	GetXPAwardReversal(ctx context.Context, awardID int64) (*models.XPAward, error)
+	GetExperienceTotal(ctx context.Context, characterID int64) (int, error)
+	// CreateXPAward stores an award and updates the character's experience to
+	// the new ledger total in the same transaction
+	CreateXPAward(ctx context.Context, award *models.XPAward) (int64, int, error)
+}
+
+type SQLCXPAwardRepository struct {
+	db *sql.DB
+	q  *sqlcdb.Queries
+}
+
+func NewSQLCXPAwardRepository(db *sql.DB) *SQLCXPAwardRepository {
+	return &SQLCXPAwardRepository{
+		db: db,
+		q:  sqlcdb.New(db),
+	}
+}
+
+func (r *SQLCXPAwardRepository) GetXPAward(ctx context.Context, id int64) (*models.XPAward, error) {
+	award, err := r.q.GetXPAward(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("xp award", id)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := mapDbXPAwardToModel(award)
+
+	_, err = r.q.GetXPAwardReversal(ctx, sql.NullInt64{Int64: id, Valid: true})
+	if err == nil {
+		result.Reversed = true
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	return result, nil
+}
+
+func (r *SQLCXPAwardRepository) GetXPAwardsByCharacter(ctx context.Context, characterID int64) ([]*models.XPAward, error) {
+	awards, err := r.q.GetXPAwardsByCharacter(ctx, characterID)
+	if err != nil {
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	result := make([]*models.XPAward, len(awards))
+	byID := make(map[int64]*models.XPAward, len(awards))
+	for i, award := range awards {
+		result[i] = mapDbXPAwardToModel(award)
+		byID[award.ID] = result[i]
+	}
+
+	// Flag awards that have been cancelled by a later reversal
+	for _, award := range result {
+		if award.ReversesAwardID != nil {
+			if original, ok := byID[*award.ReversesAwardID]; ok {
+				original.Reversed = true
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (r *SQLCXPAwardRepository) GetXPAwardReversal(ctx context.Context, awardID int64) (*models.XPAward, error) {
+	award, err := r.q.GetXPAwardReversal(ctx, sql.NullInt64{Int64: awardID, Valid: true})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewNotFound("reversal of xp award", awardID)
+		}
+		return nil, apperrors.NewDatabaseError(err)
+	}
+
+	return mapDbXPAwardToModel(award), nil
+}
+
+func (r *SQLCXPAwardRepository) GetExperienceTotal(ctx context.Context, characterID int64) (int, error) {
+	total, err := r.q.SumXPAwardsByCharacter(ctx, characterID)
+	if err != nil {
+		return 0, apperrors.NewDatabaseError(err)
+	}
+	return int(total), nil
+}
+
+func (r *SQLCXPAwardRepository) CreateXPAward(ctx context.Context, award *models.XPAward) (int64, int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, apperrors.NewDatabaseError(err)
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	var awardedBy, reversesAwardID sql.NullInt64
+	if award.AwardedBy != nil {
+		awardedBy = sql.NullInt64{Int64: *award.AwardedBy, Valid: true}
+	}
+	if award.ReversesAwardID != nil {
+		reversesAwardID = sql.NullInt64{Int64: *award.ReversesAwardID, Valid: true}
+	}
+
+	result, err := qtx.CreateXPAward(ctx, sqlcdb.CreateXPAwardParams{
+		CharacterID:     award.CharacterID,
+		Amount:          int64(award.Amount),
+		BonusPercent:    int64(award.BonusPercent),
+		BonusAmount:     int64(award.BonusAmount),
+		Total:           int64(award.Total),
+		Source:          award.Source,
+		Session:         award.Session,
+		Notes:           award.Notes,
+		AwardedBy:       awardedBy,
+		ReversesAwardID: reversesAwardID,
+	})
+	if err != nil {
+		return 0, 0, apperrors.NewDatabaseError(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, 0, apperrors.NewDatabaseError(err)
+	}
+
+	total, err := qtx.SumXPAwardsByCharacter(ctx, award.CharacterID)
+	if err != nil {
+		return 0, 0, apperrors.NewDatabaseError(err)
+	}
+	if total < 0 {
+		return 0, 0, apperrors.NewValidationError("amount", "Experience points cannot become negative")
+	}
+
+	err = qtx.UpdateCharacterExperience(ctx, sqlcdb.UpdateCharacterExperienceParams{
+		ExperiencePoints: total,
+		ID:               award.CharacterID,
+	})
+	if err != nil {
+		return 0, 0, apperrors.NewDatabaseError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, 0, apperrors.NewDatabaseError(err)
+	}
+
+	return id, int(total), nil
+}
+
+func mapDbXPAwardToModel(award sqlcdb.XpAward) *models.XPAward {
+	result := &models.XPAward{
+		ID:           award.ID,
+		CharacterID:  award.CharacterID,
+		Amount:       int(award.Amount),
+		BonusPercent: int(award.BonusPercent),
+		BonusAmount:  int(award.BonusAmount),
+		Total:        int(award.Total),
+		Source:       award.Source,
+		Session:      award.Session,
+		Notes:        award.Notes,
+		CreatedAt:    award.CreatedAt,
+	}
+	if award.AwardedBy.Valid {
+		awardedBy := award.AwardedBy.Int64
+		result.AwardedBy = &awardedBy
+	}
+	if award.ReversesAwardID.Valid {
+		reversesAwardID := award.ReversesAwardID.Int64
+		result.ReversesAwardID = &reversesAwardID
+	}
+	return result
+}
@@ -0,0 +1,253 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"mordezzanV4/internal/dice"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/logger"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+)
+
+// AbilityRollService generates ability scores server-side and signs the result
+// so characters created from them can be verified later
+type AbilityRollService struct {
+	abilityRollRepo repositories.AbilityRollRepository
+	roller          *dice.Roller
+	secret          []byte
+}
+
+// NewAbilityRollService creates a new ability roll service
+func NewAbilityRollService(abilityRollRepo repositories.AbilityRollRepository, roller *dice.Roller, secret []byte) *AbilityRollService {
+	return &AbilityRollService{
+		abilityRollRepo: abilityRollRepo,
+		roller:          roller,
+		secret:          secret,
+	}
+}
+
+// GenerateAbilityRoll rolls (or validates a point-buy of) six ability scores and stores the signed record.
+// A player holding MaxUnusedAbilityRolls unused dice rolls must create a character from one first.
+func (s *AbilityRollService) GenerateAbilityRoll(ctx context.Context, userID int64, input *models.CreateAbilityRollInput) (*models.AbilityRoll, error) {
+	if input.Method != models.AbilityRollPointBuy {
+		unused, err := s.abilityRollRepo.CountUnusedDiceAbilityRolls(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if unused >= models.MaxUnusedAbilityRolls {
+			logger.Info("User %d refused a new ability roll with %d unused", userID, unused)
+			return nil, apperrors.NewConflict(fmt.Sprintf("You already have %d unused ability rolls; create a character from one first", unused))
+		}
+	}
+
+	roll := &models.AbilityRoll{
+		UserID: userID,
+		Method: input.Method,
+		Nonce:  uuid.New().String(),
+	}
+
+	if input.Method == models.AbilityRollPointBuy {
+		roll.Scores = input.PointBuy.Slice()
+	} else {
+		roll.Seed = s.roller.Int63()
+		roll.Scores, roll.Rolls = rollAbilityScores(input.Method, dice.NewRoller(roll.Seed))
+	}
+
+	roll.Signature = s.sign(roll)
+
+	id, err := s.abilityRollRepo.CreateAbilityRoll(ctx, roll)
+	if err != nil {
+		logger.Error("Failed to store ability roll for user %d: %v", userID, err)
+		return nil, err
+	}
+
+	logger.Info("User %d generated ability scores %v using %s", userID, roll.Scores, roll.Method)
+
+	return s.GetAbilityRoll(ctx, id)
+}
+
+// GetAbilityRoll returns a stored roll with its signature checked
+func (s *AbilityRollService) GetAbilityRoll(ctx context.Context, id int64) (*models.AbilityRoll, error) {
+	roll, err := s.abilityRollRepo.GetAbilityRoll(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	roll.Verified = s.verify(roll)
+	return roll, nil
+}
+
+// GetAbilityRollsByUser returns every roll a user has generated
+func (s *AbilityRollService) GetAbilityRollsByUser(ctx context.Context, userID int64) ([]*models.AbilityRoll, error) {
+	rolls, err := s.abilityRollRepo.GetAbilityRollsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, roll := range rolls {
+		roll.Verified = s.verify(roll)
+	}
+	return rolls, nil
+}
+
+// GetAbilityRollForCharacter returns the roll a character's attributes came from
+func (s *AbilityRollService) GetAbilityRollForCharacter(ctx context.Context, characterID int64) (*models.AbilityRoll, error) {
+	roll, err := s.abilityRollRepo.GetAbilityRollByCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	roll.Verified = s.verify(roll)
+	return roll, nil
+}
+
+// ClaimAbilityRoll checks that the attributes of a new character match an unused,
+// correctly signed roll owned by the user, then marks the roll as used. The claim
+// must be completed with AttachCharacter or undone with ReleaseAbilityRoll.
+func (s *AbilityRollService) ClaimAbilityRoll(ctx context.Context, input *models.CreateCharacterInput) error {
+	roll, err := s.abilityRollRepo.GetAbilityRoll(ctx, input.AbilityRollID)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return apperrors.NewValidationError("ability_roll_id", "Ability roll not found")
+		}
+		return err
+	}
+
+	if roll.UserID != input.UserID {
+		return apperrors.NewForbidden("You do not have access to this ability roll")
+	}
+	if roll.UsedAt != nil {
+		return apperrors.NewValidationError("ability_roll_id", "Ability roll has already been used")
+	}
+	if !s.verify(roll) {
+		logger.Warning("Ability roll %d failed signature verification", roll.ID)
+		return apperrors.NewValidationError("ability_roll_id", "Ability roll failed verification")
+	}
+
+	attributes := models.AbilityScores{
+		Strength:     input.Strength,
+		Dexterity:    input.Dexterity,
+		Constitution: input.Constitution,
+		Intelligence: input.Intelligence,
+		Wisdom:       input.Wisdom,
+		Charisma:     input.Charisma,
+	}
+	if !scoresMatch(roll, attributes.Slice()) {
+		if roll.IsArranged() {
+			return apperrors.NewValidationError("ability_roll_id", fmt.Sprintf("Attributes must be an arrangement of the rolled scores %v", roll.Scores))
+		}
+		return apperrors.NewValidationError("ability_roll_id", fmt.Sprintf("Attributes must match the rolled scores %v in order", roll.Scores))
+	}
+
+	claimed, err := s.abilityRollRepo.ClaimAbilityRoll(ctx, roll.ID)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return apperrors.NewValidationError("ability_roll_id", "Ability roll has already been used")
+	}
+	return nil
+}
+
+// AttachCharacter records which character a claimed roll was used for
+func (s *AbilityRollService) AttachCharacter(ctx context.Context, rollID, characterID int64) error {
+	return s.abilityRollRepo.SetAbilityRollCharacter(ctx, rollID, characterID)
+}
+
+// ReleaseAbilityRoll returns a claimed roll to the unused pool when character creation fails
+func (s *AbilityRollService) ReleaseAbilityRoll(ctx context.Context, rollID int64) error {
+	return s.abilityRollRepo.ReleaseAbilityRoll(ctx, rollID)
+}
+
+// sign computes the HMAC of the fields that determine the scores
+func (s *AbilityRollService) sign(roll *models.AbilityRoll) string {
+	scores := make([]string, len(roll.Scores))
+	for i, score := range roll.Scores {
+		scores[i] = fmt.Sprint(score)
+	}
+
+	mac := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(mac, "%s|%d|%s|%d|%s", roll.Nonce, roll.UserID, roll.Method, roll.Seed, strings.Join(scores, ","))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// verify checks the signature and, for dice methods, that replaying the seed
+// produces the stored scores
+func (s *AbilityRollService) verify(roll *models.AbilityRoll) bool {
+	if !hmac.Equal([]byte(s.sign(roll)), []byte(roll.Signature)) {
+		return false
+	}
+	if roll.Method == models.AbilityRollPointBuy {
+		return true
+	}
+
+	scores, _ := rollAbilityScores(roll.Method, dice.NewRoller(roll.Seed))
+	if len(scores) != len(roll.Scores) {
+		return false
+	}
+	for i := range scores {
+		if scores[i] != roll.Scores[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// rollAbilityScores produces the scores for a dice method. For arranged methods
+// the scores are returned highest first.
+func rollAbilityScores(method string, roller *dice.Roller) ([]int, []*dice.Result) {
+	var expr string
+	count := 6
+	switch method {
+	case models.AbilityRollThreeD6InOrder:
+		expr = "3d6"
+	case models.AbilityRollFourD6DropLowest:
+		expr = "4d6dl1"
+	case models.AbilityRollThreeD6BestOfTwelve:
+		expr = "3d6"
+		count = 12
+	default:
+		return nil, nil
+	}
+
+	expression := dice.MustParse(expr)
+	rolls := make([]*dice.Result, count)
+	scores := make([]int, count)
+	for i := range rolls {
+		rolls[i] = expression.Roll(roller)
+		scores[i] = rolls[i].Total
+	}
+
+	if method == models.AbilityRollThreeD6InOrder {
+		return scores, rolls
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(scores)))
+	return scores[:6], rolls
+}
+
+func scoresMatch(roll *models.AbilityRoll, attributes []int) bool {
+	if len(roll.Scores) != len(attributes) {
+		return false
+	}
+
+	expected := append([]int(nil), roll.Scores...)
+	actual := append([]int(nil), attributes...)
+	if roll.IsArranged() {
+		sort.Ints(expected)
+		sort.Ints(actual)
+	}
+
+	for i := range expected {
+		if expected[i] != actual[i] {
+			return false
+		}
+	}
+	return true
+}
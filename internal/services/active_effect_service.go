@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+)
+
+// ActiveEffectService tracks timed effects and conditions on characters as
+// game time passes, and the hit points the dying lose meanwhile
+type ActiveEffectService struct {
+	activeEffectRepo repositories.ActiveEffectRepository
+	conditionRepo    repositories.ConditionRepository
+	characterRepo    repositories.CharacterRepository
+}
+
+// NewActiveEffectService creates a new active effect service
+func NewActiveEffectService(
+	activeEffectRepo repositories.ActiveEffectRepository,
+	conditionRepo repositories.ConditionRepository,
+	characterRepo repositories.CharacterRepository,
+) *ActiveEffectService {
+	return &ActiveEffectService{
+		activeEffectRepo: activeEffectRepo,
+		conditionRepo:    conditionRepo,
+		characterRepo:    characterRepo,
+	}
+}
+
+// GetActiveEffects lists the effects currently on a character
+func (s *ActiveEffectService) GetActiveEffects(ctx context.Context, characterID int64) ([]*models.ActiveEffect, error) {
+	return s.activeEffectRepo.GetActiveEffects(ctx, characterID)
+}
+
+// AdvanceTime moves game time forward for a character, ending the effects
+// and timed conditions whose duration runs out. A dying character loses a hit
+// point each round and may die.
+func (s *ActiveEffectService) AdvanceTime(ctx context.Context, characterID int64, input *models.AdvanceTimeInput) (*models.AdvanceTimeResult, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := s.activeEffectRepo.GetActiveEffects(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	conditionsBefore, err := s.conditionRepo.GetCharacterConditions(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	rounds := input.TotalRounds()
+	if err := s.activeEffectRepo.AdvanceTime(ctx, characterID, rounds); err != nil {
+		return nil, err
+	}
+	if err := s.conditionRepo.AdvanceConditions(ctx, characterID, rounds); err != nil {
+		return nil, err
+	}
+
+	result := &models.AdvanceTimeResult{
+		Rounds:            rounds,
+		Expired:           []*models.ActiveEffect{},
+		ExpiredConditions: []*models.CharacterCondition{},
+	}
+	if !character.IsRetired() {
+		result.HitPointsLost = character.Bleed(rounds)
+		if result.HitPointsLost > 0 {
+			if err := s.characterRepo.UpdateHitPoints(ctx, character); err != nil {
+				return nil, err
+			}
+		}
+	}
+	result.Vitality = character.Vitality
+	for _, effect := range before {
+		if effect.RemainingRounds <= rounds {
+			effect.RemainingRounds = 0
+			result.Expired = append(result.Expired, effect)
+		}
+	}
+	for _, condition := range conditionsBefore {
+		if condition.RemainingRounds != nil && *condition.RemainingRounds <= rounds {
+			remaining := 0
+			condition.RemainingRounds = &remaining
+			result.ExpiredConditions = append(result.ExpiredConditions, condition)
+		}
+	}
+
+	result.Active, err = s.activeEffectRepo.GetActiveEffects(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	result.Conditions, err = s.conditionRepo.GetCharacterConditions(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RemoveActiveEffect ends an effect early, such as when it is dispelled
+func (s *ActiveEffectService) RemoveActiveEffect(ctx context.Context, characterID, effectID int64) error {
+	return s.activeEffectRepo.RemoveActiveEffect(ctx, characterID, effectID)
+}
@@ -25,6 +25,7 @@ type ACDetails struct {
 	NaturalAC      int    `json:"natural_ac,omitempty"`
 	AgileBonus     int    `json:"agile_bonus,omitempty"`
 	OtherBonuses   int    `json:"other_bonuses,omitempty"`
+	ConditionBonus int    `json:"condition_bonus,omitempty"`
 	FinalAC        int    `json:"final_ac"`
 	ArmorEquipped  string `json:"armor_equipped,omitempty"`
 	ShieldEquipped string `json:"shield_equipped,omitempty"`
@@ -141,6 +142,11 @@ func (s *ACService) CalculateCharacterAC(ctx context.Context, characterID int64)
 		}
 	}
 
+	// Conditions such as blinded or prone can worsen AC as well as improve it
+	if character.ConditionModifiers != nil {
+		details.ConditionBonus = character.ConditionModifiers.ACBonus
+	}
+
 	finalAC := details.BaseAC
 	if details.ArmorAC > 0 {
 		finalAC = details.ArmorAC
@@ -150,6 +156,7 @@ func (s *ACService) CalculateCharacterAC(ctx context.Context, characterID int64)
 	finalAC -= details.AgileBonus
 	finalAC -= details.NaturalAC
 	finalAC -= details.OtherBonuses
+	finalAC -= details.ConditionBonus
 	details.FinalAC = finalAC
 
 	return details, nil
@@ -0,0 +1,222 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"mordezzanV4/internal/dice"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/logger"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+)
+
+// AttackService resolves a character's weapon attacks against a target AC
+type AttackService struct {
+	characterRepo      repositories.CharacterRepository
+	classService       *ClassService
+	weaponStatsService *WeaponStatsService
+	roller             *dice.Roller
+}
+
+// NewAttackService creates a new attack service
+func NewAttackService(
+	characterRepo repositories.CharacterRepository,
+	classService *ClassService,
+	weaponStatsService *WeaponStatsService,
+	roller *dice.Roller,
+) *AttackService {
+	return &AttackService{
+		characterRepo:      characterRepo,
+		classService:       classService,
+		weaponStatsService: weaponStatsService,
+		roller:             roller,
+	}
+}
+
+// Attack rolls d20 plus the character's fighting ability and every modifier
+// that applies against the target's AC, then rolls damage on a hit
+func (s *AttackService) Attack(ctx context.Context, characterID int64, input *models.AttackInput) (*models.AttackResult, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.classService.EnrichCharacterWithClassData(ctx, character); err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+
+	weaponStats, err := s.weaponStatsService.CalculateCharacterWeaponStats(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	var stats *WeaponStats
+	for _, ws := range weaponStats {
+		if ws.InventoryItem != nil && ws.InventoryItem.ID == input.InventoryItemID {
+			stats = ws
+			break
+		}
+	}
+	if stats == nil {
+		return nil, apperrors.NewNotFound("weapon inventory item", input.InventoryItemID)
+	}
+	weapon := stats.Weapon
+
+	modifiers := []models.RollModifier{}
+	add := func(source string, value int) {
+		if value != 0 {
+			modifiers = append(modifiers, models.RollModifier{Source: source, Value: value})
+		}
+	}
+
+	result := &models.AttackResult{
+		CharacterID:     characterID,
+		InventoryItemID: input.InventoryItemID,
+		Weapon:          weapon.Name,
+		TargetAC:        input.TargetAC,
+		FightingAbility: character.FightingAbility,
+		Needed:          models.AttackTarget - input.TargetAC,
+	}
+
+	if input.Range > 0 {
+		band, modifier, err := rangeBand(weapon, input.Range)
+		if err != nil {
+			return nil, err
+		}
+		result.RangeBand = band
+		add("dexterity", character.RangedModifier)
+		add(band+" range", modifier)
+	} else {
+		if isRangedWeapon(weapon) {
+			return nil, apperrors.NewValidationError("range", fmt.Sprintf("%s needs a range to attack with", weapon.Name))
+		}
+		add("strength", character.MeleeModifier)
+	}
+
+	add(weapon.Name, extractWeaponBonus(weapon.Name))
+	if stats.IsMastered {
+		if bonus, ok := stats.MasteryBonuses["to_hit_bonus"].(int); ok {
+			add("mastery", bonus)
+		}
+	}
+	for _, condition := range character.Conditions {
+		add(condition.Name, condition.ToHitModifier)
+	}
+
+	multiplier := 0
+	if input.Backstab {
+		if character.BackStabMultiplier == 0 {
+			return nil, apperrors.NewValidationError("backstab", fmt.Sprintf("%s cannot backstab", character.Class))
+		}
+		if weapon.WeaponClass < 1 || weapon.WeaponClass > models.MaxBackstabWeaponClass {
+			return nil, apperrors.NewValidationError("backstab",
+				fmt.Sprintf("Backstabs need a class 1 or 2 melee weapon, not %s", weapon.Name))
+		}
+		multiplier = character.BackStabMultiplier
+		add("backstab", models.BackstabBonus)
+	} else if input.RearAttack {
+		add("rear attack", models.RearAttackBonus)
+	}
+
+	for _, modifier := range input.Modifiers {
+		add(modifier.Source, modifier.Value)
+	}
+
+	result.Modifiers = modifiers
+	for _, modifier := range modifiers {
+		result.TotalModifier += modifier.Value
+	}
+	result.Roll = s.roller.Die(20)
+	result.Total = result.Roll + result.FightingAbility + result.TotalModifier
+	switch result.Roll {
+	case 20:
+		result.Hit = true
+	case 1:
+		result.Hit = false
+	default:
+		result.Hit = result.Total >= result.Needed
+	}
+
+	if result.Hit {
+		result.Damage, err = s.rollDamage(stats, input.TwoHanded, multiplier)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result.Summary = describeAttack(character.Name, result)
+	return result, nil
+}
+
+// rollDamage rolls the weapon's damage dice, multiplied for a backstab, and
+// adds the damage bonus once. A hit always does at least 1 damage.
+func (s *AttackService) rollDamage(stats *WeaponStats, twoHanded bool, multiplier int) (*models.AttackDamage, error) {
+	damage := stats.BaseDamage
+	if twoHanded {
+		if !hasDamageRoll(stats.Weapon.DamageTwoHanded) {
+			return nil, apperrors.NewValidationError("two_handed", "This weapon has no two-handed damage")
+		}
+		damage = stats.Weapon.DamageTwoHanded
+	} else if !hasDamageRoll(damage) {
+		return nil, apperrors.NewValidationError("inventory_item_id", "This weapon has no damage roll")
+	}
+
+	expression, err := dice.Parse(damage)
+	if err != nil {
+		logger.Error("Weapon %s has unparseable damage %q: %v", stats.Weapon.Name, damage, err)
+		return nil, apperrors.NewInternalError(err)
+	}
+	if multiplier > 1 {
+		for i := range expression.Terms {
+			if expression.Terms[i].IsDice() {
+				expression.Terms[i].Count *= multiplier
+			}
+		}
+	}
+	if stats.DamageBonus != 0 {
+		sign := 1
+		if stats.DamageBonus < 0 {
+			sign = -1
+		}
+		expression.Terms = append(expression.Terms, dice.Term{Sign: sign, Constant: sign * stats.DamageBonus})
+	}
+
+	roll := expression.Roll(s.roller)
+	roll.Expression = expression.String()
+	if roll.Total < 1 {
+		roll.Total = 1
+	}
+	return &models.AttackDamage{Result: roll, Multiplier: multiplier}, nil
+}
+
+// rangeBand returns the band the distance falls in for the weapon and its
+// to-hit modifier
+func rangeBand(weapon *models.Weapon, distance int) (string, int, error) {
+	within := func(limit *int) bool {
+		return limit != nil && distance <= *limit
+	}
+	switch {
+	case within(weapon.RangeShort):
+		return models.RangeBandShort, models.ShortRangeModifier, nil
+	case within(weapon.RangeMedium):
+		return models.RangeBandMedium, models.MediumRangeModifier, nil
+	case within(weapon.RangeLong):
+		return models.RangeBandLong, models.LongRangeModifier, nil
+	}
+	for _, limit := range []*int{weapon.RangeShort, weapon.RangeMedium, weapon.RangeLong} {
+		if limit != nil && *limit > 0 {
+			return "", 0, apperrors.NewValidationError("range", fmt.Sprintf("The target is out of range of %s", weapon.Name))
+		}
+	}
+	return "", 0, apperrors.NewValidationError("range", fmt.Sprintf("%s cannot attack at range", weapon.Name))
+}
+
+// describeAttack summarises an attack for a combat log
+func describeAttack(name string, result *models.AttackResult) string {
+	attack := fmt.Sprintf("%s attacks AC %d with %s: %d%+d = %d vs %d",
+		name, result.TargetAC, result.Weapon, result.Roll,
+		result.FightingAbility+result.TotalModifier, result.Total, result.Needed)
+	if !result.Hit {
+		return attack + ", miss"
+	}
+	return fmt.Sprintf("%s, hit for %d (%s)", attack, result.Damage.Total, result.Damage.Expression)
+}
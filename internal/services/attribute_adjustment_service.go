@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+)
+
+// AttributeAdjustmentService damages, drains and improves a character's
+// attributes and level, and restores them again
+type AttributeAdjustmentService struct {
+	adjustmentRepo repositories.AttributeAdjustmentRepository
+	characterRepo  repositories.CharacterRepository
+	classService   *ClassService
+}
+
+// NewAttributeAdjustmentService creates a new attribute adjustment service
+func NewAttributeAdjustmentService(
+	adjustmentRepo repositories.AttributeAdjustmentRepository,
+	characterRepo repositories.CharacterRepository,
+	classService *ClassService,
+) *AttributeAdjustmentService {
+	return &AttributeAdjustmentService{
+		adjustmentRepo: adjustmentRepo,
+		characterRepo:  characterRepo,
+		classService:   classService,
+	}
+}
+
+// GetAttributes returns the base, adjusted and current values of the
+// character's attributes and level
+func (s *AttributeAdjustmentService) GetAttributes(ctx context.Context, characterID int64) (*models.CharacterAttributes, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.classService.EnrichCharacterWithClassData(ctx, character); err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	return character.AttributeBreakdown(), nil
+}
+
+// AddAdjustment damages, drains or improves one of the character's attributes
+// or their level, such as strength drained by a shadow, and returns the
+// attributes afterwards
+func (s *AttributeAdjustmentService) AddAdjustment(ctx context.Context, characterID int64, input *models.AddAttributeAdjustmentInput) (*models.CharacterAttributes, error) {
+	if _, err := s.characterRepo.GetCharacter(ctx, characterID); err != nil {
+		return nil, err
+	}
+	if _, err := s.adjustmentRepo.AddAdjustment(ctx, characterID, input); err != nil {
+		return nil, err
+	}
+	return s.GetAttributes(ctx, characterID)
+}
+
+// RemoveAdjustment takes a single adjustment off the character, such as when
+// a magic item granting a bonus is removed. Losses are permanent and cannot
+// be removed.
+func (s *AttributeAdjustmentService) RemoveAdjustment(ctx context.Context, characterID, id int64) error {
+	adjustment, err := s.adjustmentRepo.GetAdjustment(ctx, characterID, id)
+	if err != nil {
+		return err
+	}
+	if adjustment.IsPermanent() {
+		return apperrors.NewConflict(fmt.Sprintf("The %s lost to %s cannot be restored", adjustment.Attribute, adjustment.Source))
+	}
+	return s.adjustmentRepo.RemoveAdjustment(ctx, characterID, id)
+}
+
+// Restore removes the character's damage, as rest or healing does, or their
+// drain, as a restoration spell does, from one attribute or all of them
+func (s *AttributeAdjustmentService) Restore(ctx context.Context, characterID int64, input *models.RestoreAttributesInput) (*models.RestoreAttributesResult, error) {
+	if _, err := s.characterRepo.GetCharacter(ctx, characterID); err != nil {
+		return nil, err
+	}
+	restored, err := s.adjustmentRepo.RestoreAdjustments(ctx, characterID, input.Kind, input.Attribute)
+	if err != nil {
+		return nil, err
+	}
+	attributes, err := s.GetAttributes(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.RestoreAttributesResult{
+		Restored:   restored,
+		Attributes: attributes,
+	}, nil
+}
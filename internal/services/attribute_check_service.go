@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"mordezzanV4/internal/dice"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+)
+
+// AttributeCheckService rolls attribute tests, feats and checks for a character
+type AttributeCheckService struct {
+	characterRepo repositories.CharacterRepository
+	classService  *ClassService
+	roller        *dice.Roller
+}
+
+// NewAttributeCheckService creates a new attribute check service
+func NewAttributeCheckService(
+	characterRepo repositories.CharacterRepository,
+	classService *ClassService,
+	roller *dice.Roller,
+) *AttributeCheckService {
+	return &AttributeCheckService{
+		characterRepo: characterRepo,
+		classService:  classService,
+		roller:        roller,
+	}
+}
+
+// GetChances lists the character's chances with each of the six attributes
+// before modifiers
+func (s *AttributeCheckService) GetChances(ctx context.Context, characterID int64) ([]*models.AttributeChances, error) {
+	character, err := s.loadCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := character.EffectiveAttributes()
+	chances := make([]*models.AttributeChances, len(models.Attributes))
+	for i, attribute := range models.Attributes {
+		test, feat := character.AttributeTests(attribute)
+		chances[i] = &models.AttributeChances{
+			Attribute: attribute,
+			Score:     scores.Score(attribute),
+			Test:      test,
+			Feat:      feat,
+			Check:     fmt.Sprintf("%d:%d", scores.Score(attribute), models.AttributeCheckDie),
+		}
+	}
+	return chances, nil
+}
+
+// RollCheck rolls the kind of check for the attribute. The modifiers of the
+// character's conditions and the referee are added to the base chance, and a
+// roll at or under the result succeeds; the highest roll of the die always
+// fails.
+func (s *AttributeCheckService) RollCheck(ctx context.Context, characterID int64, input *models.AttributeCheckInput) (*models.AttributeCheckResult, error) {
+	character, err := s.loadCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := input.ResolvedKind()
+	if kind != models.AttributeCheckScore && !models.HasAttributeTest(input.Attribute) {
+		return nil, apperrors.NewValidationError("kind", fmt.Sprintf("%s has no %s; roll a check instead", input.Attribute, kind))
+	}
+	base, die, err := character.AttributeCheckChance(input.Attribute, kind)
+	if err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+
+	modifiers := []models.RollModifier{}
+	add := func(source string, value int) {
+		if value != 0 {
+			modifiers = append(modifiers, models.RollModifier{Source: source, Value: models.AttributeCheckModifier(kind, value)})
+		}
+	}
+	for _, condition := range character.Conditions {
+		add(condition.Name, condition.CheckModifier)
+	}
+	for _, modifier := range input.Modifiers {
+		add(modifier.Source, modifier.Value)
+	}
+
+	result := &models.AttributeCheckResult{
+		CharacterID: characterID,
+		Attribute:   input.Attribute,
+		Kind:        kind,
+		Score:       character.EffectiveAttributes().Score(input.Attribute),
+		Die:         die,
+		BaseChance:  base,
+		Modifiers:   modifiers,
+		Roll:        s.roller.Die(die),
+	}
+	for _, modifier := range modifiers {
+		result.TotalModifier += modifier.Value
+	}
+	result.Chance = models.BoundAttributeChance(base+result.TotalModifier, die)
+	result.Success = result.Roll <= result.Chance
+	result.Summary = models.AttributeCheckSummary(input.Attribute, kind, result.Success)
+	return result, nil
+}
+
+// loadCharacter retrieves the character with their derived stats and the
+// feats their class improves
+func (s *AttributeCheckService) loadCharacter(ctx context.Context, characterID int64) (*models.Character, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.classService.EnrichCharacterWithClassData(ctx, character); err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	return character, nil
+}
@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"mordezzanV4/internal/logger"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+
+	apperrors "mordezzanV4/internal/errors"
+)
+
+// CharacterAccessService decides whether a user may read or change a character
+type CharacterAccessService struct {
+	characterRepo repositories.CharacterRepository
+	grantRepo     repositories.CharacterGrantRepository
+	inventoryRepo repositories.InventoryRepository
+	userRepo      repositories.UserRepository
+}
+
+// NewCharacterAccessService creates a new character access service
+func NewCharacterAccessService(
+	characterRepo repositories.CharacterRepository,
+	grantRepo repositories.CharacterGrantRepository,
+	inventoryRepo repositories.InventoryRepository,
+	userRepo repositories.UserRepository,
+) *CharacterAccessService {
+	return &CharacterAccessService{
+		characterRepo: characterRepo,
+		grantRepo:     grantRepo,
+		inventoryRepo: inventoryRepo,
+		userRepo:      userRepo,
+	}
+}
+
+// AuthorizeCharacter returns nil if the user may access the character.
+// Owners and GM grants may read and write; share grants may only read.
+func (s *CharacterAccessService) AuthorizeCharacter(ctx context.Context, userID, characterID int64, write bool) error {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return err
+	}
+
+	allowed, err := s.CanAccessCharacter(ctx, userID, character, write)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		logger.Info("User %d denied access to character %d", userID, characterID)
+		return apperrors.NewForbidden("You do not have access to this character")
+	}
+	return nil
+}
+
+// AuthorizeCharacterOwner returns nil only if the user owns the character
+func (s *CharacterAccessService) AuthorizeCharacterOwner(ctx context.Context, userID, characterID int64) error {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return err
+	}
+	if character.UserID != userID {
+		logger.Info("User %d is not the owner of character %d", userID, characterID)
+		return apperrors.NewForbidden("Only the owner can perform this action")
+	}
+	return nil
+}
+
+// AuthorizeInventory resolves the inventory's character and checks access to it
+func (s *CharacterAccessService) AuthorizeInventory(ctx context.Context, userID, inventoryID int64, write bool) error {
+	inventory, err := s.inventoryRepo.GetInventory(ctx, inventoryID)
+	if err != nil {
+		return err
+	}
+	return s.AuthorizeCharacter(ctx, userID, inventory.CharacterID, write)
+}
+
+// CanAccessCharacter reports whether the user owns the character or holds a grant for it
+func (s *CharacterAccessService) CanAccessCharacter(ctx context.Context, userID int64, character *models.Character, write bool) (bool, error) {
+	if character.UserID == userID {
+		return true, nil
+	}
+
+	grant, err := s.grantRepo.GetCharacterGrant(ctx, character.ID, userID)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if write {
+		return grant.GrantType == models.GrantTypeGM, nil
+	}
+	return true, nil
+}
+
+// FilterAccessibleCharacters returns the characters the user may read
+func (s *CharacterAccessService) FilterAccessibleCharacters(ctx context.Context, userID int64, characters []*models.Character) ([]*models.Character, error) {
+	grants, err := s.grantRepo.GetCharacterGrantsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	granted := make(map[int64]bool, len(grants))
+	for _, grant := range grants {
+		granted[grant.CharacterID] = true
+	}
+
+	result := make([]*models.Character, 0, len(characters))
+	for _, character := range characters {
+		if character.UserID == userID || granted[character.ID] {
+			result = append(result, character)
+		}
+	}
+	return result, nil
+}
+
+// GetGrants lists the grants on a character
+func (s *CharacterAccessService) GetGrants(ctx context.Context, characterID int64) ([]*models.CharacterGrant, error) {
+	return s.grantRepo.GetCharacterGrants(ctx, characterID)
+}
+
+// SaveGrant creates or updates a grant on a character
+func (s *CharacterAccessService) SaveGrant(ctx context.Context, input *models.CreateCharacterGrantInput) error {
+	character, err := s.characterRepo.GetCharacter(ctx, input.CharacterID)
+	if err != nil {
+		return err
+	}
+	if character.UserID == input.UserID {
+		return apperrors.NewValidationError("user_id", "The owner already has full access")
+	}
+
+	// Only game masters can be given control of someone else's character
+	if input.GrantType == models.GrantTypeGM {
+		grantee, err := s.userRepo.GetUser(ctx, input.UserID)
+		if err != nil {
+			return err
+		}
+		if !grantee.IsGameMaster() {
+			return apperrors.NewValidationError("grant_type", "GM access can only be granted to a game master")
+		}
+	}
+	return s.grantRepo.SaveCharacterGrant(ctx, input)
+}
+
+// RevokeGrant removes a user's grant on a character
+func (s *CharacterAccessService) RevokeGrant(ctx context.Context, characterID, userID int64) error {
+	return s.grantRepo.DeleteCharacterGrant(ctx, characterID, userID)
+}
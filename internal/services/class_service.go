@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 	"fmt"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/logger"
 	"mordezzanV4/internal/models"
 	"mordezzanV4/internal/repositories"
 	"strconv"
@@ -13,6 +15,7 @@ type ClassService struct {
 	classRepo          repositories.ClassRepository
 	inventoryRepo      repositories.InventoryRepository
 	armorRepo          repositories.ArmorRepository
+	kindredRepo        repositories.KindredRepository
 	encumbranceService *EncumbranceService
 }
 
@@ -37,26 +40,51 @@ func (s *ClassService) SetEncumbranceService(encumbranceService *EncumbranceServ
 	s.encumbranceService = encumbranceService
 }
 
+func (s *ClassService) SetKindredRepository(kindredRepo repositories.KindredRepository) {
+	s.kindredRepo = kindredRepo
+}
+
+// ValidateKindred checks that the kindred exists and may take the given class.
+// An empty kindred is always valid.
+func (s *ClassService) ValidateKindred(ctx context.Context, kindredName, class string) error {
+	if kindredName == "" || s.kindredRepo == nil {
+		return nil
+	}
+
+	kindred, err := s.kindredRepo.GetKindredByName(ctx, kindredName)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return apperrors.NewValidationError("kindred", fmt.Sprintf("Unknown kindred: %s", kindredName))
+		}
+		return err
+	}
+
+	if !kindred.AllowsClass(class) {
+		return apperrors.NewValidationError("class", fmt.Sprintf("%s characters cannot take the %s class", kindred.Name, class))
+	}
+	return nil
+}
+
 func (s *ClassService) applyAgileBonus(ctx context.Context, character *models.Character) error {
-	fmt.Printf("DEBUG: Applying agile bonus for %s (ID: %d)\n", character.Name, character.ID)
+	logger.Debug("Applying agile bonus for %s (ID: %d)", character.Name, character.ID)
 
 	if s.encumbranceService == nil || s.inventoryRepo == nil {
-		fmt.Printf("DEBUG: Encumbrance service or inventory repo is nil\n")
+		logger.Debug("Encumbrance service or inventory repo is nil")
 		return nil
 	}
 
 	encumbranceDetails, err := s.encumbranceService.GetCharacterEncumbrance(ctx, character.ID)
 	if err != nil {
-		fmt.Printf("DEBUG: Failed to get encumbrance details: %v\n", err)
+		logger.Error("Failed to get encumbrance details: %v", err)
 		return fmt.Errorf("failed to get encumbrance details: %v", err)
 	}
 
-	fmt.Printf("DEBUG: Encumbrance status - HeavyEncumbered: %v\n",
+	logger.Debug("Encumbrance status - HeavyEncumbered: %v",
 		encumbranceDetails.Status.HeavyEncumbered)
 
 	inventory, err := s.inventoryRepo.GetInventoryByCharacter(ctx, character.ID)
 	if err != nil {
-		fmt.Printf("DEBUG: Failed to get character inventory: %v\n", err)
+		logger.Error("Failed to get character inventory: %v", err)
 		return fmt.Errorf("failed to get character inventory: %v", err)
 	}
 
@@ -64,21 +92,21 @@ func (s *ClassService) applyAgileBonus(ctx context.Context, character *models.Ch
 	for _, item := range inventory.Items {
 		if item.ItemType == "armor" && item.IsEquipped {
 			wearingArmor = true
-			fmt.Printf("DEBUG: Character is wearing armor: %s\n", item.ItemID)
+			logger.Debug("Character is wearing armor: %d", item.ItemID)
 			break
 		}
 	}
 
-	fmt.Printf("DEBUG: Character is wearing armor: %v\n", wearingArmor)
+	logger.Debug("Character is wearing armor: %v", wearingArmor)
 
 	if !wearingArmor && !encumbranceDetails.Status.HeavyEncumbered {
-		fmt.Printf("DEBUG: Adding +1 DefenceAdjustment for agile bonus\n")
+		logger.Debug("Adding +1 DefenceAdjustment for agile bonus")
 		character.DefenceAdjustment += 1
 	} else {
-		fmt.Printf("DEBUG: Not applying agile bonus due to armor or encumbrance\n")
+		logger.Debug("Not applying agile bonus due to armor or encumbrance")
 	}
 
-	fmt.Printf("DEBUG: Final DefenceAdjustment: %d\n", character.DefenceAdjustment)
+	logger.Debug("Final DefenceAdjustment: %d", character.DefenceAdjustment)
 	return nil
 }
 
@@ -161,14 +189,131 @@ func (s *ClassService) applyExtraDex(ctx context.Context, character *models.Char
 	return nil
 }
 
+// applyClassHook runs the behaviour named by a class rules hook
+func (s *ClassService) applyClassHook(ctx context.Context, character *models.Character, hook string) error {
+	switch hook {
+	case models.ClassHookAgile:
+		return s.applyAgileBonus(ctx, character)
+	case models.ClassHookRun:
+		return s.applyRunAbility(ctx, character)
+	case models.ClassHookExtraStrengthFeat:
+		return s.applyExtraStr(ctx, character)
+	case models.ClassHookExtraDexterityFeat:
+		return s.applyExtraDex(ctx, character)
+	case models.ClassHookNaturalAC:
+		naturalAC, err := s.classRepo.GetNaturalAC(ctx, character.Class, character.EffectiveLevel())
+		if err != nil {
+			return err
+		}
+		setCharacterAbility(character, "natural_ac", naturalAC)
+	case models.ClassHookRunesPerDay:
+		runesPerDay, err := s.classRepo.GetRunesPerDay(ctx, character.Class, character.EffectiveLevel())
+		if err != nil {
+			return err
+		}
+		setCharacterAbility(character, "runes_per_day", runesPerDay)
+	case models.ClassHookMartialArts:
+		acBonus, emptyHandDamage, err := s.classRepo.GetMartialArts(ctx, character.Class, character.EffectiveLevel())
+		if err != nil {
+			return err
+		}
+		setCharacterAbility(character, "ac_bonus", acBonus)
+		setCharacterAbility(character, "empty_hand_damage", emptyHandDamage)
+	case models.ClassHookBackstab:
+		multiplier, err := s.classRepo.GetBackstabMultiplier(ctx, character.Class, character.EffectiveLevel())
+		if err != nil {
+			return err
+		}
+		character.BackStabMultiplier = multiplier
+	default:
+		return fmt.Errorf("unknown class hook %q", hook)
+	}
+	return nil
+}
+
+// setCharacterAbility stores a value in the character's abilities map
+func setCharacterAbility(character *models.Character, key string, value interface{}) {
+	if abilities, ok := character.Abilities.(map[string]interface{}); ok {
+		abilities[key] = value
+		return
+	}
+	character.Abilities = map[string]interface{}{
+		key: value,
+	}
+}
+
+// checkArmorRestrictions sets ArmorWarning when the equipped armor is heavier
+// than the class allows or a shield is carried by a class that may not use one
+func (s *ClassService) checkArmorRestrictions(ctx context.Context, character *models.Character, rules *models.ClassRules) error {
+	character.ArmorWarning = ""
+	if s.inventoryRepo == nil || character.ID == 0 {
+		return nil
+	}
+
+	inventory, err := s.inventoryRepo.GetInventoryByCharacter(ctx, character.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get character inventory: %v", err)
+	}
+
+	var warnings []string
+	for _, item := range inventory.Items {
+		if !item.IsEquipped {
+			continue
+		}
+		switch item.ItemType {
+		case "armor":
+			if s.armorRepo == nil {
+				continue
+			}
+			armor, err := s.armorRepo.GetArmor(ctx, item.ItemID)
+			if err != nil {
+				continue
+			}
+			if !rules.AllowsArmor(armor.WeightClass) {
+				warnings = append(warnings, fmt.Sprintf("%s cannot wear %s armor", character.Class, strings.ToLower(armor.WeightClass)))
+			}
+		case "shield":
+			if !rules.ShieldsAllowed {
+				warnings = append(warnings, fmt.Sprintf("%s cannot use shields", character.Class))
+			}
+		}
+	}
+
+	character.ArmorWarning = strings.Join(warnings, "; ")
+	return nil
+}
+
+// ClassRules returns the rules of a class, or unrestricted defaults with no
+// spellcasting for a class that has no class_rules row
+func (s *ClassService) ClassRules(ctx context.Context, className string) (*models.ClassRules, error) {
+	rules, err := s.classRepo.GetClassRules(ctx, className)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return models.DefaultClassRules(className), nil
+		}
+		return nil, err
+	}
+	return rules, nil
+}
+
 // EnrichCharacterWithClassData applies class-specific data to a character
 func (s *ClassService) EnrichCharacterWithClassData(ctx context.Context, character *models.Character) error {
 	// Get class data for this character's class and level
-	classData, err := s.classRepo.GetClassData(ctx, character.Class, character.Level)
+	classData, err := s.classRepo.GetClassData(ctx, character.Class, character.EffectiveLevel())
 	if err != nil {
 		return fmt.Errorf("failed to get class data: %v", err)
 	}
 
+	// Kindred modifiers adjust the attributes the derived stats are based on
+	if character.Kindred != "" && s.kindredRepo != nil {
+		kindred, err := s.kindredRepo.GetKindredByName(ctx, character.Kindred)
+		if err != nil {
+			logger.Warning("Failed to fetch kindred %s: %v", character.Kindred, err)
+		} else {
+			character.KindredTraits = kindred
+		}
+	}
+
 	// First calculate basic derived stats
 	character.CalculateDerivedStats()
 
@@ -179,297 +324,60 @@ func (s *ClassService) EnrichCharacterWithClassData(ctx context.Context, charact
 	character.CastingAbility = classData.CastingAbility
 	character.SpellSlots = classData.SpellSlots
 
-	// Set default save bonuses
-	character.DeathSaveBonus = 0
-	character.TransformationSaveBonus = 0
-	character.DeviceSaveBonus = 0
-	character.SorcerySaveBonus = 0
-	character.AvoidanceSaveBonus = 0
-
-	// Get class abilities using the class-specific method
-	var classAbilities []*models.ClassAbility
-
-	// Use a direct call to the appropriate class-specific ability method based on character's class
-	switch character.Class {
-	case "Barbarian":
-		classAbilities, err = s.classRepo.GetBarbarianAbilities(ctx, character.Level)
-	case "Berserker":
-		classAbilities, err = s.classRepo.GetBerserkerAbilities(ctx, character.Level)
-	case "Bard":
-		classAbilities, err = s.classRepo.GetBardAbilities(ctx, character.Level)
-	case "Cataphract":
-		classAbilities, err = s.classRepo.GetCataphractAbilities(ctx, character.Level)
-	case "Cleric":
-		classAbilities, err = s.classRepo.GetClericAbilities(ctx, character.Level)
-	case "Cryomancer":
-		classAbilities, err = s.classRepo.GetCryomancerAbilities(ctx, character.Level)
-	case "Druid":
-		classAbilities, err = s.classRepo.GetDruidAbilities(ctx, character.Level)
-	case "Fighter":
-		classAbilities, err = s.classRepo.GetFighterAbilities(ctx, character.Level)
-	case "Huntsman":
-		classAbilities, err = s.classRepo.GetHuntsmanAbilities(ctx, character.Level)
-	case "Illusionist":
-		classAbilities, err = s.classRepo.GetIllusionistAbilities(ctx, character.Level)
-	case "Legerdemainist":
-		classAbilities, err = s.classRepo.GetLegerdemainistAbilities(ctx, character.Level)
-	case "Magician":
-		classAbilities, err = s.classRepo.GetMagicianAbilities(ctx, character.Level)
-	case "Monk":
-		classAbilities, err = s.classRepo.GetMonkAbilities(ctx, character.Level)
-	case "Necromancer":
-		classAbilities, err = s.classRepo.GetNecromancerAbilities(ctx, character.Level)
-	case "Paladin":
-		classAbilities, err = s.classRepo.GetPaladinAbilities(ctx, character.Level)
-	case "Priest":
-		classAbilities, err = s.classRepo.GetPriestAbilities(ctx, character.Level)
-	case "Purloiner":
-		classAbilities, err = s.classRepo.GetPurloinerAbilities(ctx, character.Level)
-	case "Pyromancer":
-		classAbilities, err = s.classRepo.GetPyromancerAbilities(ctx, character.Level)
-	case "Ranger":
-		classAbilities, err = s.classRepo.GetRangerAbilities(ctx, character.Level)
-	case "Runegraver":
-		classAbilities, err = s.classRepo.GetRunegraverAbilities(ctx, character.Level)
-	case "Scout":
-		classAbilities, err = s.classRepo.GetScoutAbilities(ctx, character.Level)
-	case "Shaman":
-		classAbilities, err = s.classRepo.GetShamanAbilities(ctx, character.Level)
-	case "Thief":
-		classAbilities, err = s.classRepo.GetThiefAbilities(ctx, character.Level)
-	case "Warlock":
-		classAbilities, err = s.classRepo.GetWarlockAbilities(ctx, character.Level)
-	case "Witch":
-		classAbilities, err = s.classRepo.GetWitchAbilities(ctx, character.Level)
-	default:
-		// Fallback for any classes not specifically handled
-		classAbilities, err = s.classRepo.GetClassAbilitiesByLevel(ctx, character.Class, character.Level)
-	}
-
-	// Check for errors in ability retrieval, but don't fail the entire function
+	// Class rules drive save bonuses, armor restrictions and hooks
+	rules, err := s.ClassRules(ctx, character.Class)
 	if err != nil {
-		// Log the error but continue with the rest of the function
-		fmt.Printf("failed to fetch %s abilities: %v\n", character.Class, err)
-	}
-
-	// Apply class-specific data based on class type
-	switch character.Class {
-	// Warrior types
-	case "Fighter":
-		// Fighter-specific save bonuses
-		character.DeathSaveBonus = 2
-		character.TransformationSaveBonus = 2
-		character.DeviceSaveBonus = 0
-		character.SorcerySaveBonus = 0
-		character.AvoidanceSaveBonus = 0
-		if err := s.applyAgileBonus(ctx, character); err != nil {
-			// Log error but continue
-			fmt.Printf("failed to apply agile bonus: %v\n", err)
-		}
-
-	case "Barbarian":
-		// Barbarian-specific save bonuses
-		character.AvoidanceSaveBonus = 2
-		character.DeviceSaveBonus = 2
-		character.SorcerySaveBonus = 2
-		character.DeathSaveBonus = 2
-		character.TransformationSaveBonus = 2
-		character.SurpriseChance = 1
-
-		if err := s.applyExtraStr(ctx, character); err != nil {
-			// Log error but continue
-			fmt.Printf("failed to apply extra strength feat: %v\n", err)
-		}
-
-		if err := s.applyAgileBonus(ctx, character); err != nil {
-			// Log error but continue
-			fmt.Printf("failed to apply agile bonus: %v\n", err)
-		}
-
-		// Apply RUN ability - base 50 MV when lightly armored or unarmored
-		if err := s.applyRunAbility(ctx, character); err != nil {
-			// Log error but continue
-			fmt.Printf("failed to apply run ability: %v\n", err)
-		}
-
-	case "Berserker":
-		naturalAC, _ := s.classRepo.GetBerserkerNaturalAC(ctx, character.Level)
-		// Add to character struct or create a special field
-		character.Abilities = map[string]interface{}{
-			"natural_ac": naturalAC,
-		}
-		character.DeathSaveBonus = 2
-		character.TransformationSaveBonus = 2
-
-	case "Cataphract":
-		character.DeathSaveBonus = 2
-		character.TransformationSaveBonus = 2
-
-	case "Huntsman":
-		character.DeathSaveBonus = 2
-		character.AvoidanceSaveBonus = 2
-
-	// Divine casters
-	case "Cleric":
-		turningAbility, _ := s.classRepo.GetClericTurningAbility(ctx, character.Level)
-		character.TurningAbility = turningAbility
-		character.TransformationSaveBonus = 2
-		character.SorcerySaveBonus = 2
-
-	case "Druid":
-		character.TransformationSaveBonus = 2
-		character.SorcerySaveBonus = 2
-
-	case "Paladin":
-		turningAbility, _ := s.classRepo.GetPaladinTurningAbility(ctx, character.Level)
-		character.TurningAbility = turningAbility
-		character.DeathSaveBonus = 2
-		character.TransformationSaveBonus = 2
-
-	case "Priest":
-		character.TransformationSaveBonus = 2
-		character.SorcerySaveBonus = 2
-
-	// Arcane casters
-	case "Magician":
-		character.DeviceSaveBonus = 2
-		character.SorcerySaveBonus = 2
-
-	case "Cryomancer":
-		character.DeviceSaveBonus = 2
-		character.SorcerySaveBonus = 2
-
-	case "Illusionist":
-		character.DeviceSaveBonus = 2
-		character.SorcerySaveBonus = 2
-
-	case "Necromancer":
-		turningAbility, _ := s.classRepo.GetNecromancerTurningAbility(ctx, character.Level)
-		character.TurningAbility = turningAbility
-		character.DeviceSaveBonus = 2
-		character.SorcerySaveBonus = 2
-
-	case "Pyromancer":
-		character.DeviceSaveBonus = 2
-		character.SorcerySaveBonus = 2
-
-	case "Witch":
-		character.DeviceSaveBonus = 2
-		character.SorcerySaveBonus = 2
-
-	case "Warlock":
-		character.DeviceSaveBonus = 2
-		character.SorcerySaveBonus = 2
-
-	// Hybrid and special classes
-	case "Ranger":
-		specialSlots, _ := s.classRepo.GetSpecialClassSpellSlots(ctx, character.Class, character.Level)
-		if specialSlots != nil {
-			// Add special slots to character
-			if character.SpellSlots == nil {
-				character.SpellSlots = specialSlots
-			} else {
-				// Merge the maps
-				for k, v := range specialSlots {
-					character.SpellSlots[k] = v
-				}
-			}
-		}
-		character.DeathSaveBonus = 2
-		character.AvoidanceSaveBonus = 2
+		logger.Error("Failed to fetch %s rules: %v", character.Class, err)
+		rules = models.DefaultClassRules(character.Class)
+	}
 
-	case "Runegraver":
-		runesPerDay, _ := s.classRepo.GetRunegraverRunesPerDay(ctx, character.Level)
-		if character.Abilities == nil {
-			character.Abilities = map[string]interface{}{
-				"runes_per_day": runesPerDay,
-			}
-		} else if abilities, ok := character.Abilities.(map[string]interface{}); ok {
-			abilities["runes_per_day"] = runesPerDay
-			character.Abilities = abilities
-		}
-		character.DeathSaveBonus = 2
-		character.DeviceSaveBonus = 2
-
-	case "Shaman":
-		specialSlots, _ := s.classRepo.GetSpecialClassSpellSlots(ctx, character.Class, character.Level)
-		if specialSlots != nil {
-			// Merge with existing spell slots
-			if character.SpellSlots == nil {
-				character.SpellSlots = specialSlots
-			} else {
-				for k, v := range specialSlots {
-					character.SpellSlots[k] = v
-				}
-			}
-		}
-		character.TransformationSaveBonus = 2
-		character.SorcerySaveBonus = 2
-
-	case "Bard":
-		specialSlots, _ := s.classRepo.GetSpecialClassSpellSlots(ctx, character.Class, character.Level)
-		if specialSlots != nil {
-			if character.SpellSlots == nil {
-				character.SpellSlots = specialSlots
-			} else {
-				for k, v := range specialSlots {
-					character.SpellSlots[k] = v
-				}
-			}
-		}
-		character.DeviceSaveBonus = 2
-		character.AvoidanceSaveBonus = 2
+	character.DeathSaveBonus = rules.DeathSaveBonus
+	character.TransformationSaveBonus = rules.TransformationSaveBonus
+	character.DeviceSaveBonus = rules.DeviceSaveBonus
+	character.SorcerySaveBonus = rules.SorcerySaveBonus
+	character.AvoidanceSaveBonus = rules.AvoidanceSaveBonus
+	if rules.SurpriseChance != nil {
+		character.SurpriseChance = *rules.SurpriseChance
+	}
+	character.MaxArmor = rules.MaxArmor
+	character.ShieldsAllowed = rules.ShieldsAllowed
 
-	case "Monk":
-		acBonus, _ := s.classRepo.GetMonkACBonus(ctx, character.Level)
-		emptyHandDamage, _ := s.classRepo.GetMonkEmptyHandDamage(ctx, character.Level)
+	turningAbility, err := s.classRepo.GetTurningAbility(ctx, character.Class, character.EffectiveLevel())
+	if err != nil {
+		logger.Error("Failed to fetch %s turning ability: %v", character.Class, err)
+	}
+	character.TurningAbility = turningAbility
 
-		if character.Abilities == nil {
-			character.Abilities = map[string]interface{}{
-				"ac_bonus":          acBonus,
-				"empty_hand_damage": emptyHandDamage,
-			}
-		} else if abilities, ok := character.Abilities.(map[string]interface{}); ok {
-			abilities["ac_bonus"] = acBonus
-			abilities["empty_hand_damage"] = emptyHandDamage
-			character.Abilities = abilities
+	extraSlots, err := s.classRepo.GetExtraSpellSlots(ctx, character.Class, character.EffectiveLevel())
+	if err != nil {
+		logger.Error("Failed to fetch %s extra spell slots: %v", character.Class, err)
+	}
+	if len(extraSlots) > 0 {
+		if character.SpellSlots == nil {
+			character.SpellSlots = map[string]int{}
 		}
-		character.DeathSaveBonus = 2
-		character.AvoidanceSaveBonus = 2
-
-	// Rogue-types
-	case "Thief":
-		character.DeviceSaveBonus = 2
-		character.AvoidanceSaveBonus = 2
-
-		if err := s.applyAgileBonus(ctx, character); err != nil {
-			fmt.Printf("failed to apply agile bonus: %v\n", err)
+		for k, v := range extraSlots {
+			character.SpellSlots[k] = v
 		}
+	}
 
-		if err := s.applyExtraDex(ctx, character); err != nil {
-			// Log error but continue
-			fmt.Printf("failed to apply extra strength feat: %v\n", err)
+	for _, hook := range rules.Hooks {
+		if err := s.applyClassHook(ctx, character, hook); err != nil {
+			logger.Error("Failed to apply %s hook: %v", hook, err)
 		}
+	}
 
-	case "Assassin":
-		character.DeathSaveBonus = 2
-		character.AvoidanceSaveBonus = 2
-
-	case "Legerdemainist":
-		character.DeviceSaveBonus = 2
-		character.AvoidanceSaveBonus = 2
-
-	case "Purloiner":
-		character.DeviceSaveBonus = 2
-		character.AvoidanceSaveBonus = 2
+	if err := s.checkArmorRestrictions(ctx, character, rules); err != nil {
+		logger.Error("Failed to check armor restrictions: %v", err)
+	}
 
-	case "Scout":
-		character.DeathSaveBonus = 2
-		character.AvoidanceSaveBonus = 2
+	classAbilities, err := s.classRepo.GetClassAbilitiesByLevel(ctx, character.Class, character.EffectiveLevel())
+	if err != nil {
+		logger.Error("Failed to fetch %s abilities: %v", character.Class, err)
 	}
 
 	// Add class abilities to character.Abilities if we successfully retrieved them
-	if classAbilities != nil && len(classAbilities) > 0 {
+	if len(classAbilities) > 0 {
 		// If character.Abilities is nil, initialize it
 		if character.Abilities == nil {
 			character.Abilities = map[string]interface{}{
@@ -522,14 +430,16 @@ func (s *ClassService) CalculateMaxSpellsPerLevel(ctx context.Context, character
 		return nil, err
 	}
 
-	// Get casting stat modifier based on class
+	// Get casting stat modifier based on the class's casting attribute
+	rules, err := s.ClassRules(ctx, character.Class)
+	if err != nil {
+		return nil, err
+	}
 	var modifier int
-	switch character.Class {
-	case "Magician", "Illusionist", "Necromancer", "Pyromancer", "Cryo-mancer":
-		// Intelligence-based casters
+	switch rules.CastingAttribute {
+	case models.AttributeIntelligence:
 		modifier = character.RangedModifier // Use the pre-calculated modifier
-	case "Cleric", "Druid", "Witch":
-		// Wisdom-based casters
+	case models.AttributeWisdom:
 		modifier = character.WillpowerModifier // Use the pre-calculated modifier
 	default:
 		// Non-casting class
@@ -537,7 +447,7 @@ func (s *ClassService) CalculateMaxSpellsPerLevel(ctx context.Context, character
 	}
 
 	// Get level data to determine base number of spells
-	levelData, err := s.classRepo.GetClassData(ctx, character.Class, character.Level)
+	levelData, err := s.classRepo.GetClassData(ctx, character.Class, character.EffectiveLevel())
 	if err != nil {
 		return nil, err
 	}
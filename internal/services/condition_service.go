@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+)
+
+// ConditionService puts conditions such as blinded or blessed on characters
+// and takes them off again
+type ConditionService struct {
+	conditionRepo repositories.ConditionRepository
+	characterRepo repositories.CharacterRepository
+}
+
+// NewConditionService creates a new condition service
+func NewConditionService(
+	conditionRepo repositories.ConditionRepository,
+	characterRepo repositories.CharacterRepository,
+) *ConditionService {
+	return &ConditionService{
+		conditionRepo: conditionRepo,
+		characterRepo: characterRepo,
+	}
+}
+
+// GetCharacterConditions lists the conditions currently on a character
+func (s *ConditionService) GetCharacterConditions(ctx context.Context, characterID int64) ([]*models.CharacterCondition, error) {
+	return s.conditionRepo.GetCharacterConditions(ctx, characterID)
+}
+
+// ApplyCondition puts a condition on a character. A character cannot suffer
+// the same condition twice; remove the existing one to restart its duration.
+func (s *ConditionService) ApplyCondition(ctx context.Context, characterID int64, input *models.ApplyConditionInput) (*models.CharacterCondition, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	condition, err := s.conditionRepo.GetCondition(ctx, input.ConditionID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, existing := range character.Conditions {
+		if existing.ConditionID == condition.ID {
+			return nil, apperrors.NewConflict(fmt.Sprintf("%s is already %s", character.Name, condition.Name))
+		}
+	}
+
+	id, err := s.conditionRepo.AddCharacterCondition(ctx, characterID, condition.ID, input.Source, input.TotalRounds())
+	if err != nil {
+		return nil, err
+	}
+	return s.conditionRepo.GetCharacterCondition(ctx, characterID, id)
+}
+
+// RemoveCondition ends a condition on a character, such as when it is cured
+func (s *ConditionService) RemoveCondition(ctx context.Context, characterID, id int64) error {
+	return s.conditionRepo.RemoveCharacterCondition(ctx, characterID, id)
+}
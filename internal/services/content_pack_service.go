@@ -0,0 +1,287 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+)
+
+// MaxContentPackSize bounds an uploaded content pack
+const MaxContentPackSize = 10 << 20
+
+// MaxContentPackFiles bounds the number of files in a content pack archive
+const MaxContentPackFiles = 500
+
+// MaxContentPackFileSize bounds each file read from a content pack archive, so
+// a small archive cannot expand into an unbounded amount of memory
+const MaxContentPackFileSize = 1 << 20
+
+// contentPackManifests are the names of the file at the root of a pack
+// directory that holds the pack's metadata and, optionally, inline entries
+var contentPackManifests = []string{"pack.json", "pack.yaml", "pack.yml"}
+
+// contentPackEntryPatterns match the entry files in a pack's directories
+var contentPackEntryPatterns = []string{"*.json", "*.yaml", "*.yml"}
+
+// ContentPackService imports, validates and uninstalls homebrew content packs
+type ContentPackService struct {
+	packRepo repositories.ContentPackRepository
+}
+
+// NewContentPackService creates a new content pack service
+func NewContentPackService(packRepo repositories.ContentPackRepository) *ContentPackService {
+	return &ContentPackService{
+		packRepo: packRepo,
+	}
+}
+
+// ParseContentPack reads a pack from a zip archive of a pack directory or
+// from a single JSON or YAML document holding the manifest with its entries
+// inline
+func (s *ContentPackService) ParseContentPack(data []byte) (*models.ContentPack, error) {
+	if bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return nil, apperrors.NewBadRequest("Invalid content pack archive")
+		}
+		if len(archive.File) > MaxContentPackFiles {
+			return nil, apperrors.NewBadRequest(fmt.Sprintf("Content pack archive cannot contain more than %d files", MaxContentPackFiles))
+		}
+		return loadContentPackFS(archive)
+	}
+
+	pack := &models.ContentPack{}
+	file := "pack.yaml"
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		file = "pack.json"
+	}
+	if err := decodeContentPackFile(data, file, pack); err != nil {
+		return nil, err
+	}
+	return pack, nil
+}
+
+// loadContentPackFS reads a pack directory: a pack.json or pack.yaml manifest
+// plus optional classes/, spells/, weapons/ and armors/ directories holding one
+// JSON or YAML entry per file. The directory may also be nested one level
+// down, as when a folder is zipped.
+func loadContentPackFS(fsys fs.FS) (*models.ContentPack, error) {
+	manifestPath, err := findContentPackManifest(fsys)
+	if err != nil {
+		return nil, err
+	}
+	root := path.Dir(manifestPath)
+
+	data, err := readContentPackFile(fsys, manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	pack := &models.ContentPack{}
+	if err := decodeContentPackFile(data, manifestPath, pack); err != nil {
+		return nil, err
+	}
+
+	classes, err := loadContentPackEntries[models.ContentPackClass](fsys, path.Join(root, "classes"))
+	if err != nil {
+		return nil, err
+	}
+	spells, err := loadContentPackEntries[models.CreateSpellInput](fsys, path.Join(root, "spells"))
+	if err != nil {
+		return nil, err
+	}
+	weapons, err := loadContentPackEntries[models.CreateWeaponInput](fsys, path.Join(root, "weapons"))
+	if err != nil {
+		return nil, err
+	}
+	armors, err := loadContentPackEntries[models.CreateArmorInput](fsys, path.Join(root, "armors"))
+	if err != nil {
+		return nil, err
+	}
+
+	pack.Classes = append(pack.Classes, classes...)
+	pack.Spells = append(pack.Spells, spells...)
+	pack.Weapons = append(pack.Weapons, weapons...)
+	pack.Armors = append(pack.Armors, armors...)
+	return pack, nil
+}
+
+// findContentPackManifest returns the path of the single manifest at the root
+// of the pack or one directory down
+func findContentPackManifest(fsys fs.FS) (string, error) {
+	var found []string
+	for _, pattern := range []string{".", "*"} {
+		for _, manifest := range contentPackManifests {
+			matches, err := fs.Glob(fsys, path.Join(pattern, manifest))
+			if err != nil {
+				return "", apperrors.NewBadRequest("Invalid content pack archive")
+			}
+			found = append(found, matches...)
+		}
+		if len(found) > 0 {
+			break
+		}
+	}
+	if len(found) != 1 {
+		return "", apperrors.NewBadRequest("Content pack must contain a single pack.json or pack.yaml")
+	}
+	return found[0], nil
+}
+
+// loadContentPackEntries decodes every JSON and YAML file in dir, in name
+// order. A missing directory yields no entries.
+func loadContentPackEntries[T any](fsys fs.FS, dir string) ([]T, error) {
+	var files []string
+	for _, pattern := range contentPackEntryPatterns {
+		matches, err := fs.Glob(fsys, path.Join(dir, pattern))
+		if err != nil {
+			return nil, apperrors.NewBadRequest("Invalid content pack directory " + dir)
+		}
+		files = append(files, matches...)
+	}
+	sort.Strings(files)
+
+	entries := make([]T, 0, len(files))
+	for _, file := range files {
+		data, err := readContentPackFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+		var entry T
+		if err := decodeContentPackFile(data, file, &entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// readContentPackFile reads a file of at most MaxContentPackFileSize bytes
+func readContentPackFile(fsys fs.FS, file string) ([]byte, error) {
+	f, err := fsys.Open(file)
+	if err != nil {
+		return nil, apperrors.NewBadRequest("Could not read " + file)
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, MaxContentPackFileSize+1))
+	if err != nil {
+		return nil, apperrors.NewBadRequest("Could not read " + file)
+	}
+	if len(data) > MaxContentPackFileSize {
+		return nil, apperrors.NewBadRequest(fmt.Sprintf("%s is larger than %d bytes", file, MaxContentPackFileSize))
+	}
+	return data, nil
+}
+
+// decodeContentPackFile decodes a JSON or YAML file, chosen by its extension
+func decodeContentPackFile(data []byte, file string, v interface{}) error {
+	switch path.Ext(file) {
+	case ".yaml", ".yml":
+		return decodeContentPackYAML(data, file, v)
+	}
+	return decodeContentPackJSON(data, file, v)
+}
+
+// decodeContentPackJSON decodes strictly so misspelled fields are reported
+// rather than silently dropped
+func decodeContentPackJSON(data []byte, file string, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return apperrors.NewBadRequest(fmt.Sprintf("Invalid JSON in %s: %v", file, err))
+	}
+	return nil
+}
+
+// decodeContentPackYAML converts YAML to JSON so entries are read through the
+// same field names and strict decoding as JSON packs
+func decodeContentPackYAML(data []byte, file string, v interface{}) error {
+	var document interface{}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return apperrors.NewBadRequest(fmt.Sprintf("Invalid YAML in %s: %v", file, err))
+	}
+	converted, err := json.Marshal(document)
+	if err != nil {
+		return apperrors.NewBadRequest(fmt.Sprintf("Invalid YAML in %s: %v", file, err))
+	}
+	decoder := json.NewDecoder(bytes.NewReader(converted))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return apperrors.NewBadRequest(fmt.Sprintf("Invalid YAML in %s: %v", file, err))
+	}
+	return nil
+}
+
+// ValidateContentPack checks a pack's contents and that its namespace is free
+func (s *ContentPackService) ValidateContentPack(ctx context.Context, pack *models.ContentPack) error {
+	if err := pack.Validate(); err != nil {
+		return err
+	}
+
+	_, err := s.packRepo.GetContentPackByNamespace(ctx, pack.Namespace)
+	if err == nil {
+		return apperrors.NewConflict(fmt.Sprintf("A content pack with namespace %q is already installed", pack.Namespace))
+	}
+	if !apperrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// InstallContentPack validates and installs a pack, returning the installed record
+func (s *ContentPackService) InstallContentPack(ctx context.Context, pack *models.ContentPack, installedBy int64) (*models.InstalledContentPack, error) {
+	if err := s.ValidateContentPack(ctx, pack); err != nil {
+		return nil, err
+	}
+
+	id, err := s.packRepo.InstallContentPack(ctx, pack, installedBy)
+	if err != nil {
+		return nil, err
+	}
+	return s.packRepo.GetContentPack(ctx, id)
+}
+
+// UninstallContentPack removes a pack once nothing uses its entries
+func (s *ContentPackService) UninstallContentPack(ctx context.Context, id int64) error {
+	pack, err := s.packRepo.GetContentPack(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var inUse []string
+	for _, entry := range pack.Entries {
+		count, err := s.packRepo.CountEntryReferences(ctx, entry)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			inUse = append(inUse, fmt.Sprintf("%s %s (%d)", entry.Type, entry.Name, count))
+		}
+	}
+	if len(inUse) > 0 {
+		return apperrors.NewConflict("Content pack is still in use: " + strings.Join(inUse, ", "))
+	}
+
+	return s.packRepo.UninstallContentPack(ctx, id)
+}
+
+func (s *ContentPackService) GetContentPack(ctx context.Context, id int64) (*models.InstalledContentPack, error) {
+	return s.packRepo.GetContentPack(ctx, id)
+}
+
+func (s *ContentPackService) ListContentPacks(ctx context.Context) ([]*models.InstalledContentPack, error) {
+	return s.packRepo.ListContentPacks(ctx)
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	"mordezzanV4/internal/dice"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/logger"
+)
+
+// DiceService rolls dice expressions and weapon damage for characters
+type DiceService struct {
+	roller             *dice.Roller
+	weaponStatsService *WeaponStatsService
+}
+
+// DiceRoll is the outcome of a roll along with the seed that reproduces it
+type DiceRoll struct {
+	*dice.Result
+	Seed            int64  `json:"seed"`
+	CharacterID     int64  `json:"character_id,omitempty"`
+	InventoryItemID int64  `json:"inventory_item_id,omitempty"`
+	Weapon          string `json:"weapon,omitempty"`
+}
+
+// NewDiceService creates a new dice service
+func NewDiceService(roller *dice.Roller, weaponStatsService *WeaponStatsService) *DiceService {
+	return &DiceService{
+		roller:             roller,
+		weaponStatsService: weaponStatsService,
+	}
+}
+
+// Roller returns the shared roller used by other services
+func (s *DiceService) Roller() *dice.Roller {
+	return s.roller
+}
+
+// Roll evaluates an expression. When seed is nil a fresh seed is drawn so the
+// returned roll can still be reproduced later.
+func (s *DiceService) Roll(expr string, seed *int64) (*DiceRoll, error) {
+	roller := s.rollerFor(seed)
+
+	result, err := roller.Roll(expr)
+	if err != nil {
+		return nil, apperrors.NewValidationError("expression", err.Error())
+	}
+
+	return &DiceRoll{
+		Result: result,
+		Seed:   roller.Seed(),
+	}, nil
+}
+
+// RollWeaponDamage rolls the final damage of a weapon in the character's inventory
+func (s *DiceService) RollWeaponDamage(ctx context.Context, characterID, inventoryItemID int64, twoHanded bool, seed *int64) (*DiceRoll, error) {
+	weaponStats, err := s.weaponStatsService.CalculateCharacterWeaponStats(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats *WeaponStats
+	for _, ws := range weaponStats {
+		if ws.InventoryItem != nil && ws.InventoryItem.ID == inventoryItemID {
+			stats = ws
+			break
+		}
+	}
+	if stats == nil {
+		return nil, apperrors.NewNotFound("weapon inventory item", inventoryItemID)
+	}
+
+	damage := stats.FinalDamage
+	if twoHanded {
+		if !hasDamageRoll(stats.Weapon.DamageTwoHanded) {
+			return nil, apperrors.NewValidationError("two_handed", "This weapon has no two-handed damage")
+		}
+		damage = formatDamageWithBonus(stats.Weapon.DamageTwoHanded, stats.DamageBonus)
+	} else if !hasDamageRoll(stats.BaseDamage) {
+		return nil, apperrors.NewValidationError("inventory_item_id", "This weapon has no damage roll")
+	}
+
+	logger.Debug("Rolling weapon damage %s for character %d, item %d", damage, characterID, inventoryItemID)
+
+	roll, err := s.Roll(damage, seed)
+	if err != nil {
+		logger.Error("Weapon %s has unparseable damage %q: %v", stats.Weapon.Name, damage, err)
+		return nil, apperrors.NewInternalError(err)
+	}
+
+	roll.CharacterID = characterID
+	roll.InventoryItemID = inventoryItemID
+	roll.Weapon = stats.Weapon.Name
+	return roll, nil
+}
+
+func (s *DiceService) rollerFor(seed *int64) *dice.Roller {
+	if seed != nil {
+		return dice.NewRoller(*seed)
+	}
+	return dice.NewRoller(s.roller.Int63())
+}
+
+// hasDamageRoll reports whether a weapon damage field holds an actual value;
+// the catalog uses "-" or an empty string for weapons without one
+func hasDamageRoll(damage string) bool {
+	damage = strings.TrimSpace(damage)
+	return damage != "" && damage != "-"
+}
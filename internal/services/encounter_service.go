@@ -0,0 +1,437 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"mordezzanV4/internal/dice"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+)
+
+// EncounterService runs combat encounters: who is in them, the order they
+// act in, and the hit points they lose along the way
+type EncounterService struct {
+	encounterRepo       repositories.EncounterRepository
+	monsterRepo         repositories.MonsterRepository
+	characterRepo       repositories.CharacterRepository
+	classService        *ClassService
+	accessService       *CharacterAccessService
+	activeEffectService *ActiveEffectService
+	vitalityService     *VitalityService
+	roller              *dice.Roller
+}
+
+// NewEncounterService creates a new encounter service
+func NewEncounterService(
+	encounterRepo repositories.EncounterRepository,
+	monsterRepo repositories.MonsterRepository,
+	characterRepo repositories.CharacterRepository,
+	classService *ClassService,
+	accessService *CharacterAccessService,
+	activeEffectService *ActiveEffectService,
+	vitalityService *VitalityService,
+	roller *dice.Roller,
+) *EncounterService {
+	return &EncounterService{
+		encounterRepo:       encounterRepo,
+		monsterRepo:         monsterRepo,
+		characterRepo:       characterRepo,
+		classService:        classService,
+		accessService:       accessService,
+		activeEffectService: activeEffectService,
+		vitalityService:     vitalityService,
+		roller:              roller,
+	}
+}
+
+// CreateEncounter starts an empty encounter for the user
+func (s *EncounterService) CreateEncounter(ctx context.Context, userID int64, input *models.CreateEncounterInput) (*models.Encounter, error) {
+	id, err := s.encounterRepo.CreateEncounter(ctx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetEncounter(ctx, userID, id)
+}
+
+// GetEncounter retrieves one of the user's encounters with its participants
+// in initiative order and the one whose turn it is
+func (s *EncounterService) GetEncounter(ctx context.Context, userID, id int64) (*models.Encounter, error) {
+	encounter, err := s.encounterRepo.GetEncounter(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if encounter.UserID != userID {
+		return nil, apperrors.NewForbidden("You do not have access to this encounter")
+	}
+	if encounter.Round > 0 && encounter.Turn < len(encounter.Participants) {
+		encounter.Current = encounter.Participants[encounter.Turn]
+	}
+	return encounter, nil
+}
+
+// GetEncounters lists the user's encounters, newest first
+func (s *EncounterService) GetEncounters(ctx context.Context, userID int64) ([]*models.Encounter, error) {
+	return s.encounterRepo.GetEncountersByUser(ctx, userID)
+}
+
+// DeleteEncounter removes one of the user's encounters
+func (s *EncounterService) DeleteEncounter(ctx context.Context, userID, id int64) error {
+	if _, err := s.GetEncounter(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.encounterRepo.DeleteEncounter(ctx, id)
+}
+
+// AddParticipants adds a character the user may play, copies of a bestiary
+// monster with rolled hit points, or ad-hoc monsters to the encounter
+func (s *EncounterService) AddParticipants(ctx context.Context, userID, encounterID int64, input *models.AddParticipantInput) (*models.Encounter, error) {
+	encounter, err := s.GetEncounter(ctx, userID, encounterID)
+	if err != nil {
+		return nil, err
+	}
+
+	var participants []*models.EncounterParticipant
+	switch {
+	case input.CharacterID != 0:
+		participant, err := s.characterParticipant(ctx, userID, encounter, input)
+		if err != nil {
+			return nil, err
+		}
+		participants = append(participants, participant)
+
+	case input.MonsterID != 0:
+		monster, err := s.monsterRepo.GetMonster(ctx, input.MonsterID)
+		if err != nil {
+			return nil, err
+		}
+		hitPoints, err := dice.Parse(monster.HitPointsExpression())
+		if err != nil {
+			return nil, apperrors.NewInternalError(err)
+		}
+		name := monster.Name
+		if input.Name != "" {
+			name = input.Name
+		}
+		armorClass := monster.ArmorClass
+		if input.ArmorClass != nil {
+			armorClass = *input.ArmorClass
+		}
+		for _, participant := range s.monsterParticipants(input, name) {
+			participant.MonsterID = &monster.ID
+			participant.ArmorClass = &armorClass
+			if input.HitPoints == 0 {
+				participant.MaxHP = max(hitPoints.Roll(s.roller).Total, 1)
+				participant.CurrentHP = participant.MaxHP
+			}
+			participants = append(participants, participant)
+		}
+
+	default:
+		participants = s.monsterParticipants(input, input.Name)
+	}
+
+	if err := s.encounterRepo.AddParticipants(ctx, encounterID, participants); err != nil {
+		return nil, err
+	}
+	return s.GetEncounter(ctx, userID, encounterID)
+}
+
+// RemoveParticipant takes a participant out of the encounter, keeping the
+// turn with whoever was acting
+func (s *EncounterService) RemoveParticipant(ctx context.Context, userID, encounterID, participantID int64) (*models.Encounter, error) {
+	encounter, err := s.GetEncounter(ctx, userID, encounterID)
+	if err != nil {
+		return nil, err
+	}
+	index := participantIndex(encounter, participantID)
+	if index < 0 {
+		return nil, apperrors.NewNotFound("encounter participant", participantID)
+	}
+
+	if err := s.encounterRepo.RemoveParticipant(ctx, encounterID, participantID); err != nil {
+		return nil, err
+	}
+	if encounter.Round > 0 && index < encounter.Turn {
+		if err := s.encounterRepo.UpdateTurn(ctx, encounterID, encounter.Round, encounter.Turn-1); err != nil {
+			return nil, err
+		}
+	}
+	return s.GetEncounter(ctx, userID, encounterID)
+}
+
+// RollInitiative checks surprise and rolls initiative, then starts the first
+// round with the first participant able to act. By side, each side rolls a
+// d6 and ties are rerolled; individually, every participant rolls a d6 and
+// characters add their dexterity adjustment. A surprised participant loses
+// the first round.
+func (s *EncounterService) RollInitiative(ctx context.Context, userID, encounterID int64, input *models.RollInitiativeInput) (*models.InitiativeResult, error) {
+	encounter, err := s.GetEncounter(ctx, userID, encounterID)
+	if err != nil {
+		return nil, err
+	}
+	if len(encounter.Participants) == 0 {
+		return nil, apperrors.NewValidationError("participants", "Add participants before rolling initiative")
+	}
+
+	mode := input.Mode
+	if mode == "" {
+		mode = encounter.InitiativeMode
+	}
+	opponentChance := input.OpponentSurpriseChance
+	if opponentChance == 0 {
+		opponentChance = models.DefaultMonsterSurpriseChance
+	}
+
+	characters := make(map[int64]*models.Character)
+	for _, participant := range encounter.Participants {
+		if !participant.IsCharacter() {
+			continue
+		}
+		if _, ok := characters[*participant.CharacterID]; ok {
+			continue
+		}
+		character, err := s.characterRepo.GetCharacter(ctx, *participant.CharacterID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.classService.EnrichCharacterWithClassData(ctx, character); err != nil {
+			return nil, apperrors.NewInternalError(err)
+		}
+		characters[character.ID] = character
+	}
+
+	result := &models.InitiativeResult{}
+	if mode == models.InitiativeBySide {
+		result.SideInitiative = s.rollSideInitiative(encounter.Participants)
+	}
+
+	for _, participant := range encounter.Participants {
+		var character *models.Character
+		if participant.IsCharacter() {
+			character = characters[*participant.CharacterID]
+		}
+
+		initiative := result.SideInitiative[participant.Side]
+		if mode == models.InitiativeByIndividual {
+			initiative = s.roller.Die(models.InitiativeDie)
+			if character != nil {
+				initiative += character.DefenceAdjustment
+			}
+		}
+		participant.Initiative = &initiative
+
+		participant.Surprised = false
+		if input.CheckSurprise {
+			chance := opponentChance
+			if character != nil {
+				chance = character.SurpriseChance
+			}
+			participant.Surprised = s.roller.Die(models.InitiativeDie) <= chance
+		}
+	}
+
+	if err := s.encounterRepo.SetInitiative(ctx, encounterID, mode, encounter.Participants); err != nil {
+		return nil, err
+	}
+
+	// Participants are reloaded in their new order to find who acts first
+	encounter, err = s.GetEncounter(ctx, userID, encounterID)
+	if err != nil {
+		return nil, err
+	}
+	if round, turn, ok := nextActor(encounter.Participants, 1, -1); ok && (round != 1 || turn != 0) {
+		if err := s.encounterRepo.UpdateTurn(ctx, encounterID, round, turn); err != nil {
+			return nil, err
+		}
+	}
+
+	result.Encounter, err = s.GetEncounter(ctx, userID, encounterID)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NextTurn passes the turn to the next participant able to act, skipping the
+// fallen and, in the first round, the surprised. When a new round begins one
+// round passes for each character, so their timed effects and conditions run
+// down and the dying lose hit points.
+func (s *EncounterService) NextTurn(ctx context.Context, userID, encounterID int64) (*models.NextTurnResult, error) {
+	encounter, err := s.GetEncounter(ctx, userID, encounterID)
+	if err != nil {
+		return nil, err
+	}
+	if encounter.Round == 0 {
+		return nil, apperrors.NewValidationError("round", "Roll initiative before taking turns")
+	}
+
+	round, turn, ok := nextActor(encounter.Participants, encounter.Round, encounter.Turn)
+	if !ok {
+		return nil, apperrors.NewValidationError("participants", "No participant is able to act")
+	}
+
+	result := &models.NextTurnResult{NewRound: round > encounter.Round}
+	if result.NewRound {
+		// A new round changes every character in the encounter, so the user
+		// must be able to edit all of them before any is advanced. Dead and
+		// retired characters are out of play and are left as they are.
+		characterIDs := []int64{}
+		for _, participant := range encounter.Participants {
+			if !participant.IsCharacter() {
+				continue
+			}
+			if err := s.accessService.AuthorizeCharacter(ctx, userID, *participant.CharacterID, true); err != nil {
+				return nil, err
+			}
+			character, err := s.characterRepo.GetCharacter(ctx, *participant.CharacterID)
+			if err != nil {
+				return nil, err
+			}
+			if !character.IsReadOnly() {
+				characterIDs = append(characterIDs, character.ID)
+			}
+		}
+
+		result.Expired = make(map[int64]*models.AdvanceTimeResult)
+		for _, characterID := range characterIDs {
+			advanced, err := s.activeEffectService.AdvanceTime(ctx, characterID, &models.AdvanceTimeInput{Rounds: round - encounter.Round})
+			if err != nil {
+				return nil, err
+			}
+			if len(advanced.Expired) > 0 || len(advanced.ExpiredConditions) > 0 || advanced.HitPointsLost > 0 {
+				result.Expired[characterID] = advanced
+			}
+		}
+	}
+
+	if err := s.encounterRepo.UpdateTurn(ctx, encounterID, round, turn); err != nil {
+		return nil, err
+	}
+	result.Encounter, err = s.GetEncounter(ctx, userID, encounterID)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ModifyParticipantHP damages or heals a participant. Characters go through
+// the same rules as changing their hit points directly; monsters stay
+// between zero and their maximum.
+func (s *EncounterService) ModifyParticipantHP(ctx context.Context, userID, encounterID, participantID int64, input *models.ParticipantHPInput) (*models.Encounter, error) {
+	encounter, err := s.GetEncounter(ctx, userID, encounterID)
+	if err != nil {
+		return nil, err
+	}
+	index := participantIndex(encounter, participantID)
+	if index < 0 {
+		return nil, apperrors.NewNotFound("encounter participant", participantID)
+	}
+	participant := encounter.Participants[index]
+
+	if participant.IsCharacter() {
+		if err := s.accessService.AuthorizeCharacter(ctx, userID, *participant.CharacterID, true); err != nil {
+			return nil, err
+		}
+		if _, err := s.vitalityService.ModifyHitPoints(ctx, *participant.CharacterID, input.Delta, input.Temp); err != nil {
+			return nil, err
+		}
+	} else {
+		if input.Temp {
+			return nil, apperrors.NewValidationError("temp", "Only characters gain temporary hit points")
+		}
+		currentHP := min(max(participant.CurrentHP+input.Delta, 0), participant.MaxHP)
+		if err := s.encounterRepo.UpdateParticipantHP(ctx, participantID, currentHP); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.GetEncounter(ctx, userID, encounterID)
+}
+
+// characterParticipant checks the user may play the character and that it is
+// not already in the encounter
+func (s *EncounterService) characterParticipant(ctx context.Context, userID int64, encounter *models.Encounter, input *models.AddParticipantInput) (*models.EncounterParticipant, error) {
+	if err := s.accessService.AuthorizeCharacter(ctx, userID, input.CharacterID, true); err != nil {
+		return nil, err
+	}
+	character, err := s.characterRepo.GetCharacter(ctx, input.CharacterID)
+	if err != nil {
+		return nil, err
+	}
+	for _, existing := range encounter.Participants {
+		if existing.IsCharacter() && *existing.CharacterID == character.ID {
+			return nil, apperrors.NewConflict(fmt.Sprintf("%s is already in %s", character.Name, encounter.Name))
+		}
+	}
+	return &models.EncounterParticipant{
+		Side:        input.Side,
+		CharacterID: &character.ID,
+		Name:        character.Name,
+		ArmorClass:  input.ArmorClass,
+	}, nil
+}
+
+// monsterParticipants builds the requested number of monsters, numbering
+// their names when there is more than one
+func (s *EncounterService) monsterParticipants(input *models.AddParticipantInput, name string) []*models.EncounterParticipant {
+	count := max(input.Count, 1)
+	participants := make([]*models.EncounterParticipant, count)
+	for i := range participants {
+		participant := &models.EncounterParticipant{
+			Side:       input.Side,
+			Name:       name,
+			ArmorClass: input.ArmorClass,
+			MaxHP:      input.HitPoints,
+			CurrentHP:  input.HitPoints,
+		}
+		if count > 1 {
+			participant.Name = fmt.Sprintf("%s %d", name, i+1)
+		}
+		participants[i] = participant
+	}
+	return participants
+}
+
+// rollSideInitiative rolls a d6 for each side present, rerolling ties
+func (s *EncounterService) rollSideInitiative(participants []*models.EncounterParticipant) map[string]int {
+	rolls := make(map[string]int)
+	for _, participant := range participants {
+		rolls[participant.Side] = 0
+	}
+	for {
+		for side := range rolls {
+			rolls[side] = s.roller.Die(models.InitiativeDie)
+		}
+		if len(rolls) < 2 || rolls[models.EncounterSideParty] != rolls[models.EncounterSideOpponents] {
+			return rolls
+		}
+	}
+}
+
+// nextActor finds the first participant after turn able to act, moving on to
+// the following round when the order is exhausted
+func nextActor(participants []*models.EncounterParticipant, round, turn int) (int, int, bool) {
+	for range 2 {
+		for i := turn + 1; i < len(participants); i++ {
+			if participants[i].CanAct(round) {
+				return round, i, true
+			}
+		}
+		round++
+		turn = -1
+	}
+	return 0, 0, false
+}
+
+// participantIndex returns the position of a participant in the encounter's
+// initiative order, or -1 if it is not in the encounter
+func participantIndex(encounter *models.Encounter, participantID int64) int {
+	for i, participant := range encounter.Participants {
+		if participant.ID == participantID {
+			return i
+		}
+	}
+	return -1
+}
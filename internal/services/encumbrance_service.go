@@ -19,6 +19,7 @@ type EncumbranceService struct {
 	ringRepo        repositories.RingRepository
 	ammoRepo        repositories.AmmoRepository
 	spellScrollRepo repositories.SpellScrollRepository
+	spellbookRepo   repositories.SpellbookRepository
 	containerRepo   repositories.ContainerRepository
 	equipmentRepo   repositories.EquipmentRepository
 	treasureRepo    repositories.TreasureRepository
@@ -36,6 +37,7 @@ func NewEncumbranceService(
 	ringRepo repositories.RingRepository,
 	ammoRepo repositories.AmmoRepository,
 	spellScrollRepo repositories.SpellScrollRepository,
+	spellbookRepo repositories.SpellbookRepository,
 	containerRepo repositories.ContainerRepository,
 	equipmentRepo repositories.EquipmentRepository,
 	treasureRepo repositories.TreasureRepository,
@@ -51,6 +53,7 @@ func NewEncumbranceService(
 		ringRepo:        ringRepo,
 		ammoRepo:        ammoRepo,
 		spellScrollRepo: spellScrollRepo,
+		spellbookRepo:   spellbookRepo,
 		containerRepo:   containerRepo,
 		equipmentRepo:   equipmentRepo,
 		treasureRepo:    treasureRepo,
@@ -65,8 +68,10 @@ func (s *EncumbranceService) GetCharacterEncumbrance(ctx context.Context, charac
 		return nil, err
 	}
 
-	// Calculate encumbrance thresholds based on attributes
-	thresholds := models.CalculateEncumbranceThresholds(character.Strength, character.Constitution)
+	// Calculate encumbrance thresholds based on current attributes, so that
+	// drained strength or a girdle of giant strength changes what can be carried
+	scores := character.EffectiveAttributes()
+	thresholds := models.CalculateEncumbranceThresholds(scores.Strength, scores.Constitution)
 
 	// Get inventory
 	inventory, err := s.inventoryRepo.GetInventoryByCharacter(ctx, characterID)
@@ -176,6 +181,12 @@ func (s *EncumbranceService) calculateWeightByType(ctx context.Context, inventor
 				itemName = "Scroll of " + scroll.SpellName
 			}
 			weightByType["scrolls"] += itemWeight * float64(item.Quantity)
+		case models.SpellbookItemType:
+			if spellbook, err := s.spellbookRepo.GetSpellbook(ctx, item.ItemID); err == nil {
+				itemWeight = spellbook.Weight
+				itemName = spellbook.Name
+				weightByType["spellbooks"] += itemWeight * float64(item.Quantity)
+			}
 		case "container":
 			if container, err := s.containerRepo.GetContainer(ctx, item.ItemID); err == nil {
 				itemWeight = float64(container.Weight)
@@ -0,0 +1,382 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"mordezzanV4/internal/dice"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+)
+
+// ItemUseService handles using items from a character's inventory
+type ItemUseService struct {
+	itemUseRepo        repositories.ItemUseRepository
+	characterRepo      repositories.CharacterRepository
+	spellRepo          repositories.SpellRepository
+	spellScrollRepo    repositories.SpellScrollRepository
+	magicItemRepo      repositories.MagicItemRepository
+	potionRepo         repositories.PotionRepository
+	activeEffectRepo   repositories.ActiveEffectRepository
+	classService       *ClassService
+	thiefSkillsService *ThiefSkillsService
+	roller             *dice.Roller
+}
+
+// NewItemUseService creates a new item use service
+func NewItemUseService(
+	itemUseRepo repositories.ItemUseRepository,
+	characterRepo repositories.CharacterRepository,
+	spellRepo repositories.SpellRepository,
+	spellScrollRepo repositories.SpellScrollRepository,
+	magicItemRepo repositories.MagicItemRepository,
+	potionRepo repositories.PotionRepository,
+	activeEffectRepo repositories.ActiveEffectRepository,
+	classService *ClassService,
+	thiefSkillsService *ThiefSkillsService,
+	roller *dice.Roller,
+) *ItemUseService {
+	return &ItemUseService{
+		itemUseRepo:        itemUseRepo,
+		characterRepo:      characterRepo,
+		spellRepo:          spellRepo,
+		spellScrollRepo:    spellScrollRepo,
+		magicItemRepo:      magicItemRepo,
+		potionRepo:         potionRepo,
+		activeEffectRepo:   activeEffectRepo,
+		classService:       classService,
+		thiefSkillsService: thiefSkillsService,
+		roller:             roller,
+	}
+}
+
+// UseItem uses an item from the character's inventory and logs the effect
+func (s *ItemUseService) UseItem(ctx context.Context, characterID, itemID int64, input *models.UseItemInput) (*models.ItemUseResult, error) {
+	item, err := s.itemUseRepo.GetCharacterInventoryItem(ctx, characterID, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch item.ItemType {
+	case models.SpellScrollItemType:
+		return s.readSpellScroll(ctx, characterID, item, input)
+	case models.MagicItemItemType:
+		return s.expendCharges(ctx, characterID, item, input)
+	case models.PotionItemType:
+		return s.drinkPotion(ctx, characterID, item, input)
+	default:
+		return nil, apperrors.NewBadRequest(fmt.Sprintf("Items of type %s cannot be used", item.ItemType))
+	}
+}
+
+// RechargeItem restores charges to a wand, rod or staff. Only spellcasters
+// of sufficient level can recharge an item, and never beyond the charges it
+// held when made.
+func (s *ItemUseService) RechargeItem(ctx context.Context, characterID, itemID int64, input *models.RechargeItemInput) (*models.ItemUseResult, error) {
+	item, err := s.itemUseRepo.GetCharacterInventoryItem(ctx, characterID, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if item.ItemType != models.MagicItemItemType {
+		return nil, apperrors.NewBadRequest(fmt.Sprintf("Items of type %s cannot be recharged", item.ItemType))
+	}
+
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := s.classService.ClassRules(ctx, character.Class)
+	if err != nil {
+		return nil, err
+	}
+	if rules.CastingClass == "" {
+		return nil, apperrors.NewValidationError("character", fmt.Sprintf("%s cannot recharge magic items", character.Class))
+	}
+	if character.EffectiveLevel() < models.MinRechargeLevel {
+		return nil, apperrors.NewValidationError("character",
+			fmt.Sprintf("%s must be level %d to recharge magic items", character.Name, models.MinRechargeLevel))
+	}
+
+	magicItem, err := s.magicItemRepo.GetMagicItem(ctx, item.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	if magicItem.Charges == nil {
+		return nil, apperrors.NewValidationError("item_id", fmt.Sprintf("%s does not hold charges", magicItem.Name))
+	}
+
+	current := 0
+	if item.Charges != nil {
+		current = *item.Charges
+	}
+	if current >= *magicItem.Charges {
+		return nil, apperrors.NewValidationError("charges", fmt.Sprintf("%s is already fully charged", magicItem.Name))
+	}
+	charges := min(current+input.Charges, *magicItem.Charges)
+
+	use := &models.ItemUse{
+		CharacterID:     characterID,
+		InventoryItemID: item.ID,
+		ItemType:        item.ItemType,
+		ItemID:          item.ItemID,
+		ItemName:        magicItem.Name,
+		Method:          models.ItemUseByRecharge,
+		Success:         true,
+		Effect: fmt.Sprintf("%s restores %d charges to %s, which now holds %d",
+			character.Name, charges-current, magicItem.Name, charges),
+	}
+
+	id, err := s.itemUseRepo.RecordChargeUse(ctx, use, charges)
+	if err != nil {
+		return nil, err
+	}
+	return s.chargeUseResult(ctx, id, item, charges)
+}
+
+// GetItemUses lists the items a character has used, newest first
+func (s *ItemUseService) GetItemUses(ctx context.Context, characterID int64) ([]*models.ItemUse, error) {
+	return s.itemUseRepo.GetItemUses(ctx, characterID)
+}
+
+// readSpellScroll casts the spell on a scroll. Casters read scrolls of
+// spells on their own list, risking a miscast when the spell is beyond the
+// level they can cast; thieves decipher arcane scrolls with their Read
+// Scrolls skill. The scroll is used up whether or not the reading succeeds,
+// and a roll of the die's highest face is a mishap.
+func (s *ItemUseService) readSpellScroll(ctx context.Context, characterID int64, item *models.InventoryItem, input *models.UseItemInput) (*models.ItemUseResult, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	scroll, err := s.spellScrollRepo.GetSpellScroll(ctx, item.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	spell, err := s.spellRepo.GetSpell(ctx, scroll.SpellID)
+	if err != nil {
+		return nil, err
+	}
+
+	use := &models.ItemUse{
+		CharacterID:     characterID,
+		InventoryItemID: item.ID,
+		ItemType:        item.ItemType,
+		ItemID:          item.ItemID,
+		ItemName:        fmt.Sprintf("Scroll of %s", spell.Name),
+		Target:          input.Target,
+	}
+
+	rules, err := s.classService.ClassRules(ctx, character.Class)
+	if err != nil {
+		return nil, err
+	}
+	castingClass := rules.CastingClass
+	if spellLevel := spell.GetLevel(castingClass); castingClass != "" && spellLevel > 0 {
+		use.Method = models.ScrollReadBySpellcasting
+		if chance := models.ScrollCastingChance(spellLevel, rules.MaxSpellLevel(character.EffectiveLevel())); chance < 100 {
+			use.Die = 100
+			use.Chance = chance
+		}
+	} else {
+		chance, err := s.readScrollsChance(ctx, character)
+		if err != nil {
+			return nil, err
+		}
+		if chance == 0 || !isArcaneSpell(spell) {
+			return nil, apperrors.NewValidationError("item_id", fmt.Sprintf("%s cannot read a scroll of %s", character.Name, spell.Name))
+		}
+		use.Method = models.ScrollReadByThiefSkill
+		use.Die = 12
+		use.Chance = chance
+	}
+
+	use.Success = true
+	if use.Die > 0 {
+		use.Roll = s.roller.Die(use.Die)
+		use.Success = use.Roll <= use.Chance
+		use.Mishap = !use.Success && use.Roll == use.Die
+	}
+
+	switch {
+	case use.Success:
+		use.Effect = fmt.Sprintf("%s is cast from the scroll at caster level %d", spell.Name, scroll.CastingLevel)
+	case use.Mishap:
+		use.Effect = fmt.Sprintf("The reading goes awry and %s takes effect with an unintended result", spell.Name)
+	default:
+		use.Effect = fmt.Sprintf("%s fails to invoke %s and the scroll's magic is lost", character.Name, spell.Name)
+	}
+
+	id, err := s.itemUseRepo.RecordUse(ctx, use, true)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.ItemUseResult{
+		RemainingQuantity: item.Quantity - 1,
+	}
+	result.Use, err = s.itemUseRepo.GetItemUse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// expendCharges uses charges from a wand, rod or staff. The item must hold
+// enough charges for the use, and one left with none is reported depleted.
+func (s *ItemUseService) expendCharges(ctx context.Context, characterID int64, item *models.InventoryItem, input *models.UseItemInput) (*models.ItemUseResult, error) {
+	magicItem, err := s.magicItemRepo.GetMagicItem(ctx, item.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	if item.Charges == nil {
+		return nil, apperrors.NewValidationError("item_id", fmt.Sprintf("%s does not hold charges", magicItem.Name))
+	}
+
+	spend := max(input.Charges, 1)
+	if *item.Charges < spend {
+		return nil, apperrors.NewValidationError("charges",
+			fmt.Sprintf("%s has only %d charges left", magicItem.Name, *item.Charges))
+	}
+	charges := *item.Charges - spend
+
+	use := &models.ItemUse{
+		CharacterID:     characterID,
+		InventoryItemID: item.ID,
+		ItemType:        item.ItemType,
+		ItemID:          item.ItemID,
+		ItemName:        magicItem.Name,
+		Method:          models.ItemUseByCharges,
+		Success:         true,
+		Target:          input.Target,
+		Effect:          fmt.Sprintf("%s expends %d of its charges, leaving %d", magicItem.Name, spend, charges),
+	}
+	if charges == 0 {
+		use.Effect = fmt.Sprintf("%s expends its last charges and is depleted", magicItem.Name)
+	}
+
+	id, err := s.itemUseRepo.RecordChargeUse(ctx, use, charges)
+	if err != nil {
+		return nil, err
+	}
+	return s.chargeUseResult(ctx, id, item, charges)
+}
+
+// drinkPotion takes one use of the open potion in a stack, starting its
+// effect for a rolled number of turns. A potion with no uses left is thrown
+// away and the next one in the stack opened.
+func (s *ItemUseService) drinkPotion(ctx context.Context, characterID int64, item *models.InventoryItem, input *models.UseItemInput) (*models.ItemUseResult, error) {
+	potion, err := s.potionRepo.GetPotion(ctx, item.ItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	uses := potion.Uses
+	if item.UsesRemaining != nil && *item.UsesRemaining > 0 {
+		uses = *item.UsesRemaining
+	}
+	usesLeft := uses - 1
+
+	use := &models.ItemUse{
+		CharacterID:     characterID,
+		InventoryItemID: item.ID,
+		ItemType:        item.ItemType,
+		ItemID:          item.ItemID,
+		ItemName:        potion.Name,
+		Method:          models.ItemUseByDrinking,
+		Success:         true,
+		Target:          input.Target,
+		Effect:          fmt.Sprintf("%s takes effect", potion.Name),
+	}
+
+	var effect *models.ActiveEffect
+	if potion.EffectAttribute != "" && potion.EffectScore != nil {
+		expr, err := dice.Parse(potion.EffectDuration)
+		if err != nil {
+			return nil, apperrors.NewInternalError(fmt.Errorf("potion %d has an invalid effect duration: %w", potion.ID, err))
+		}
+		turns := max(expr.Roll(s.roller).Total, 1)
+		effect = &models.ActiveEffect{
+			CharacterID:     characterID,
+			SourceType:      models.EffectSourcePotion,
+			SourceID:        potion.ID,
+			Name:            potion.Name,
+			Attribute:       potion.EffectAttribute,
+			Score:           potion.EffectScore,
+			DurationRounds:  turns * models.RoundsPerTurn,
+			RemainingRounds: turns * models.RoundsPerTurn,
+		}
+		use.Effect = fmt.Sprintf("%s sets %s to %d for %d turns", potion.Name, potion.EffectAttribute, *potion.EffectScore, turns)
+	}
+
+	id, err := s.itemUseRepo.RecordPotionUse(ctx, use, effect, usesLeft, potion.Uses)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.ItemUseResult{
+		RemainingQuantity: item.Quantity,
+		RemainingUses:     &usesLeft,
+	}
+	if usesLeft == 0 {
+		result.RemainingQuantity--
+		result.RemainingUses = nil
+		if result.RemainingQuantity > 0 {
+			result.RemainingUses = &potion.Uses
+		}
+	}
+	result.Use, err = s.itemUseRepo.GetItemUse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if effect != nil {
+		result.ActiveEffect, err = s.activeEffectRepo.GetActiveEffect(ctx, characterID, effect.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// chargeUseResult reports a logged charge use and the charges left in the item
+func (s *ItemUseService) chargeUseResult(ctx context.Context, id int64, item *models.InventoryItem, charges int) (*models.ItemUseResult, error) {
+	use, err := s.itemUseRepo.GetItemUse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &models.ItemUseResult{
+		Use:               use,
+		RemainingQuantity: item.Quantity,
+		RemainingCharges:  &charges,
+		Depleted:          charges == 0,
+	}, nil
+}
+
+// readScrollsChance returns the character's Read Scrolls skill out of 12,
+// or zero if they have no such skill yet
+func (s *ItemUseService) readScrollsChance(ctx context.Context, character *models.Character) (int, error) {
+	scores := character.EffectiveAttributes()
+	skills, err := s.thiefSkillsService.GetThiefSkillsForCharacter(ctx, character.Class, int64(character.EffectiveLevel()), map[string]int{
+		"DX": scores.Dexterity,
+		"IN": scores.Intelligence,
+		"WS": scores.Wisdom,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, skill := range skills {
+		if skill.Name != "Read Scrolls" {
+			continue
+		}
+		chance, _ := models.ParseSkillChance(skill.SuccessChance)
+		return chance, nil
+	}
+	return 0, nil
+}
+
+// isArcaneSpell reports whether a spell appears on any arcane class's list
+func isArcaneSpell(spell *models.Spell) bool {
+	return spell.MagLevel > 0 || spell.CryLevel > 0 || spell.IllLevel > 0 ||
+		spell.NecLevel > 0 || spell.PyrLevel > 0 || spell.WchLevel > 0
+}
@@ -0,0 +1,268 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"mordezzanV4/internal/dice"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/logger"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+)
+
+// LevelUpService advances characters a level at a time, rolling hit points and
+// collecting the class features gained
+type LevelUpService struct {
+	characterRepo repositories.CharacterRepository
+	levelUpRepo   repositories.LevelUpRepository
+	classRepo     repositories.ClassRepository
+	classService  *ClassService
+	spellService  *SpellService
+	roller        *dice.Roller
+}
+
+// NewLevelUpService creates a new level-up service
+func NewLevelUpService(
+	characterRepo repositories.CharacterRepository,
+	levelUpRepo repositories.LevelUpRepository,
+	classRepo repositories.ClassRepository,
+	classService *ClassService,
+	spellService *SpellService,
+	roller *dice.Roller,
+) *LevelUpService {
+	return &LevelUpService{
+		characterRepo: characterRepo,
+		levelUpRepo:   levelUpRepo,
+		classRepo:     classRepo,
+		classService:  classService,
+		spellService:  spellService,
+		roller:        roller,
+	}
+}
+
+// GetLevelUpStatus reports whether a character has the experience for its next level
+func (s *LevelUpService) GetLevelUpStatus(ctx context.Context, characterID int64) (*models.LevelUpStatus, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &models.LevelUpStatus{
+		CharacterID:      character.ID,
+		CurrentLevel:     character.Level,
+		ExperiencePoints: character.ExperiencePoints,
+	}
+
+	nextLevel, err := s.classRepo.GetNextLevelData(ctx, character.Class, character.Level)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			status.MaxLevel = true
+			return status, nil
+		}
+		return nil, err
+	}
+
+	status.NextLevel = nextLevel.Level
+	status.NextLevelXP = nextLevel.ExperiencePoints
+	status.Eligible = character.ExperiencePoints >= nextLevel.ExperiencePoints
+	return status, nil
+}
+
+// GetLevelUps returns the level-up history of a character
+func (s *LevelUpService) GetLevelUps(ctx context.Context, characterID int64) ([]*models.LevelUp, error) {
+	return s.levelUpRepo.GetLevelUpsByCharacter(ctx, characterID)
+}
+
+// LevelUp advances a character by one level. Hit points are rolled from the
+// difference in class hit dice plus the Constitution modifier per die; past
+// name level the class gains a fixed amount instead. The roll is always seeded
+// server-side; the seed is recorded with the level-up so it can be audited.
+func (s *LevelUpService) LevelUp(ctx context.Context, characterID int64) (*models.LevelUp, error) {
+	status, err := s.GetLevelUpStatus(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if status.MaxLevel {
+		return nil, apperrors.NewValidationError("level", "Character is already at the maximum level")
+	}
+	if !status.Eligible {
+		return nil, apperrors.NewValidationError("experience_points",
+			fmt.Sprintf("Level %d requires %d experience points", status.NextLevel, status.NextLevelXP))
+	}
+
+	before, err := s.enrichedCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentData, err := s.classRepo.GetClassData(ctx, before.Class, before.Level)
+	if err != nil {
+		return nil, err
+	}
+	nextData, err := s.classRepo.GetClassData(ctx, before.Class, status.NextLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	levelUp := &models.LevelUp{
+		CharacterID: characterID,
+		FromLevel:   before.Level,
+		ToLevel:     nextData.Level,
+		HitDice:     nextData.HitDice,
+		HPModifier:  before.HPModifier,
+	}
+
+	if err := s.rollHitPoints(levelUp, currentData.HitDice, nextData.HitDice); err != nil {
+		return nil, err
+	}
+
+	updateInput := models.UpdateCharacterInput{
+		Name:               before.Name,
+		Class:              before.Class,
+		Kindred:            before.Kindred,
+		Level:              levelUp.ToLevel,
+		ExperiencePoints:   before.ExperiencePoints,
+		Strength:           before.Strength,
+		Dexterity:          before.Dexterity,
+		Constitution:       before.Constitution,
+		Wisdom:             before.Wisdom,
+		Intelligence:       before.Intelligence,
+		Charisma:           before.Charisma,
+		MaxHitPoints:       before.MaxHitPoints + levelUp.HPGained,
+		CurrentHitPoints:   before.CurrentHitPoints + levelUp.HPGained,
+		TemporaryHitPoints: before.TemporaryHitPoints,
+	}
+	if err := s.characterRepo.UpdateCharacter(ctx, characterID, &updateInput); err != nil {
+		logger.Error("Failed to update character %d during level up: %v", characterID, err)
+		return nil, err
+	}
+
+	after, err := s.enrichedCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	levelUp.Changes = diffCharacters(before, after)
+
+	levelUp.NewAbilities, err = s.newAbilities(ctx, before.Class, levelUp.FromLevel, levelUp.ToLevel)
+	if err != nil {
+		logger.Error("Failed to fetch new abilities for %s level %d: %v", before.Class, levelUp.ToLevel, err)
+		levelUp.NewAbilities = []*models.ClassAbility{}
+	}
+
+	id, err := s.levelUpRepo.CreateLevelUp(ctx, levelUp)
+	if err != nil {
+		logger.Error("Failed to record level up for character %d: %v", characterID, err)
+		return nil, err
+	}
+	levelUp.ID = id
+
+	levelUp.LearnableSpells, err = s.spellService.GetSpellsLearnableOnLevelUp(ctx, characterID, levelUp.ToLevel)
+	if err != nil {
+		logger.Error("Failed to fetch learnable spells for character %d: %v", characterID, err)
+	}
+
+	if status, err := s.GetLevelUpStatus(ctx, characterID); err == nil {
+		levelUp.CanLevelAgain = status.Eligible
+	}
+
+	logger.Info("Character %d advanced from level %d to %d, gaining %d HP",
+		characterID, levelUp.FromLevel, levelUp.ToLevel, levelUp.HPGained)
+
+	return levelUp, nil
+}
+
+// rollHitPoints fills in the hit point gain between two class hit dice values
+func (s *LevelUpService) rollHitPoints(levelUp *models.LevelUp, fromHitDice, toHitDice string) error {
+	fromCount, _, fromBonus, err := models.ParseClassHitDice(fromHitDice)
+	if err != nil {
+		return err
+	}
+	toCount, sides, toBonus, err := models.ParseClassHitDice(toHitDice)
+	if err != nil {
+		return err
+	}
+
+	// Past name level the class adds a fixed amount and Constitution no longer applies
+	levelUp.HPGained = toBonus - fromBonus
+
+	newDice := toCount - fromCount
+	if newDice <= 0 {
+		levelUp.FixedHP = true
+		levelUp.HPModifier = 0
+		return nil
+	}
+
+	roller := dice.NewRoller(s.roller.Int63())
+	levelUp.Seed = roller.Seed()
+
+	expr, err := dice.Parse(fmt.Sprintf("%dd%d", newDice, sides))
+	if err != nil {
+		return fmt.Errorf("invalid hit dice %q: %w", toHitDice, err)
+	}
+	levelUp.HPRoll = expr.Roll(roller)
+
+	// Each new die gains at least one hit point regardless of Constitution
+	for _, die := range levelUp.HPRoll.Terms[0].Dice {
+		levelUp.HPGained += max(die.Value+levelUp.HPModifier, 1)
+	}
+	return nil
+}
+
+func (s *LevelUpService) enrichedCharacter(ctx context.Context, characterID int64) (*models.Character, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.classService.EnrichCharacterWithClassData(ctx, character); err != nil {
+		return nil, err
+	}
+	return character, nil
+}
+
+// newAbilities returns the class abilities unlocked between two levels
+func (s *LevelUpService) newAbilities(ctx context.Context, class string, fromLevel, toLevel int) ([]*models.ClassAbility, error) {
+	abilities, err := s.classService.GetClassAbilitiesByLevel(ctx, class, toLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	gained := []*models.ClassAbility{}
+	for _, ability := range abilities {
+		if ability.MinLevel > fromLevel && ability.MinLevel <= toLevel {
+			gained = append(gained, ability)
+		}
+	}
+	return gained, nil
+}
+
+// diffCharacters lists the level-dependent values that changed between two snapshots
+func diffCharacters(before, after *models.Character) []models.StatChange {
+	fields := []struct {
+		name          string
+		before, after interface{}
+	}{
+		{"level", before.Level, after.Level},
+		{"max_hit_points", before.MaxHitPoints, after.MaxHitPoints},
+		{"current_hit_points", before.CurrentHitPoints, after.CurrentHitPoints},
+		{"hit_dice", before.HitDice, after.HitDice},
+		{"saving_throw", before.SavingThrow, after.SavingThrow},
+		{"fighting_ability", before.FightingAbility, after.FightingAbility},
+		{"casting_ability", before.CastingAbility, after.CastingAbility},
+		{"turning_ability", before.TurningAbility, after.TurningAbility},
+		{"spell_slots", before.SpellSlots, after.SpellSlots},
+		{"thief_skills", before.ThiefSkills, after.ThiefSkills},
+		{"back_stab_multiplier", before.BackStabMultiplier, after.BackStabMultiplier},
+		{"natural_ac", before.NaturalAC, after.NaturalAC},
+		{"movement_rate", before.MovementRate, after.MovementRate},
+	}
+
+	changes := []models.StatChange{}
+	for _, f := range fields {
+		if !reflect.DeepEqual(f.before, f.after) {
+			changes = append(changes, models.StatChange{Field: f.name, Before: f.before, After: f.after})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+
+	"mordezzanV4/internal/dice"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/logger"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+)
+
+// SavingThrowService rolls saving throws for characters, combining the class
+// save with the bonuses from class, attributes, items and conditions
+type SavingThrowService struct {
+	characterRepo repositories.CharacterRepository
+	classService  *ClassService
+	inventoryRepo repositories.InventoryRepository
+	ringRepo      repositories.RingRepository
+	equipmentRepo repositories.EquipmentRepository
+	roller        *dice.Roller
+}
+
+// NewSavingThrowService creates a new saving throw service
+func NewSavingThrowService(
+	characterRepo repositories.CharacterRepository,
+	classService *ClassService,
+	inventoryRepo repositories.InventoryRepository,
+	ringRepo repositories.RingRepository,
+	equipmentRepo repositories.EquipmentRepository,
+	roller *dice.Roller,
+) *SavingThrowService {
+	return &SavingThrowService{
+		characterRepo: characterRepo,
+		classService:  classService,
+		inventoryRepo: inventoryRepo,
+		ringRepo:      ringRepo,
+		equipmentRepo: equipmentRepo,
+		roller:        roller,
+	}
+}
+
+// RollSavingThrow rolls a d20 for the character, adds every modifier that
+// applies to the category and compares the total with their saving throw
+func (s *SavingThrowService) RollSavingThrow(ctx context.Context, characterID int64, input *models.SavingThrowInput) (*models.SavingThrowResult, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.classService.EnrichCharacterWithClassData(ctx, character); err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+
+	modifiers := []models.RollModifier{}
+	add := func(source string, value int) {
+		if value != 0 {
+			modifiers = append(modifiers, models.RollModifier{Source: source, Value: value})
+		}
+	}
+
+	add(character.Class, character.ClassSaveBonus(input.Category))
+	add(character.AttributeSaveBonus(input.Category))
+
+	item, bonus, err := s.protectionBonus(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	add(item, bonus)
+
+	for _, condition := range character.Conditions {
+		add(condition.Name, condition.SaveModifier)
+	}
+	for _, modifier := range input.Modifiers {
+		add(modifier.Source, modifier.Value)
+	}
+
+	result := &models.SavingThrowResult{
+		CharacterID: characterID,
+		Category:    input.Category,
+		Target:      character.SavingThrow,
+		Roll:        s.roller.Die(20),
+		Modifiers:   modifiers,
+	}
+	for _, modifier := range modifiers {
+		result.TotalModifier += modifier.Value
+	}
+	result.Total = result.Roll + result.TotalModifier
+	result.Success = result.Total >= result.Target
+	return result, nil
+}
+
+// protectionBonus returns the best save bonus among the character's equipped
+// rings and equipment, such as rings and cloaks of protection. Protection from
+// several items does not stack.
+func (s *SavingThrowService) protectionBonus(ctx context.Context, characterID int64) (string, int, error) {
+	inventory, err := s.inventoryRepo.GetInventoryByCharacter(ctx, characterID)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return "", 0, nil
+		}
+		return "", 0, err
+	}
+
+	var bestName string
+	var best int
+	for _, item := range inventory.Items {
+		if !item.IsEquipped {
+			continue
+		}
+
+		var name string
+		var bonus int
+		switch item.ItemType {
+		case "ring":
+			ring, err := s.ringRepo.GetRing(ctx, item.ItemID)
+			if err != nil {
+				logger.Error("Failed to fetch ring details for ID %d: %v", item.ItemID, err)
+				continue
+			}
+			name, bonus = ring.Name, ring.SaveBonus
+		case "equipment":
+			equipment, err := s.equipmentRepo.GetEquipment(ctx, item.ItemID)
+			if err != nil {
+				logger.Error("Failed to fetch equipment details for ID %d: %v", item.ItemID, err)
+				continue
+			}
+			name, bonus = equipment.Name, equipment.SaveBonus
+		default:
+			continue
+		}
+
+		if bonus > best {
+			bestName, best = name, bonus
+		}
+	}
+	return bestName, best, nil
+}
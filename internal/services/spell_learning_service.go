@@ -0,0 +1,230 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"mordezzanV4/internal/dice"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+)
+
+// SpellLearningService handles learning new spells from scrolls and from
+// other casters' spellbooks
+type SpellLearningService struct {
+	learningRepo     repositories.SpellLearningRepository
+	characterRepo    repositories.CharacterRepository
+	spellRepo        repositories.SpellRepository
+	spellScrollRepo  repositories.SpellScrollRepository
+	spellbookRepo    repositories.SpellbookRepository
+	spellCastingRepo repositories.SpellCastingRepository
+	classService     *ClassService
+	accessService    *CharacterAccessService
+	roller           *dice.Roller
+}
+
+// NewSpellLearningService creates a new spell learning service
+func NewSpellLearningService(
+	learningRepo repositories.SpellLearningRepository,
+	characterRepo repositories.CharacterRepository,
+	spellRepo repositories.SpellRepository,
+	spellScrollRepo repositories.SpellScrollRepository,
+	spellbookRepo repositories.SpellbookRepository,
+	spellCastingRepo repositories.SpellCastingRepository,
+	classService *ClassService,
+	accessService *CharacterAccessService,
+	roller *dice.Roller,
+) *SpellLearningService {
+	return &SpellLearningService{
+		learningRepo:     learningRepo,
+		characterRepo:    characterRepo,
+		spellRepo:        spellRepo,
+		spellScrollRepo:  spellScrollRepo,
+		spellbookRepo:    spellbookRepo,
+		spellCastingRepo: spellCastingRepo,
+		classService:     classService,
+		accessService:    accessService,
+		roller:           roller,
+	}
+}
+
+// LearnSpell rolls percentile dice against the character's chance to learn
+// the spell on a scroll or in another caster's spellbook. On success the
+// spell becomes known, arcane casters scribe it into their chosen book and
+// the scroll is used up. A failure is recorded and the spell cannot be tried
+// again until the character gains a level. The user must be able to read the
+// character carrying a source spellbook.
+func (s *SpellLearningService) LearnSpell(ctx context.Context, characterID, userID int64, input *models.LearnSpellInput) (*models.LearnSpellResult, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.classService.ClassRules(ctx, character.Class)
+	if err != nil {
+		return nil, err
+	}
+	castingClass := rules.CastingClass
+	if castingClass == "" {
+		return nil, apperrors.NewValidationError("character", fmt.Sprintf("%s cannot learn spells", character.Class))
+	}
+	arcane := rules.IsArcane()
+
+	spellID, err := s.resolveSourceSpell(ctx, characterID, userID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	spell, err := s.spellRepo.GetSpell(ctx, spellID)
+	if err != nil {
+		return nil, err
+	}
+	spellLevel := spell.GetLevel(castingClass)
+	if spellLevel == 0 {
+		return nil, apperrors.NewValidationError("spell_id", fmt.Sprintf("%s is not a %s spell", spell.Name, castingClass))
+	}
+	if spellLevel > rules.MaxSpellLevel(character.EffectiveLevel()) {
+		return nil, apperrors.NewValidationError("spell_id",
+			fmt.Sprintf("%s cannot learn level %d spells yet", character.Name, spellLevel))
+	}
+
+	knownSpells, err := s.spellCastingRepo.GetKnownSpells(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	for _, known := range knownSpells {
+		if known.SpellID == spellID {
+			return nil, apperrors.NewConflict(fmt.Sprintf("%s already knows %s", character.Name, spell.Name))
+		}
+	}
+
+	failed, err := s.learningRepo.HasFailedAttempt(ctx, characterID, spellID, character.EffectiveLevel())
+	if err != nil {
+		return nil, err
+	}
+	if failed {
+		return nil, apperrors.NewConflict(fmt.Sprintf("%s already failed to learn %s at level %d and must gain a level before trying again",
+			character.Name, spell.Name, character.EffectiveLevel()))
+	}
+
+	chance := character.ChanceToLearnSpell(arcane)
+	if chance == 0 {
+		return nil, apperrors.NewValidationError("character", fmt.Sprintf("%s cannot comprehend new spells", character.Name))
+	}
+
+	// Arcane spells must have room in the chosen book before the roll is made
+	var spellbookID int64
+	if arcane {
+		if input.SpellbookID == 0 {
+			return nil, apperrors.NewValidationError("spellbook_id", "A spellbook is required to learn arcane spells")
+		}
+		book, err := s.spellbookRepo.GetCharacterSpellbook(ctx, characterID, input.SpellbookID)
+		if err != nil {
+			return nil, err
+		}
+		if pages := models.SpellbookPageCost(spellLevel); pages > book.FreePages {
+			return nil, apperrors.NewValidationError("spellbook_id", fmt.Sprintf("%s needs %d pages but %s has only %d free",
+				spell.Name, pages, book.Name, book.FreePages))
+		}
+		spellbookID = book.ID
+	} else if input.SpellbookID != 0 {
+		return nil, apperrors.NewValidationError("spellbook_id", "Only arcane spells are scribed into spellbooks")
+	}
+
+	roll := s.roller.Die(100)
+	attempt := &models.SpellLearningAttempt{
+		CharacterID:    characterID,
+		SpellID:        spellID,
+		SpellName:      spell.Name,
+		SpellLevel:     spellLevel,
+		SpellClass:     castingClass,
+		SourceType:     input.SourceType,
+		SourceID:       input.SourceID,
+		CharacterLevel: character.EffectiveLevel(),
+		Roll:           roll,
+		Chance:         chance,
+		Success:        roll <= chance,
+	}
+
+	var scrollItemID int64
+	if input.SourceType == models.SpellSourceScroll {
+		scrollItemID = input.SourceID
+	}
+
+	id, err := s.learningRepo.RecordAttempt(ctx, attempt, scrollItemID, spellbookID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.LearnSpellResult{
+		ScrollConsumed: attempt.Success && scrollItemID != 0,
+	}
+	result.Attempt, err = s.learningRepo.GetAttempt(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if attempt.Success && spellbookID != 0 {
+		result.Spellbook, err = s.spellbookRepo.GetCharacterSpellbook(ctx, characterID, spellbookID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// GetLearningAttempts lists a character's attempts to learn spells, newest first
+func (s *SpellLearningService) GetLearningAttempts(ctx context.Context, characterID int64) ([]*models.SpellLearningAttempt, error) {
+	return s.learningRepo.GetAttempts(ctx, characterID)
+}
+
+// resolveSourceSpell returns the spell the source holds, checking that the
+// character has the scroll or that the user may read the book and it contains
+// the requested spell
+func (s *SpellLearningService) resolveSourceSpell(ctx context.Context, characterID, userID int64, input *models.LearnSpellInput) (int64, error) {
+	switch input.SourceType {
+	case models.SpellSourceScroll:
+		item, err := s.learningRepo.GetSpellScrollItem(ctx, characterID, input.SourceID)
+		if err != nil {
+			return 0, err
+		}
+		scroll, err := s.spellScrollRepo.GetSpellScroll(ctx, item.ItemID)
+		if err != nil {
+			return 0, err
+		}
+		if input.SpellID != 0 && input.SpellID != scroll.SpellID {
+			return 0, apperrors.NewValidationError("spell_id", fmt.Sprintf("The scroll holds %s, not the requested spell", scroll.SpellName))
+		}
+		return scroll.SpellID, nil
+
+	case models.SpellSourceSpellbook:
+		if _, err := s.spellbookRepo.GetCharacterSpellbook(ctx, characterID, input.SourceID); err == nil {
+			return 0, apperrors.NewValidationError("source_id", "Spells are learned from another caster's spellbook")
+		} else if !apperrors.IsNotFound(err) {
+			return 0, err
+		}
+
+		ownerID, err := s.spellbookRepo.GetSpellbookOwner(ctx, input.SourceID)
+		if err != nil {
+			return 0, err
+		}
+		if err := s.accessService.AuthorizeCharacter(ctx, userID, ownerID, false); err != nil {
+			if apperrors.IsForbidden(err) {
+				return 0, apperrors.NewForbidden("You do not have access to this spellbook")
+			}
+			return 0, err
+		}
+
+		book, err := s.spellbookRepo.GetSpellbook(ctx, input.SourceID)
+		if err != nil {
+			return 0, err
+		}
+		for _, entry := range book.Spells {
+			if entry.SpellID == input.SpellID {
+				return entry.SpellID, nil
+			}
+		}
+		return 0, apperrors.NewValidationError("spell_id", fmt.Sprintf("%s does not contain the requested spell", book.Name))
+	}
+	return 0, apperrors.NewValidationError("source_type", "Source type must be scroll or spellbook")
+}
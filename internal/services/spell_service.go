@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	apperrors "mordezzanV4/internal/errors"
 	"mordezzanV4/internal/models"
 	"mordezzanV4/internal/repositories"
 	"strconv"
@@ -12,28 +13,34 @@ import (
 type SpellService struct {
 	spellRepo          repositories.SpellRepository
 	spellCastingRepo   repositories.SpellCastingRepository
+	spellbookRepo      repositories.SpellbookRepository
 	characterRepo      repositories.CharacterRepository
 	classRepo          repositories.ClassRepository
 	classService       *ClassService
 	encumbranceService *EncumbranceService
+	turnUndeadRepo     repositories.TurnUndeadRepository
 }
 
 // NewSpellService creates a new spell service
 func NewSpellService(
 	spellRepo repositories.SpellRepository,
 	spellCastingRepo repositories.SpellCastingRepository,
+	spellbookRepo repositories.SpellbookRepository,
 	characterRepo repositories.CharacterRepository,
 	classRepo repositories.ClassRepository,
 	classService *ClassService,
 	encumbranceService *EncumbranceService,
+	turnUndeadRepo repositories.TurnUndeadRepository,
 ) *SpellService {
 	return &SpellService{
 		spellRepo:          spellRepo,
 		spellCastingRepo:   spellCastingRepo,
+		spellbookRepo:      spellbookRepo,
 		characterRepo:      characterRepo,
 		classRepo:          classRepo,
 		classService:       classService,
 		encumbranceService: encumbranceService,
+		turnUndeadRepo:     turnUndeadRepo,
 	}
 }
 
@@ -73,7 +80,11 @@ func (s *SpellService) GetCharacterSpellsInfo(ctx context.Context, characterID i
 	classSpellLimits := make(map[string]map[string][]int)
 
 	// Determine primary casting class
-	primaryCastingClass := getPrimaryCastingClass(character.Class)
+	rules, err := s.classService.ClassRules(ctx, character.Class)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get class rules: %v", err)
+	}
+	primaryCastingClass := rules.CastingClass
 	if primaryCastingClass != "" {
 		maxKnownSpells, err := s.spellCastingRepo.GetMaxKnownSpells(ctx, characterID, primaryCastingClass)
 		if err != nil {
@@ -107,8 +118,8 @@ func (s *SpellService) GetCharacterSpellsInfo(ctx context.Context, characterID i
 		levelNum, _ := strconv.Atoi(level[5:])
 
 		// For divine casters
-		if isDivineCaster(character.Class) && bonusSpells["divine"] != nil {
-			if character.Level >= (2*levelNum - 1) { // Check if character can cast this level
+		if rules.IsDivine() && bonusSpells["divine"] != nil {
+			if character.EffectiveLevel() >= (2*levelNum - 1) { // Check if character can cast this level
 				if bonus, ok := bonusSpells["divine"][level]; ok {
 					availablePreparedSlots[level] += bonus
 				}
@@ -116,8 +127,8 @@ func (s *SpellService) GetCharacterSpellsInfo(ctx context.Context, characterID i
 		}
 
 		// For arcane casters
-		if isArcaneCaster(character.Class) && bonusSpells["arcane"] != nil {
-			if character.Level >= (2*levelNum - 1) { // Check if character can cast this level
+		if rules.IsArcane() && bonusSpells["arcane"] != nil {
+			if character.EffectiveLevel() >= (2*levelNum - 1) { // Check if character can cast this level
 				if bonus, ok := bonusSpells["arcane"][level]; ok {
 					availablePreparedSlots[level] += bonus
 				}
@@ -133,11 +144,23 @@ func (s *SpellService) GetCharacterSpellsInfo(ctx context.Context, characterID i
 		}
 	}
 
+	// Count prepared spells that can still be cast before the next rest
+	remainingCastings := make(map[string]int)
+	for level := range spellSlots {
+		remainingCastings[level] = 0
+	}
+	for _, spell := range preparedSpells {
+		if !spell.Expended {
+			remainingCastings[fmt.Sprintf("level%d", spell.SpellLevel)]++
+		}
+	}
+
 	return &models.CharacterSpellsInfo{
 		KnownSpells:            knownSpells,
 		PreparedSpells:         preparedSpells,
 		SpellSlots:             spellSlots,
 		AvailablePreparedSlots: availablePreparedSlots,
+		RemainingCastings:      remainingCastings,
 		BonusSpells:            bonusSpells,
 		ClassSpellLimits:       classSpellLimits,
 	}, nil
@@ -147,14 +170,138 @@ func (s *SpellService) ClearPreparedSpells(ctx context.Context, characterID int6
 	return s.spellCastingRepo.ClearPreparedSpells(ctx, characterID)
 }
 
-func (s *SpellService) UnprepareSpell(ctx context.Context, characterID, spellID int64) error {
-	return s.spellCastingRepo.UnprepareSpell(ctx, characterID, spellID)
+func (s *SpellService) UnprepareSpell(ctx context.Context, input *models.UnprepareSpellInput) error {
+	return s.spellCastingRepo.UnprepareSpell(ctx, input)
 }
 
+// PrepareSpell fills one free slot of the spell's level. The same spell may
+// be prepared again as long as slots of that level remain.
 func (s *SpellService) PrepareSpell(ctx context.Context, input *models.PrepareSpellInput) (int64, error) {
+	spellInfo, err := s.GetCharacterSpellsInfo(ctx, input.CharacterID)
+	if err != nil {
+		return 0, err
+	}
+
+	var knownSpell *models.KnownSpell
+	for i := range spellInfo.KnownSpells {
+		if spellInfo.KnownSpells[i].SpellID == input.SpellID {
+			knownSpell = &spellInfo.KnownSpells[i]
+			break
+		}
+	}
+	if knownSpell == nil {
+		return 0, apperrors.NewValidationError("spell_id", "Character does not know this spell")
+	}
+
+	// Arcane casters memorize from a spellbook they have with them
+	spellRules, err := s.classService.ClassRules(ctx, knownSpell.SpellClass)
+	if err != nil {
+		return 0, err
+	}
+	if spellRules.IsArcane() {
+		inBook, err := s.spellbookRepo.HasSpellInCarriedSpellbook(ctx, input.CharacterID, knownSpell.SpellID)
+		if err != nil {
+			return 0, err
+		}
+		if !inBook {
+			return 0, apperrors.NewValidationError("spell_id", "Arcane spells can only be prepared from a carried spellbook containing them")
+		}
+	}
+
+	// Slots are counted by the level the spell is known at, not the level in the request
+	input.SpellLevel = knownSpell.SpellLevel
+	levelKey := fmt.Sprintf("level%d", knownSpell.SpellLevel)
+	if spellInfo.AvailablePreparedSlots[levelKey] <= 0 {
+		return 0, apperrors.NewValidationError("spell_level", fmt.Sprintf("No available slots for level %d spells", knownSpell.SpellLevel))
+	}
+
 	return s.spellCastingRepo.PrepareSpell(ctx, input)
 }
 
+// CastSpell expends a prepared spell and returns the logged casting
+func (s *SpellService) CastSpell(ctx context.Context, input *models.CastSpellInput) (*models.SpellCast, error) {
+	id, err := s.spellCastingRepo.CastSpell(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return s.spellCastingRepo.GetSpellCast(ctx, id)
+}
+
+func (s *SpellService) GetSpellCasts(ctx context.Context, characterID int64) ([]*models.SpellCast, error) {
+	return s.spellCastingRepo.GetSpellCasts(ctx, characterID)
+}
+
+// Rest recovers expended spells after a full night's rest. Divine spells
+// return after an hour of prayer; arcane spells need a turn of study per
+// spell level and are recovered lowest level first until study time runs out.
+// The rest also restores the day's attempts to turn undead.
+func (s *SpellService) Rest(ctx context.Context, characterID int64, input *models.RestInput) (*models.RestResult, error) {
+	preparedSpells, err := s.spellCastingRepo.GetPreparedSpells(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepared spells come ordered by level, so arcane study covers the lowest levels first
+	var divine, arcane []models.PreparedSpell
+	for _, spell := range preparedSpells {
+		if !spell.Expended {
+			continue
+		}
+		spellRules, err := s.classService.ClassRules(ctx, spell.SpellClass)
+		if err != nil {
+			return nil, err
+		}
+		if spellRules.IsDivine() {
+			divine = append(divine, spell)
+		} else {
+			arcane = append(arcane, spell)
+		}
+	}
+
+	result := &models.RestResult{Restored: []models.PreparedSpell{}}
+	minutesLeft := input.RecoveryMinutes
+
+	if len(divine) > 0 {
+		result.RecoveryMinutesRequired += models.DivinePrayerMinutes
+		if minutesLeft >= models.DivinePrayerMinutes {
+			minutesLeft -= models.DivinePrayerMinutes
+			result.Restored = append(result.Restored, divine...)
+		} else {
+			result.StillExpended += len(divine)
+		}
+	}
+
+	for _, spell := range arcane {
+		studyMinutes := spell.SpellLevel * models.ArcaneStudyMinutesPerSpellLevel
+		result.RecoveryMinutesRequired += studyMinutes
+		if minutesLeft >= studyMinutes {
+			minutesLeft -= studyMinutes
+			result.Restored = append(result.Restored, spell)
+		} else {
+			result.StillExpended++
+		}
+	}
+	result.RecoveryMinutesUsed = input.RecoveryMinutes - minutesLeft
+
+	ids := make([]int64, len(result.Restored))
+	for i, spell := range result.Restored {
+		ids[i] = spell.ID
+		result.Restored[i].Expended = false
+		result.Restored[i].ExpendedAt = nil
+	}
+	if err := s.spellCastingRepo.RestorePreparedSpells(ctx, characterID, ids); err != nil {
+		return nil, err
+	}
+
+	// A night's rest also restores daily attempts to turn undead
+	result.TurningUsesRestored, err = s.turnUndeadRepo.RecoverUses(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (s *SpellService) RemoveKnownSpell(ctx context.Context, characterID, spellID int64) error {
 	return s.spellCastingRepo.RemoveKnownSpell(ctx, characterID, spellID)
 }
@@ -168,7 +315,11 @@ func (s *SpellService) AddInitialSpellsForNewCharacter(ctx context.Context, char
 	}
 
 	// Determine if this character can cast spells
-	primaryCastingClass := getPrimaryCastingClass(character.Class)
+	rules, err := s.classService.ClassRules(ctx, character.Class)
+	if err != nil {
+		return fmt.Errorf("failed to get class rules: %v", err)
+	}
+	primaryCastingClass := rules.CastingClass
 	if primaryCastingClass == "" {
 		// No spellcasting for this class
 		return nil
@@ -195,6 +346,17 @@ func (s *SpellService) AddInitialSpellsForNewCharacter(ctx context.Context, char
 		initialSpellCount = len(level1Spells)
 	}
 
+	// Arcane casters start with a spellbook holding their initial spells
+	var spellbookID int64
+	if rules.IsArcane() && initialSpellCount > 0 {
+		bookInput := &models.CreateSpellbookInput{}
+		bookInput.ApplyDefaults()
+		spellbookID, err = s.spellbookRepo.CreateSpellbook(ctx, characterID, bookInput)
+		if err != nil {
+			return fmt.Errorf("failed to create starting spellbook: %v", err)
+		}
+	}
+
 	// Add the initial spells
 	for i := 0; i < initialSpellCount; i++ {
 		spell := level1Spells[i]
@@ -209,6 +371,20 @@ func (s *SpellService) AddInitialSpellsForNewCharacter(ctx context.Context, char
 		if err != nil {
 			return fmt.Errorf("failed to add initial spell %s: %v", spell.Name, err)
 		}
+
+		if spellbookID != 0 {
+			err = s.spellbookRepo.AddSpell(ctx, &models.SpellbookSpell{
+				SpellbookID: spellbookID,
+				SpellID:     spell.ID,
+				SpellName:   spell.Name,
+				SpellLevel:  1,
+				SpellClass:  primaryCastingClass,
+				Pages:       models.SpellbookPageCost(1),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to scribe initial spell %s: %v", spell.Name, err)
+			}
+		}
 	}
 
 	return nil
@@ -223,14 +399,18 @@ func (s *SpellService) GetSpellsLearnableOnLevelUp(ctx context.Context, characte
 	}
 
 	// Determine if this character can cast spells
-	primaryCastingClass := getPrimaryCastingClass(character.Class)
+	rules, err := s.classService.ClassRules(ctx, character.Class)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get class rules: %v", err)
+	}
+	primaryCastingClass := rules.CastingClass
 	if primaryCastingClass == "" {
 		// No spellcasting for this class
 		return nil, nil
 	}
 
 	// Calculate the highest spell level this character can cast at the new level
-	maxSpellLevel := calculateMaxSpellLevel(primaryCastingClass, newLevel)
+	maxSpellLevel := rules.MaxSpellLevel(newLevel)
 
 	// Get spells available for this class up to the max level
 	allSpells, err := s.spellRepo.GetSpellsByClass(ctx, primaryCastingClass)
@@ -271,7 +451,11 @@ func (s *SpellService) LearnSpellOnLevelUp(ctx context.Context, characterID int6
 	}
 
 	// Determine primary casting class
-	primaryCastingClass := getPrimaryCastingClass(character.Class)
+	rules, err := s.classService.ClassRules(ctx, character.Class)
+	if err != nil {
+		return fmt.Errorf("failed to get class rules: %v", err)
+	}
+	primaryCastingClass := rules.CastingClass
 	if primaryCastingClass == "" {
 		return fmt.Errorf("character class %s cannot cast spells", character.Class)
 	}
@@ -286,7 +470,7 @@ func (s *SpellService) LearnSpellOnLevelUp(ctx context.Context, characterID int6
 	spellLevel := spell.GetLevel(primaryCastingClass)
 
 	// Calculate the highest spell level this character can cast
-	maxSpellLevel := calculateMaxSpellLevel(primaryCastingClass, character.Level)
+	maxSpellLevel := rules.MaxSpellLevel(character.EffectiveLevel())
 	if spellLevel > maxSpellLevel {
 		return fmt.Errorf("character cannot learn spells of level %d yet", spellLevel)
 	}
@@ -322,7 +506,7 @@ func (s *SpellService) LearnSpellOnLevelUp(ctx context.Context, characterID int6
 		CharacterID: characterID,
 		SpellID:     spellID,
 		SpellClass:  primaryCastingClass,
-		Notes:       fmt.Sprintf("Learned at level %d", character.Level),
+		Notes:       fmt.Sprintf("Learned at level %d", character.EffectiveLevel()),
 	}
 
 	_, err = s.spellCastingRepo.AddKnownSpell(ctx, input)
@@ -333,168 +517,61 @@ func (s *SpellService) LearnSpellOnLevelUp(ctx context.Context, characterID int6
 	return nil
 }
 
-// PrepareAllSpells prepares all spells a character can prepare
+// PrepareAllSpells clears the character's prepared spells and fills every
+// slot, cycling through the preparable known spells of each level so that
+// spells repeat once every one of them has a slot
 func (s *SpellService) PrepareAllSpells(ctx context.Context, characterID int64) error {
-	// Get character details and spell info
-	spellInfo, err := s.GetCharacterSpellsInfo(ctx, characterID)
+	// Clear current prepared spells
+	err := s.spellCastingRepo.ClearPreparedSpells(ctx, characterID)
 	if err != nil {
-		return fmt.Errorf("failed to get character spell info: %v", err)
+		return fmt.Errorf("failed to clear prepared spells: %v", err)
 	}
 
-	// Clear current prepared spells
-	err = s.spellCastingRepo.ClearPreparedSpells(ctx, characterID)
+	// Get spell info after clearing so every slot counts as available
+	spellInfo, err := s.GetCharacterSpellsInfo(ctx, characterID)
 	if err != nil {
-		return fmt.Errorf("failed to clear prepared spells: %v", err)
+		return fmt.Errorf("failed to get character spell info: %v", err)
 	}
 
-	// Group known spells by level and class
-	spellsByLevelAndClass := make(map[string]map[int][]models.KnownSpell)
+	// Group known spells by level, skipping arcane spells not in a carried spellbook
+	spellsByLevel := make(map[int][]models.KnownSpell)
 	for _, spell := range spellInfo.KnownSpells {
-		if spellsByLevelAndClass[spell.SpellClass] == nil {
-			spellsByLevelAndClass[spell.SpellClass] = make(map[int][]models.KnownSpell)
+		spellRules, err := s.classService.ClassRules(ctx, spell.SpellClass)
+		if err != nil {
+			return fmt.Errorf("failed to get class rules: %v", err)
 		}
-		spellsByLevelAndClass[spell.SpellClass][spell.SpellLevel] = append(
-			spellsByLevelAndClass[spell.SpellClass][spell.SpellLevel],
-			spell,
-		)
-	}
-
-	// For each spell level, prepare as many spells as possible
-	for classKey, spellsByLevel := range spellsByLevelAndClass {
-		for level, spells := range spellsByLevel {
-			// Get available slots for this level
-			slotKey := fmt.Sprintf("level%d", level)
-			availableSlots := spellInfo.AvailablePreparedSlots[slotKey]
-
-			// Prepare as many spells as we have slots for
-			for i := 0; i < len(spells) && i < availableSlots; i++ {
-				spell := spells[i]
-				input := &models.PrepareSpellInput{
-					CharacterID: characterID,
-					SpellID:     spell.SpellID,
-					SpellLevel:  spell.SpellLevel,
-					SpellClass:  classKey,
-				}
-
-				_, err := s.spellCastingRepo.PrepareSpell(ctx, input)
-				if err != nil {
-					return fmt.Errorf("failed to prepare spell %s: %v", spell.SpellName, err)
-				}
+		if spellRules.IsArcane() {
+			inBook, err := s.spellbookRepo.HasSpellInCarriedSpellbook(ctx, characterID, spell.SpellID)
+			if err != nil {
+				return fmt.Errorf("failed to check spellbooks: %v", err)
+			}
+			if !inBook {
+				continue
 			}
 		}
+		spellsByLevel[spell.SpellLevel] = append(spellsByLevel[spell.SpellLevel], spell)
 	}
 
-	return nil
-}
-
-// Private helper functions
-
-// getPrimaryCastingClass determines the primary spellcasting class for a character
-func getPrimaryCastingClass(characterClass string) string {
-	// Map character classes to their primary spellcasting class
-	castingClassMap := map[string]string{
-		// Pure divine casters
-		"Cleric": "Cleric",
-		"Druid":  "Druid",
-		"Priest": "Priest",
-
-		// Pure arcane casters
-		"Magician":    "Magician",
-		"Illusionist": "Illusionist",
-		"Necromancer": "Necromancer",
-		"Pyromancer":  "Pyromancer",
-		"Cryomancer":  "Cryomancer",
-		"Warlock":     "Warlock",
-		"Witch":       "Witch",
-
-		// Hybrid classes - could have different primary casting classes
-		"Paladin": "Cleric",   // Divine
-		"Ranger":  "Druid",    // Divine
-		"Bard":    "Magician", // Arcane
-		"Shaman":  "Druid",    // Divine
-
-		// Non-casting classes
-		"Fighter":        "",
-		"Barbarian":      "",
-		"Berserker":      "",
-		"Cataphract":     "",
-		"Huntsman":       "",
-		"Monk":           "",
-		"Thief":          "",
-		"Assassin":       "",
-		"Legerdemainist": "",
-		"Purloiner":      "",
-		"Scout":          "",
-	}
-
-	if castingClass, ok := castingClassMap[characterClass]; ok {
-		return castingClass
-	}
-
-	return ""
-}
-
-// isDivineCaster checks if a class is a divine spellcaster
-func isDivineCaster(class string) bool {
-	divineCasters := []string{"Cleric", "Druid", "Priest", "Paladin", "Shaman"}
-	for _, c := range divineCasters {
-		if c == class {
-			return true
-		}
-	}
-	return false
-}
+	// Fill every available slot of each level
+	for level, spells := range spellsByLevel {
+		slotKey := fmt.Sprintf("level%d", level)
+		availableSlots := spellInfo.AvailablePreparedSlots[slotKey]
 
-// isArcaneCaster checks if a class is an arcane spellcaster
-func isArcaneCaster(class string) bool {
-	arcaneCasters := []string{"Magician", "Illusionist", "Necromancer", "Pyromancer", "Cryomancer", "Warlock", "Witch", "Bard"}
-	for _, c := range arcaneCasters {
-		if c == class {
-			return true
-		}
-	}
-	return false
-}
+		for i := 0; i < availableSlots; i++ {
+			spell := spells[i%len(spells)]
+			input := &models.PrepareSpellInput{
+				CharacterID: characterID,
+				SpellID:     spell.SpellID,
+				SpellLevel:  spell.SpellLevel,
+				SpellClass:  spell.SpellClass,
+			}
 
-// calculateMaxSpellLevel determines the highest spell level a character can cast
-func calculateMaxSpellLevel(castingClass string, characterLevel int) int {
-	if isDivineCaster(castingClass) {
-		// Divine casters (clerics, etc.)
-		switch {
-		case characterLevel >= 17:
-			return 6
-		case characterLevel >= 15:
-			return 5
-		case characterLevel >= 9:
-			return 4
-		case characterLevel >= 5:
-			return 3
-		case characterLevel >= 3:
-			return 2
-		case characterLevel >= 1:
-			return 1
-		default:
-			return 0
-		}
-	} else if isArcaneCaster(castingClass) {
-		// Arcane casters (magicians, etc.)
-		switch {
-		case characterLevel >= 17:
-			return 6
-		case characterLevel >= 14:
-			return 5
-		case characterLevel >= 11:
-			return 4
-		case characterLevel >= 7:
-			return 3
-		case characterLevel >= 3:
-			return 2
-		case characterLevel >= 1:
-			return 1
-		default:
-			return 0
+			_, err := s.spellCastingRepo.PrepareSpell(ctx, input)
+			if err != nil {
+				return fmt.Errorf("failed to prepare spell %s: %v", spell.SpellName, err)
+			}
 		}
 	}
 
-	return 0
+	return nil
 }
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+)
+
+// SpellbookService manages the spellbooks characters carry and the spells
+// scribed into them
+type SpellbookService struct {
+	spellbookRepo    repositories.SpellbookRepository
+	spellCastingRepo repositories.SpellCastingRepository
+	classService     *ClassService
+}
+
+// NewSpellbookService creates a new spellbook service
+func NewSpellbookService(
+	spellbookRepo repositories.SpellbookRepository,
+	spellCastingRepo repositories.SpellCastingRepository,
+	classService *ClassService,
+) *SpellbookService {
+	return &SpellbookService{
+		spellbookRepo:    spellbookRepo,
+		spellCastingRepo: spellCastingRepo,
+		classService:     classService,
+	}
+}
+
+func (s *SpellbookService) ListSpellbooks(ctx context.Context, characterID int64) ([]*models.Spellbook, error) {
+	return s.spellbookRepo.ListCharacterSpellbooks(ctx, characterID)
+}
+
+func (s *SpellbookService) GetSpellbook(ctx context.Context, characterID, id int64) (*models.Spellbook, error) {
+	return s.spellbookRepo.GetCharacterSpellbook(ctx, characterID, id)
+}
+
+// CreateSpellbook adds a new, empty spellbook to the character's inventory
+func (s *SpellbookService) CreateSpellbook(ctx context.Context, characterID int64, input *models.CreateSpellbookInput) (*models.Spellbook, error) {
+	id, err := s.spellbookRepo.CreateSpellbook(ctx, characterID, input)
+	if err != nil {
+		return nil, err
+	}
+	return s.spellbookRepo.GetCharacterSpellbook(ctx, characterID, id)
+}
+
+// ScribeSpell copies an arcane spell the character knows into one of their books
+func (s *SpellbookService) ScribeSpell(ctx context.Context, characterID, spellbookID int64, input *models.ScribeSpellInput) (*models.Spellbook, error) {
+	if _, err := s.spellbookRepo.GetCharacterSpellbook(ctx, characterID, spellbookID); err != nil {
+		return nil, err
+	}
+
+	knownSpells, err := s.spellCastingRepo.GetKnownSpells(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	var knownSpell *models.KnownSpell
+	for i := range knownSpells {
+		if knownSpells[i].SpellID == input.SpellID {
+			knownSpell = &knownSpells[i]
+			break
+		}
+	}
+	if knownSpell == nil {
+		return nil, apperrors.NewValidationError("spell_id", "Character does not know this spell")
+	}
+	rules, err := s.classService.ClassRules(ctx, knownSpell.SpellClass)
+	if err != nil {
+		return nil, err
+	}
+	if !rules.IsArcane() {
+		return nil, apperrors.NewValidationError("spell_id", "Only arcane spells are scribed into spellbooks")
+	}
+
+	err = s.spellbookRepo.AddSpell(ctx, &models.SpellbookSpell{
+		SpellbookID: spellbookID,
+		SpellID:     knownSpell.SpellID,
+		SpellName:   knownSpell.SpellName,
+		SpellLevel:  knownSpell.SpellLevel,
+		SpellClass:  knownSpell.SpellClass,
+		Pages:       models.SpellbookPageCost(knownSpell.SpellLevel),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.spellbookRepo.GetCharacterSpellbook(ctx, characterID, spellbookID)
+}
+
+// EraseSpell removes a spell from one of the character's books, freeing its pages
+func (s *SpellbookService) EraseSpell(ctx context.Context, characterID, spellbookID, spellID int64) (*models.Spellbook, error) {
+	if _, err := s.spellbookRepo.GetCharacterSpellbook(ctx, characterID, spellbookID); err != nil {
+		return nil, err
+	}
+	if err := s.spellbookRepo.RemoveSpell(ctx, spellbookID, spellID); err != nil {
+		return nil, err
+	}
+	return s.spellbookRepo.GetCharacterSpellbook(ctx, characterID, spellbookID)
+}
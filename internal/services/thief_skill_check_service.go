@@ -0,0 +1,234 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"mordezzanV4/internal/dice"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/logger"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+)
+
+// ThiefSkillCheckService rolls a character's thief skills against their
+// x-in-12 chance and keeps a history of the attempts
+type ThiefSkillCheckService struct {
+	checkRepo          repositories.ThiefSkillCheckRepository
+	characterRepo      repositories.CharacterRepository
+	thiefSkillsService *ThiefSkillsService
+	inventoryRepo      repositories.InventoryRepository
+	armorRepo          repositories.ArmorRepository
+	ringRepo           repositories.RingRepository
+	equipmentRepo      repositories.EquipmentRepository
+	magicItemRepo      repositories.MagicItemRepository
+	encumbranceService *EncumbranceService
+	roller             *dice.Roller
+}
+
+// NewThiefSkillCheckService creates a new thief skill check service
+func NewThiefSkillCheckService(
+	checkRepo repositories.ThiefSkillCheckRepository,
+	characterRepo repositories.CharacterRepository,
+	thiefSkillsService *ThiefSkillsService,
+	inventoryRepo repositories.InventoryRepository,
+	armorRepo repositories.ArmorRepository,
+	ringRepo repositories.RingRepository,
+	equipmentRepo repositories.EquipmentRepository,
+	magicItemRepo repositories.MagicItemRepository,
+	encumbranceService *EncumbranceService,
+	roller *dice.Roller,
+) *ThiefSkillCheckService {
+	return &ThiefSkillCheckService{
+		checkRepo:          checkRepo,
+		characterRepo:      characterRepo,
+		thiefSkillsService: thiefSkillsService,
+		inventoryRepo:      inventoryRepo,
+		armorRepo:          armorRepo,
+		ringRepo:           ringRepo,
+		equipmentRepo:      equipmentRepo,
+		magicItemRepo:      magicItemRepo,
+		encumbranceService: encumbranceService,
+		roller:             roller,
+	}
+}
+
+// GetChecks returns the character's past skill checks, newest first
+func (s *ThiefSkillCheckService) GetChecks(ctx context.Context, characterID int64) ([]*models.ThiefSkillCheck, error) {
+	if _, err := s.characterRepo.GetCharacter(ctx, characterID); err != nil {
+		return nil, err
+	}
+	return s.checkRepo.GetChecks(ctx, characterID)
+}
+
+// CheckSkill rolls a d12 against the character's chance in the named skill.
+// Skills relying on dexterity are hindered by medium or heavy armour and by
+// encumbrance; equipped items named for the skill add their bonus, and the
+// referee may add situational modifiers. A roll at or under the modified
+// chance succeeds, and a 12 always fails.
+func (s *ThiefSkillCheckService) CheckSkill(ctx context.Context, characterID int64, input *models.ThiefSkillCheckInput) (*models.ThiefSkillCheck, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	skill, err := s.findSkill(ctx, character, input.Skill)
+	if err != nil {
+		return nil, err
+	}
+	base, ok := models.ParseSkillChance(skill.SuccessChance)
+	if !ok {
+		return nil, apperrors.NewValidationError("skill", fmt.Sprintf("%s cannot use %s yet", character.Name, skill.Name))
+	}
+
+	modifiers := []models.RollModifier{}
+	add := func(source string, value int) {
+		if value != 0 {
+			modifiers = append(modifiers, models.RollModifier{Source: source, Value: value})
+		}
+	}
+
+	items, err := s.equippedItems(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if models.IsAgilitySkill(skill) {
+		for _, item := range items {
+			add(item.name, models.ArmorSkillPenalty(item.weightClass))
+		}
+		status, err := s.encumbranceStatus(ctx, characterID)
+		if err != nil {
+			return nil, err
+		}
+		add("Encumbrance", models.EncumbranceSkillPenalty(status))
+	}
+	for _, item := range items {
+		if item.itemType == "armor" || !models.ItemAidsThiefSkill(item.name, skill.Name) {
+			continue
+		}
+		bonus := extractWeaponBonus(item.name)
+		if bonus == 0 {
+			bonus = 1
+		}
+		add(item.name, bonus)
+	}
+	for _, modifier := range input.Modifiers {
+		add(modifier.Source, modifier.Value)
+	}
+
+	check := &models.ThiefSkillCheck{
+		CharacterID: characterID,
+		SkillName:   skill.Name,
+		BaseChance:  base,
+		Modifiers:   modifiers,
+		Chance:      models.ThiefSkillChance(base, modifiers),
+		Roll:        s.roller.Die(models.ThiefSkillDie),
+		Notes:       input.Notes,
+	}
+	check.Success = check.Roll <= check.Chance
+
+	id, err := s.checkRepo.RecordCheck(ctx, check)
+	if err != nil {
+		return nil, err
+	}
+	return s.checkRepo.GetCheck(ctx, id)
+}
+
+// findSkill looks up the named skill among those the character has
+func (s *ThiefSkillCheckService) findSkill(ctx context.Context, character *models.Character, name string) (*models.ThiefSkillWithChance, error) {
+	scores := character.EffectiveAttributes()
+	skills, err := s.thiefSkillsService.GetThiefSkillsForCharacter(ctx, character.Class, int64(character.EffectiveLevel()), map[string]int{
+		"DX": scores.Dexterity,
+		"IN": scores.Intelligence,
+		"WS": scores.Wisdom,
+	})
+	if err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	if len(skills) == 0 {
+		return nil, apperrors.NewValidationError("skill", fmt.Sprintf("A %s has no thief skills", character.Class))
+	}
+
+	for _, skill := range skills {
+		if strings.EqualFold(skill.Name, name) {
+			return skill, nil
+		}
+	}
+	return nil, apperrors.NewValidationError("skill", fmt.Sprintf("Unknown thief skill: %s", name))
+}
+
+// equippedItem is the name of an equipped item and, for armour, its weight class
+type equippedItem struct {
+	itemType    string
+	name        string
+	weightClass string
+}
+
+// equippedItems returns the character's equipped armour, rings, equipment
+// and magic items
+func (s *ThiefSkillCheckService) equippedItems(ctx context.Context, characterID int64) ([]equippedItem, error) {
+	inventory, err := s.inventoryRepo.GetInventoryByCharacter(ctx, characterID)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var items []equippedItem
+	for _, item := range inventory.Items {
+		if !item.IsEquipped {
+			continue
+		}
+
+		equipped := equippedItem{itemType: item.ItemType}
+		switch item.ItemType {
+		case "armor":
+			armor, err := s.armorRepo.GetArmor(ctx, item.ItemID)
+			if err != nil {
+				logger.Error("Failed to fetch armor details for ID %d: %v", item.ItemID, err)
+				continue
+			}
+			equipped.name, equipped.weightClass = armor.Name, armor.WeightClass
+		case "ring":
+			ring, err := s.ringRepo.GetRing(ctx, item.ItemID)
+			if err != nil {
+				logger.Error("Failed to fetch ring details for ID %d: %v", item.ItemID, err)
+				continue
+			}
+			equipped.name = ring.Name
+		case "equipment":
+			equipment, err := s.equipmentRepo.GetEquipment(ctx, item.ItemID)
+			if err != nil {
+				logger.Error("Failed to fetch equipment details for ID %d: %v", item.ItemID, err)
+				continue
+			}
+			equipped.name = equipment.Name
+		case "magic_item":
+			magicItem, err := s.magicItemRepo.GetMagicItem(ctx, item.ItemID)
+			if err != nil {
+				logger.Error("Failed to fetch magic item details for ID %d: %v", item.ItemID, err)
+				continue
+			}
+			equipped.name = magicItem.Name
+		default:
+			continue
+		}
+		items = append(items, equipped)
+	}
+	return items, nil
+}
+
+// encumbranceStatus returns how burdened the character is; a character
+// without an inventory carries nothing
+func (s *ThiefSkillCheckService) encumbranceStatus(ctx context.Context, characterID int64) (models.EncumbranceStatus, error) {
+	details, err := s.encumbranceService.GetCharacterEncumbrance(ctx, characterID)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return models.EncumbranceStatus{}, nil
+		}
+		return models.EncumbranceStatus{}, err
+	}
+	return details.Status, nil
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"mordezzanV4/internal/dice"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+)
+
+// TurnUndeadService resolves attempts by clerics and their kin to turn undead,
+// or to compel them to serve
+type TurnUndeadService struct {
+	turnUndeadRepo repositories.TurnUndeadRepository
+	characterRepo  repositories.CharacterRepository
+	classService   *ClassService
+	roller         *dice.Roller
+}
+
+// NewTurnUndeadService creates a new turn undead service
+func NewTurnUndeadService(
+	turnUndeadRepo repositories.TurnUndeadRepository,
+	characterRepo repositories.CharacterRepository,
+	classService *ClassService,
+	roller *dice.Roller,
+) *TurnUndeadService {
+	return &TurnUndeadService{
+		turnUndeadRepo: turnUndeadRepo,
+		characterRepo:  characterRepo,
+		classService:   classService,
+		roller:         roller,
+	}
+}
+
+// GetStatus returns the character's turning ability, the uses left today and
+// their past attempts
+func (s *TurnUndeadService) GetStatus(ctx context.Context, characterID int64) (*models.TurnUndeadStatus, error) {
+	character, err := s.loadCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	used, err := s.turnUndeadRepo.CountUsesToday(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	attempts, err := s.turnUndeadRepo.GetAttempts(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.TurnUndeadStatus{
+		CharacterID:    characterID,
+		TurningAbility: character.TurningAbility,
+		UsesPerDay:     models.TurnUndeadUsesPerDay,
+		UsesRemaining:  max(models.TurnUndeadUsesPerDay-used, 0),
+		Attempts:       attempts,
+	}, nil
+}
+
+// TurnUndead cross-references the character's turning ability with the undead
+// type on the turning matrix. A chance-in-twelve is improved or worsened by
+// the character's charisma; on success 2d6 of the undead are turned, or
+// destroyed where the matrix says so. Necromancers, and other casters who
+// choose to, command the undead instead and never destroy them.
+func (s *TurnUndeadService) TurnUndead(ctx context.Context, characterID int64, input *models.TurnUndeadInput) (*models.TurnUndeadResult, error) {
+	character, err := s.loadCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if character.TurningAbility == 0 {
+		return nil, apperrors.NewValidationError("character", fmt.Sprintf("%s cannot turn undead", character.Name))
+	}
+	if input.Command && !models.CanCommandUndead(character.Class) {
+		return nil, apperrors.NewValidationError("command", fmt.Sprintf("A %s cannot command undead", character.Class))
+	}
+	command := input.Command || models.AlwaysCommandsUndead(character.Class)
+
+	used, err := s.turnUndeadRepo.CountUsesToday(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if used >= models.TurnUndeadUsesPerDay {
+		return nil, apperrors.NewConflict(fmt.Sprintf("%s has no turning attempts left today", character.Name))
+	}
+
+	undeadType := input.ResolvedUndeadType()
+	entry := models.TurningMatrix(character.TurningAbility, undeadType)
+	attempt := &models.TurnUndeadAttempt{
+		CharacterID:    characterID,
+		UndeadType:     undeadType,
+		TurningAbility: character.TurningAbility,
+		Command:        command,
+		Entry:          entry.String(),
+		Result:         models.TurnUndeadNoEffect,
+	}
+	result := &models.TurnUndeadResult{}
+
+	success := entry.Automatic
+	if entry.Chance > 0 {
+		result.CharismaModifier = character.UndeadTurningModifier
+		attempt.Chance = min(max(entry.Chance+character.UndeadTurningModifier, 0), models.TurnUndeadDie)
+		attempt.Roll = s.roller.Die(models.TurnUndeadDie)
+		success = attempt.Roll <= attempt.Chance
+		attempt.Result = models.TurnUndeadFailed
+	}
+
+	if success {
+		affected, err := s.roller.Roll(models.TurnUndeadAffectedDice)
+		if err != nil {
+			return nil, apperrors.NewInternalError(err)
+		}
+		attempt.Affected = affected.Total
+		if input.Count > 0 {
+			attempt.Affected = min(attempt.Affected, input.Count)
+		}
+
+		switch {
+		case command:
+			attempt.Result = models.TurnUndeadCommanded
+			result.Commanded = attempt.Affected
+		case entry.Destroy:
+			attempt.Result = models.TurnUndeadDestroyed
+			result.Destroyed = attempt.Affected
+		default:
+			attempt.Result = models.TurnUndeadTurned
+			result.Turned = attempt.Affected
+		}
+	}
+
+	id, err := s.turnUndeadRepo.RecordAttempt(ctx, attempt)
+	if err != nil {
+		return nil, err
+	}
+	result.Attempt, err = s.turnUndeadRepo.GetAttempt(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	result.UsesRemaining = models.TurnUndeadUsesPerDay - used - 1
+	return result, nil
+}
+
+// loadCharacter retrieves the character with the turning ability of their class
+func (s *TurnUndeadService) loadCharacter(ctx context.Context, characterID int64) (*models.Character, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.classService.EnrichCharacterWithClassData(ctx, character); err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+	return character, nil
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"mordezzanV4/internal/dice"
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+)
+
+// VitalityService carries characters through unconsciousness, dying and
+// death: it applies damage and healing, binds the wounds of the dying, returns
+// the dead to life and retires characters from play
+type VitalityService struct {
+	characterRepo  repositories.CharacterRepository
+	adjustmentRepo repositories.AttributeAdjustmentRepository
+	roller         *dice.Roller
+}
+
+// NewVitalityService creates a new vitality service
+func NewVitalityService(
+	characterRepo repositories.CharacterRepository,
+	adjustmentRepo repositories.AttributeAdjustmentRepository,
+	roller *dice.Roller,
+) *VitalityService {
+	return &VitalityService{
+		characterRepo:  characterRepo,
+		adjustmentRepo: adjustmentRepo,
+		roller:         roller,
+	}
+}
+
+// GetVitality returns how close to death the character is
+func (s *VitalityService) GetVitality(ctx context.Context, characterID int64) (*models.VitalityStatus, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.VitalityStatus{
+		CharacterID:      character.ID,
+		Vitality:         character.Vitality,
+		CurrentHitPoints: character.CurrentHitPoints,
+		MaxHitPoints:     character.MaxHitPoints,
+		Stabilized:       character.Stabilized,
+		TraumaSurvival:   character.TraumaSurvival,
+		ReadOnly:         character.IsReadOnly(),
+		RetiredAt:        character.RetiredAt,
+	}, nil
+}
+
+// ModifyHitPoints damages or heals the character. The dead cannot be healed
+// until they are resurrected, and retired characters cannot be changed.
+func (s *VitalityService) ModifyHitPoints(ctx context.Context, characterID int64, delta int, temp bool) (*models.Character, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if character.IsReadOnly() {
+		return nil, apperrors.NewConflict(character.ReadOnlyReason())
+	}
+
+	character.ModifyHitPoints(delta, temp)
+	if err := s.characterRepo.UpdateHitPoints(ctx, character); err != nil {
+		return nil, err
+	}
+	return s.characterRepo.GetCharacter(ctx, characterID)
+}
+
+// Stabilize binds the wounds of a dying character so they stop losing hit
+// points; they stay unconscious until healed above 0
+func (s *VitalityService) Stabilize(ctx context.Context, characterID int64) (*models.Character, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if character.Vitality != models.VitalityDying {
+		return nil, apperrors.NewConflict(fmt.Sprintf("%s is %s, not dying", character.Name, character.Vitality))
+	}
+
+	character.Stabilized = true
+	if err := s.characterRepo.UpdateHitPoints(ctx, character); err != nil {
+		return nil, err
+	}
+	return s.characterRepo.GetCharacter(ctx, characterID)
+}
+
+// Resurrect attempts to return a dead character to life. A d100 at or under
+// their trauma survival succeeds: they return with ResurrectionHitPoints and
+// permanently lose ResurrectionConstitutionLoss constitution. On a failure
+// they remain dead.
+func (s *VitalityService) Resurrect(ctx context.Context, characterID int64, input *models.ResurrectInput) (*models.ResurrectionResult, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if character.IsRetired() {
+		return nil, apperrors.NewConflict(character.ReadOnlyReason())
+	}
+	if !character.IsDead() {
+		return nil, apperrors.NewConflict(fmt.Sprintf("%s is not dead", character.Name))
+	}
+	chance, err := character.TraumaSurvivalChance()
+	if err != nil {
+		return nil, apperrors.NewInternalError(err)
+	}
+
+	result := &models.ResurrectionResult{
+		CharacterID: characterID,
+		Chance:      chance,
+		Roll:        s.roller.Die(models.ResurrectionDie),
+	}
+	result.Success = result.Roll <= result.Chance
+	result.Summary = models.ResurrectionSummary(character.Name, result.Success)
+
+	if result.Success {
+		character.CurrentHitPoints = models.ResurrectionHitPoints
+		character.TemporaryHitPoints = 0
+		character.Stabilized = false
+		if err := s.characterRepo.UpdateHitPoints(ctx, character); err != nil {
+			return nil, err
+		}
+		if _, err := s.adjustmentRepo.AddAdjustment(ctx, characterID, &models.AddAttributeAdjustmentInput{
+			Attribute: models.AttributeConstitution,
+			Kind:      models.AdjustmentLoss,
+			Amount:    models.ResurrectionConstitutionLoss,
+			Source:    input.ResolvedSource(),
+		}); err != nil {
+			return nil, err
+		}
+		result.ConstitutionLost = models.ResurrectionConstitutionLoss
+	}
+
+	result.Character, err = s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Retire takes the character out of play. They are kept for the record but
+// can no longer be changed; this is how the dead who will not be raised are
+// laid to rest.
+func (s *VitalityService) Retire(ctx context.Context, characterID int64) (*models.Character, error) {
+	if _, err := s.characterRepo.GetCharacter(ctx, characterID); err != nil {
+		return nil, err
+	}
+	if err := s.characterRepo.RetireCharacter(ctx, characterID); err != nil {
+		return nil, err
+	}
+	return s.characterRepo.GetCharacter(ctx, characterID)
+}
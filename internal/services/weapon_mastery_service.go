@@ -45,12 +45,12 @@ func (s *WeaponMasteryService) GetAvailableWeaponMasteriesData(ctx context.Conte
 			"total_slots":       0,
 			"used_slots":        0,
 			"can_grand_master":  false,
-			"character_level":   character.Level,
+			"character_level":   character.EffectiveLevel(),
 		}, nil
 	}
 
 	// Calculate the total mastery slots based on character level and class
-	totalSlots := s.calculateMasterySlots(character.Class, character.Level)
+	totalSlots := s.calculateMasterySlots(character.Class, character.EffectiveLevel())
 
 	// Fetch the character's current weapon masteries
 	currentMasteries, err := s.weaponMasteryRepo.GetWeaponMasteriesByCharacter(ctx, characterID)
@@ -72,7 +72,7 @@ func (s *WeaponMasteryService) GetAvailableWeaponMasteriesData(ctx context.Conte
 
 	// Determine if the character can have grand mastery
 	// Usually requires level 4+ and appropriate class
-	canGrandMaster := character.Level >= 4 && s.canHaveGrandMastery(character.Class)
+	canGrandMaster := character.EffectiveLevel() >= 4 && s.canHaveGrandMastery(character.Class)
 
 	// Get all available weapons the character could master
 
@@ -92,7 +92,7 @@ func (s *WeaponMasteryService) GetAvailableWeaponMasteriesData(ctx context.Conte
 		"total_slots":       totalSlots,
 		"used_slots":        usedSlots,
 		"can_grand_master":  canGrandMaster && !hasGrandMastery, // Can only have one grand mastery
-		"character_level":   character.Level,
+		"character_level":   character.EffectiveLevel(),
 	}
 
 	return response, nil
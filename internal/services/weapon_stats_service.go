@@ -21,6 +21,7 @@ type WeaponStats struct {
 	InventoryItem      *models.InventoryItem  `json:"inventory_item"`
 	BaseToHit          int                    `json:"base_to_hit"`
 	ToHitBonus         int                    `json:"to_hit_bonus"`
+	ConditionToHit     int                    `json:"condition_to_hit,omitempty"`
 	FinalToHit         int                    `json:"final_to_hit"`
 	BaseDamage         string                 `json:"base_damage"`
 	DamageBonus        int                    `json:"damage_bonus"`
@@ -141,6 +142,12 @@ func (s *WeaponStatsService) CalculateCharacterWeaponStats(ctx context.Context,
 			}
 		}
 
+		// Add modifiers from conditions such as blessed or blinded
+		if character.ConditionModifiers != nil {
+			stats.ConditionToHit = character.ConditionModifiers.ToHit
+			stats.ToHitBonus += stats.ConditionToHit
+		}
+
 		// Calculate final to-hit
 		stats.FinalToHit = stats.BaseToHit + stats.ToHitBonus
 
@@ -177,7 +184,7 @@ func (s *WeaponStatsService) CalculateCharacterWeaponStats(ctx context.Context,
 			character.Class == "Paladin" || character.Class == "Barbarian" ||
 			character.Class == "Berserker" || character.Class == "Cataphract" ||
 			character.Class == "Huntsman" {
-			if character.Level >= 7 && !isMissileWeapon && stats.BaseAttackRate == "1/1" {
+			if character.EffectiveLevel() >= 7 && !isMissileWeapon && stats.BaseAttackRate == "1/1" {
 				if stats.FinalAttackRate == "1/1" { // Only upgrade if not already improved by mastery
 					stats.FinalAttackRate = "3/2"
 					stats.ImprovedAttackRate = true
@@ -193,7 +200,10 @@ func (s *WeaponStatsService) CalculateCharacterWeaponStats(ctx context.Context,
 
 // Helper functions
 func isRangedWeapon(weapon *models.Weapon) bool {
-	return weapon.Category == "Ranged" || weapon.Category == "Hurled"
+	// The weapon catalog files missiles as "Hurled Missile Type" and
+	// "Launched Missile Type"
+	return weapon.Category == "Ranged" || weapon.Category == "Hurled" ||
+		strings.HasSuffix(weapon.Category, "Missile Type")
 }
 
 func extractBaseWeaponName(name string) string {
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "mordezzanV4/internal/errors"
+	"mordezzanV4/internal/logger"
+	"mordezzanV4/internal/models"
+	"mordezzanV4/internal/repositories"
+)
+
+// XPService manages the experience ledger; a character's experience points are
+// always the sum of its awards
+type XPService struct {
+	xpAwardRepo   repositories.XPAwardRepository
+	characterRepo repositories.CharacterRepository
+	classRepo     repositories.ClassRepository
+}
+
+// NewXPService creates a new XP service
+func NewXPService(
+	xpAwardRepo repositories.XPAwardRepository,
+	characterRepo repositories.CharacterRepository,
+	classRepo repositories.ClassRepository,
+) *XPService {
+	return &XPService{
+		xpAwardRepo:   xpAwardRepo,
+		characterRepo: characterRepo,
+		classRepo:     classRepo,
+	}
+}
+
+// primeAttributes returns the prime attributes from the class's rules; a
+// class without rules has none
+func (s *XPService) primeAttributes(ctx context.Context, className string) ([]string, error) {
+	rules, err := s.classRepo.GetClassRules(ctx, className)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	return rules.PrimeAttributes, nil
+}
+
+// GetLedger returns every award for a character along with its current bonus
+func (s *XPService) GetLedger(ctx context.Context, characterID int64) (*models.XPLedger, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	awards, err := s.xpAwardRepo.GetXPAwardsByCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	for _, award := range awards {
+		total += award.Total
+	}
+
+	primes, err := s.primeAttributes(ctx, character.Class)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.XPLedger{
+		CharacterID:      characterID,
+		ExperiencePoints: total,
+		PrimeAttributes:  primes,
+		BonusPercent:     character.PrimeAttributeXPBonus(primes),
+		Awards:           awards,
+	}, nil
+}
+
+// AwardXP records an award, applying the character's prime attribute bonus
+func (s *XPService) AwardXP(ctx context.Context, characterID, awardedBy int64, input *models.CreateXPAwardInput) (*models.XPAward, error) {
+	character, err := s.characterRepo.GetCharacter(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	award := &models.XPAward{
+		CharacterID: characterID,
+		Amount:      input.Amount,
+		Source:      input.Source,
+		Session:     input.Session,
+		Notes:       input.Notes,
+		AwardedBy:   &awardedBy,
+	}
+	if !input.NoBonus && input.Amount > 0 {
+		primes, err := s.primeAttributes(ctx, character.Class)
+		if err != nil {
+			return nil, err
+		}
+		award.BonusPercent = character.PrimeAttributeXPBonus(primes)
+		award.BonusAmount = input.Amount * award.BonusPercent / 100
+	}
+	award.Total = award.Amount + award.BonusAmount
+
+	return s.createAward(ctx, award)
+}
+
+// ReverseXPAward cancels an award by recording an equal and opposite entry
+func (s *XPService) ReverseXPAward(ctx context.Context, characterID, awardID, reversedBy int64) (*models.XPAward, error) {
+	original, err := s.xpAwardRepo.GetXPAward(ctx, awardID)
+	if err != nil {
+		return nil, err
+	}
+	if original.CharacterID != characterID {
+		return nil, apperrors.NewNotFound("xp award", awardID)
+	}
+	if original.ReversesAwardID != nil {
+		return nil, apperrors.NewValidationError("award_id", "A reversal cannot itself be reversed")
+	}
+	if original.Reversed {
+		return nil, apperrors.NewValidationError("award_id", "Award has already been reversed")
+	}
+
+	reversal := &models.XPAward{
+		CharacterID:     characterID,
+		Amount:          -original.Amount,
+		BonusPercent:    original.BonusPercent,
+		BonusAmount:     -original.BonusAmount,
+		Total:           -original.Total,
+		Source:          fmt.Sprintf("Reversal of award #%d (%s)", original.ID, original.Source),
+		Session:         original.Session,
+		AwardedBy:       &reversedBy,
+		ReversesAwardID: &original.ID,
+	}
+
+	return s.createAward(ctx, reversal)
+}
+
+// SetExperience records the adjustment needed to bring the ledger to the given
+// total; used when experience is edited directly rather than awarded
+func (s *XPService) SetExperience(ctx context.Context, characterID, adjustedBy int64, experiencePoints int, source string) error {
+	current, err := s.xpAwardRepo.GetExperienceTotal(ctx, characterID)
+	if err != nil {
+		return err
+	}
+
+	difference := experiencePoints - current
+	if difference == 0 {
+		return nil
+	}
+
+	_, err = s.createAward(ctx, &models.XPAward{
+		CharacterID: characterID,
+		Amount:      difference,
+		Total:       difference,
+		Source:      source,
+		AwardedBy:   &adjustedBy,
+	})
+	return err
+}
+
+func (s *XPService) createAward(ctx context.Context, award *models.XPAward) (*models.XPAward, error) {
+	id, total, err := s.xpAwardRepo.CreateXPAward(ctx, award)
+	if err != nil {
+		logger.Error("Failed to record XP award for character %d: %v", award.CharacterID, err)
+		return nil, err
+	}
+
+	logger.Info("Character %d received %d XP (%s); ledger total is now %d",
+		award.CharacterID, award.Total, award.Source, total)
+
+	return s.xpAwardRepo.GetXPAward(ctx, id)
+}
@@ -165,31 +165,36 @@ document.addEventListener('DOMContentLoaded', function() {
             }
             try {
                 const xpElement = document.querySelector('.xp-stat .stat-value');
-                const currentXp = parseInt(xpElement.textContent);
-                const newXp = currentXp + amount;
-                const response = await fetch(`/api/characters/${characterId}/xp`, {
-                    method: 'PATCH',
+                const awardResponse = await fetch(`/api/characters/${characterId}/xp-awards`, {
+                    method: 'POST',
                     headers: {
                         'Content-Type': 'application/json',
                     },
                     body: JSON.stringify({
-                        experience_points: newXp
+                        amount: amount,
+                        source: 'Adventure award'
                     })
                 });
-                if (!response.ok) {
+                if (!awardResponse.ok) {
                     throw new Error('Failed to update experience points');
                 }
+                const award = await awardResponse.json();
+                if (award.bonus_amount > 0) {
+                    alert(`Prime attribute bonus: +${award.bonus_percent}% (${award.bonus_amount} XP)`);
+                }
+                const response = await fetch(`/api/characters/${characterId}`);
+                if (!response.ok) {
+                    throw new Error('Failed to reload character');
+                }
                 const data = await response.json();
                 xpElement.textContent = data.experience_points;
-                const levelElement = document.querySelector('.character-meta');
-                const currentLevel = parseInt(levelElement.textContent.match(/Level (\d+)/)[1]);
-                if (data.level > currentLevel) {
-                    alert(`Congratulations! You've reached level ${data.level}!`);
-                    levelElement.textContent = levelElement.textContent.replace(
-                        `Level ${currentLevel}`,
-                        `Level ${data.level}`
-                    );
-                    location.reload();
+                const statusResponse = await fetch(`/api/characters/${characterId}/level-up`);
+                if (statusResponse.ok) {
+                    const status = await statusResponse.json();
+                    if (status.eligible && confirm(`You have enough experience for level ${status.next_level}. Level up now?`)) {
+                        await levelUp();
+                        return;
+                    }
                 }
                 const xpNeededElement = document.querySelector('.xp-stat div:not(.stat-value):not(.stat-actions)');
                 if (xpNeededElement && data.next_level_experience) {
@@ -203,6 +208,34 @@ document.addEventListener('DOMContentLoaded', function() {
             }
         });
     }
+    const levelUp = async () => {
+        const summary = [];
+        let again = true;
+        while (again) {
+            const response = await fetch(`/api/characters/${characterId}/level-up`, {
+                method: 'POST',
+                headers: {
+                    'Content-Type': 'application/json',
+                },
+                body: JSON.stringify({})
+            });
+            if (!response.ok) {
+                throw new Error('Failed to level up');
+            }
+            const result = await response.json();
+            let line = `Level ${result.to_level}: +${result.hp_gained} HP`;
+            if (result.hp_roll) {
+                line += ` (rolled ${result.hp_roll.total}, modifier ${result.hp_modifier})`;
+            }
+            if (result.new_abilities && result.new_abilities.length > 0) {
+                line += `, new abilities: ${result.new_abilities.map(a => a.name).join(', ')}`;
+            }
+            summary.push(line);
+            again = result.can_level_again;
+        }
+        alert(`Congratulations!\n${summary.join('\n')}`);
+        location.reload();
+    };
     window.addEventListener('click', (e) => {
         if (e.target === hpModal) {
             hpModal.style.display = 'none';
@@ -13,6 +13,69 @@ document.addEventListener('DOMContentLoaded', function() {
         return null;
     }
     
+    const kindredSelect = document.getElementById('kindred');
+    const kindredDetails = document.getElementById('kindredDetails');
+    let kindreds = [];
+
+    function describeKindred() {
+        const kindred = kindreds.find(k => k.name === kindredSelect.value);
+        if (!kindred) {
+            kindredDetails.textContent = '';
+            return;
+        }
+        const modifiers = ['strength', 'dexterity', 'constitution', 'intelligence', 'wisdom', 'charisma']
+            .filter(attr => kindred[`${attr}_modifier`] !== 0)
+            .map(attr => `${attr.slice(0, 3).toUpperCase()} ${kindred[`${attr}_modifier`] > 0 ? '+' : ''}${kindred[`${attr}_modifier`]}`);
+        const parts = [kindred.description];
+        if (modifiers.length > 0) parts.push(modifiers.join(', '));
+        if (kindred.allowed_classes.length > 0) parts.push(`Classes: ${kindred.allowed_classes.join(', ')}`);
+        kindredDetails.textContent = parts.join(' — ');
+    }
+
+    if (kindredSelect) {
+        fetch('/api/kindreds', { headers: { 'Accept': 'application/json' } })
+            .then(response => response.ok ? response.json() : [])
+            .then(data => {
+                kindreds = data;
+                kindreds.forEach(kindred => {
+                    const option = document.createElement('option');
+                    option.value = kindred.name;
+                    option.textContent = kindred.name;
+                    kindredSelect.appendChild(option);
+                });
+                kindredSelect.value = kindredSelect.dataset.selected || '';
+                describeKindred();
+            })
+            .catch(error => console.error('Failed to load kindreds:', error));
+        kindredSelect.addEventListener('change', describeKindred);
+    }
+
+    // Classes installed by homebrew content packs are listed after the core classes
+    const classSelect = document.getElementById('class');
+    if (classSelect) {
+        fetch('/api/content-packs', { headers: { 'Accept': 'application/json' } })
+            .then(response => response.ok ? response.json() : [])
+            .then(packs => {
+                packs.forEach(pack => {
+                    const classes = pack.entries.filter(entry => entry.type === 'class');
+                    if (classes.length === 0) return;
+                    const group = document.createElement('optgroup');
+                    group.label = pack.name;
+                    classes.forEach(entry => {
+                        const option = document.createElement('option');
+                        option.value = entry.name;
+                        option.textContent = entry.name.slice(entry.name.indexOf(':') + 1);
+                        group.appendChild(option);
+                    });
+                    classSelect.appendChild(group);
+                });
+                if (classSelect.dataset.selected) {
+                    classSelect.value = classSelect.dataset.selected;
+                }
+            })
+            .catch(error => console.error('Failed to load content packs:', error));
+    }
+
     if (form) {
         form.addEventListener('submit', async function(e) {
             e.preventDefault();
@@ -27,6 +90,7 @@ document.addEventListener('DOMContentLoaded', function() {
                 user_id: parseInt(userId, 10),
                 name: document.getElementById('name').value,
                 class: document.getElementById('class').value,
+                kindred: kindredSelect ? kindredSelect.value : '',
                 level: parseInt(document.getElementById('level').value, 10),
                 experience_points: parseInt(document.getElementById('experience_points').value, 10) || 0,
                 strength: parseInt(document.getElementById('strength').value, 10),